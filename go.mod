@@ -3,6 +3,7 @@ module github.com/trois-six/terraform-provider-sendgrid
 go 1.15
 
 require (
+	github.com/hashicorp/go-cty v1.4.1-0.20200414143053-d3edf31b6320
 	github.com/hashicorp/terraform-plugin-sdk/v2 v2.4.4
 	github.com/sendgrid/rest v2.6.2+incompatible
 	github.com/sendgrid/sendgrid-go v3.8.0+incompatible
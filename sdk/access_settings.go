@@ -0,0 +1,160 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// AccessRule is an IP address, or a range in CIDR notation, allowed to access the account.
+type AccessRule struct {
+	ID        int64  `json:"id,omitempty"`
+	IP        string `json:"ip"`
+	CreatedAt int64  `json:"created_at,omitempty"`
+	UpdatedAt int64  `json:"updated_at,omitempty"`
+}
+
+// AccessActivity is a recent attempt to access the account from an IP address.
+type AccessActivity struct {
+	IP         string `json:"ip"`
+	Allowed    bool   `json:"allowed"`
+	AuthMethod string `json:"auth_method,omitempty"`
+	FirstAt    int64  `json:"first_at,omitempty"`
+	LastAt     int64  `json:"last_at,omitempty"`
+	Location   string `json:"location,omitempty"`
+}
+
+type accessRules struct {
+	Result []AccessRule `json:"result"`
+}
+
+type accessRulesCreation struct {
+	IPs []AccessRule `json:"ips"`
+}
+
+type accessRulesDeletion struct {
+	IDs []int64 `json:"ids"`
+}
+
+type accessActivity struct {
+	Result []AccessActivity `json:"result"`
+}
+
+func parseAccessRules(respBody string) ([]AccessRule, RequestError) {
+	var body accessRules
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing access rules: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListAccessRules retrieves the IP addresses allowed to access the account. If there is none,
+// the access isn't restricted.
+func (c *Client) ListAccessRules() ([]AccessRule, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/access_settings/whitelist")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing access rules: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingAccessRules, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseAccessRules(respBody)
+}
+
+// CreateAccessRules allows the IP addresses, or ranges in CIDR notation, to access the account
+// and returns the created rules.
+func (c *Client) CreateAccessRules(ips []string) ([]AccessRule, RequestError) {
+	if len(ips) == 0 {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrAccessRuleIPRequired}
+	}
+
+	rules := accessRulesCreation{IPs: make([]AccessRule, 0, len(ips))}
+	for _, ip := range ips {
+		rules.IPs = append(rules.IPs, AccessRule{IP: ip})
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/access_settings/whitelist", rules)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating access rules: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAccessRules, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseAccessRules(respBody)
+}
+
+// DeleteAccessRules removes the rules, their IP addresses aren't allowed anymore.
+func (c *Client) DeleteAccessRules(ids []int64) (bool, RequestError) {
+	if len(ids) == 0 {
+		return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	respBody, statusCode, err := c.Post("DELETE", "/access_settings/whitelist", accessRulesDeletion{IDs: ids})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting access rules: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAccessRules, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListAccessActivity retrieves the most recent attempts to access the account.
+func (c *Client) ListAccessActivity() ([]AccessActivity, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/access_settings/activity")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing access activity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingAccessActivity, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body accessActivity
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing access activity: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
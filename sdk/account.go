@@ -0,0 +1,120 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Account is the type and the reputation of the Sendgrid account.
+type Account struct {
+	Type       string  `json:"type,omitempty"`
+	Reputation float64 `json:"reputation,omitempty"`
+}
+
+// Profile is the profile of the user of the Sendgrid account.
+type Profile struct {
+	FirstName string `json:"first_name,omitempty"`
+	LastName  string `json:"last_name,omitempty"`
+	Company   string `json:"company,omitempty"`
+	Address   string `json:"address,omitempty"`
+	Address2  string `json:"address2,omitempty"`
+	City      string `json:"city,omitempty"`
+	State     string `json:"state,omitempty"`
+	Zip       string `json:"zip,omitempty"`
+	Country   string `json:"country,omitempty"`
+	Phone     string `json:"phone,omitempty"`
+	Website   string `json:"website,omitempty"`
+}
+
+type userEmail struct {
+	Email string `json:"email"`
+}
+
+// readUser retrieves the part of the user of the account behind the endpoint, and decodes it in v.
+func (c *Client) readUser(endpoint, kind string, errFailed error, v interface{}) RequestError {
+	respBody, statusCode, err := c.Get("GET", endpoint)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading %s: %w", kind, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", errFailed, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err = json.Unmarshal([]byte(respBody), v); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing %s: %w", kind, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadAccount retrieves the type and the reputation of the account and returns them.
+func (c *Client) ReadAccount() (*Account, RequestError) {
+	var account Account
+	if requestErr := c.readUser("/user/account", "account", ErrFailedReadingAccount, &account); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &account, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadProfile retrieves the profile of the user of the account and returns it.
+func (c *Client) ReadProfile() (*Profile, RequestError) {
+	var profile Profile
+	if requestErr := c.readUser("/user/profile", "profile", ErrFailedReadingProfile, &profile); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &profile, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateProfile changes the fields set in the profile of the user of the account, and returns the profile.
+// The profile of a subuser can be changed on behalf of it.
+func (c *Client) UpdateProfile(profile Profile) (*Profile, RequestError) {
+	respBody, statusCode, err := c.Post("PATCH", "/user/profile", profile)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating profile: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingProfile, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var updated Profile
+	if err = json.Unmarshal([]byte(respBody), &updated); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing profile: %w", err),
+		}
+	}
+
+	return &updated, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadUserEmail retrieves the email address of the user of the account and returns it.
+func (c *Client) ReadUserEmail() (string, RequestError) {
+	var email userEmail
+	if requestErr := c.readUser("/user/email", "user email", ErrFailedReadingUserEmail, &email); requestErr.Err != nil {
+		return "", requestErr
+	}
+
+	return email.Email, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
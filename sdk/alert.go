@@ -0,0 +1,179 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// AlertStatsNotification is the type of the alerts emailing the stats of the account at a frequency.
+	AlertStatsNotification = "stats_notification"
+
+	// AlertUsageLimit is the type of the alerts emailed once a percentage of the credits of the account is used.
+	AlertUsageLimit = "usage_limit"
+)
+
+// Alert is an email notification about the statistics or the usage of the account.
+// Frequency is only set for the stats notifications, Percentage only for the usage limits.
+type Alert struct {
+	ID         int64  `json:"id,omitempty"`
+	Type       string `json:"type,omitempty"`
+	EmailTo    string `json:"email_to"`
+	Frequency  string `json:"frequency,omitempty"`
+	Percentage int    `json:"percentage,omitempty"`
+	CreatedAt  int64  `json:"created_at,omitempty"`
+	UpdatedAt  int64  `json:"updated_at,omitempty"`
+}
+
+func parseAlert(respBody string) (*Alert, RequestError) {
+	var body Alert
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing alert: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ValidateAlert checks that the stats notifications have a frequency and no percentage,
+// and that the usage limits have a percentage between 1 and 100 and no frequency.
+func ValidateAlert(alert Alert) error {
+	switch alert.Type {
+	case AlertStatsNotification:
+		if alert.Frequency == "" {
+			return ErrAlertFrequencyRequired
+		}
+
+		if alert.Percentage != 0 {
+			return ErrAlertPercentageNotAllowed
+		}
+	case AlertUsageLimit:
+		if alert.Percentage < 1 || alert.Percentage > 100 {
+			return ErrInvalidAlertPercentage
+		}
+
+		if alert.Frequency != "" {
+			return ErrAlertFrequencyNotAllowed
+		}
+	default:
+		return ErrInvalidAlertType
+	}
+
+	return nil
+}
+
+// CreateAlert creates an alert and returns it.
+func (c *Client) CreateAlert(alert Alert) (*Alert, RequestError) {
+	if alert.EmailTo == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEmailRequired}
+	}
+
+	if err := ValidateAlert(alert); err != nil {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: err}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/alerts", alert)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating alert: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAlert, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseAlert(respBody)
+}
+
+// ReadAlert retrieves an alert by ID and returns it.
+func (c *Client) ReadAlert(id string) (*Alert, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrAlertIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/alerts/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading alert: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingAlert, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseAlert(respBody)
+}
+
+// UpdateAlert edits the recipient, and the frequency or the percentage of an alert, and returns it.
+// The type of an alert can't be changed.
+func (c *Client) UpdateAlert(id string, alert Alert) (*Alert, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrAlertIDRequired}
+	}
+
+	if err := ValidateAlert(alert); err != nil {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: err}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/alerts/"+id, Alert{
+		EmailTo:    alert.EmailTo,
+		Frequency:  alert.Frequency,
+		Percentage: alert.Percentage,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating alert: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingAlert, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseAlert(respBody)
+}
+
+// DeleteAlert deletes an alert.
+func (c *Client) DeleteAlert(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrAlertIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/alerts/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting alert: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAlert, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,36 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestValidateAlert(t *testing.T) {
+	tests := []struct {
+		alert sendgrid.Alert
+		err   error
+	}{
+		{sendgrid.Alert{Type: sendgrid.AlertStatsNotification, Frequency: "daily"}, nil},
+		{sendgrid.Alert{Type: sendgrid.AlertStatsNotification}, sendgrid.ErrAlertFrequencyRequired},
+		{
+			sendgrid.Alert{Type: sendgrid.AlertStatsNotification, Frequency: "daily", Percentage: 50},
+			sendgrid.ErrAlertPercentageNotAllowed,
+		},
+		{sendgrid.Alert{Type: sendgrid.AlertUsageLimit, Percentage: 100}, nil},
+		{sendgrid.Alert{Type: sendgrid.AlertUsageLimit}, sendgrid.ErrInvalidAlertPercentage},
+		{sendgrid.Alert{Type: sendgrid.AlertUsageLimit, Percentage: 101}, sendgrid.ErrInvalidAlertPercentage},
+		{
+			sendgrid.Alert{Type: sendgrid.AlertUsageLimit, Percentage: 90, Frequency: "daily"},
+			sendgrid.ErrAlertFrequencyNotAllowed,
+		},
+		{sendgrid.Alert{Type: "other"}, sendgrid.ErrInvalidAlertType},
+	}
+
+	for _, test := range tests {
+		if err := sendgrid.ValidateAlert(test.alert); !errors.Is(err, test.err) {
+			t.Errorf("unexpected error for %+v: %v, expected: %v", test.alert, err, test.err)
+		}
+	}
+}
@@ -14,6 +14,10 @@ type APIKey struct {
 	Scopes []string `json:"scopes,omitempty"`
 }
 
+type apiKeys struct {
+	Result []APIKey `json:"result"`
+}
+
 func parseAPIKey(respBody string) (*APIKey, RequestError) {
 	var body APIKey
 	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
@@ -48,14 +52,44 @@ func (c *Client) CreateAPIKey(name string, scopes []string) (*APIKey, RequestErr
 
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAPIKey, statusCode, respBody),
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingAPIKey, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
 		}
 	}
 
 	return parseAPIKey(respBody)
 }
 
+// ListAPIKeys retrieves the API keys, without their secret nor their scopes, and returns them.
+func (c *Client) ListAPIKeys() ([]APIKey, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/api_keys")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing API keys: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingAPIKeys, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body apiKeys
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing API keys: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
 // ReadAPIKey retreives an APIKey and returns it.
 func (c *Client) ReadAPIKey(id string) (*APIKey, RequestError) {
 	if id == "" {
@@ -65,7 +99,7 @@ func (c *Client) ReadAPIKey(id string) (*APIKey, RequestError) {
 		}
 	}
 
-	respBody, _, err := c.Get("GET", "/api_keys/"+id)
+	respBody, statusCode, err := c.Get("GET", "/api_keys/"+id)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -73,6 +107,14 @@ func (c *Client) ReadAPIKey(id string) (*APIKey, RequestError) {
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingAPIKey, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	return parseAPIKey(respBody)
 }
 
@@ -94,7 +136,7 @@ func (c *Client) UpdateAPIKey(id, name string, scopes []string) (*APIKey, Reques
 		t.Scopes = scopes
 	}
 
-	respBody, _, err := c.Post("PUT", "/api_keys/"+id, t)
+	respBody, statusCode, err := c.Post("PUT", "/api_keys/"+id, t)
 	if err != nil {
 		return nil, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -102,6 +144,14 @@ func (c *Client) UpdateAPIKey(id, name string, scopes []string) (*APIKey, Reques
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingAPIKey, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	return parseAPIKey(respBody)
 }
 
@@ -124,8 +174,9 @@ func (c *Client) DeleteAPIKey(id string) (bool, RequestError) {
 
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAPIKey, statusCode, responseBody),
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingAPIKey, statusCode, responseBody),
+			FieldErrors: parseFieldErrors(responseBody),
 		}
 	}
 
@@ -0,0 +1,59 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// automationsPageSize is the number of automations retrieved per call when listing automations.
+const automationsPageSize = 100
+
+// Automation is a Sendgrid marketing automation.
+type Automation struct {
+	ID     string `json:"id,omitempty"`
+	Name   string `json:"name,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+type automations struct {
+	Result   []Automation `json:"result"`
+	Metadata pageMetadata `json:"_metadata"`
+}
+
+func parseAutomations(respBody string) (*automations, RequestError) {
+	var body automations
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing automations: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListAutomations retrieves all the marketing automations, page by page, and returns them.
+func (c *Client) ListAutomations() ([]Automation, RequestError) {
+	var result []Automation
+
+	endpoint := "/marketing/automations?page_size=" + strconv.Itoa(automationsPageSize)
+
+	requestErr := c.followCursor("GET", endpoint, nil, "listing automations", ErrFailedListingAutomations,
+		func(respBody string) (int, pageMetadata, RequestError) {
+			page, requestErr := parseAutomations(respBody)
+			if requestErr.Err != nil {
+				return 0, pageMetadata{}, requestErr
+			}
+
+			result = append(result, page.Result...)
+
+			return len(page.Result), page.Metadata, requestErr
+		})
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,38 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestListAutomationsFollowsNextPage(t *testing.T) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprintf(w, `{"result": [{"id": "1", "name": "first", "status": "live"}],
+				"_metadata": {"next": "%s/v3/marketing/automations?page_size=100&page_token=second"}}`, server.URL)
+		case "second":
+			fmt.Fprint(w, `{"result": [{"id": "2", "name": "second", "status": "draft"}], "_metadata": {}}`)
+		default:
+			t.Errorf("unexpected page: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	automations, requestErr := c.ListAutomations()
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(automations) != 2 || automations[1].ID != "2" || automations[1].Status != "draft" {
+		t.Fatalf("unexpected automations: %+v", automations)
+	}
+}
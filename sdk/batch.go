@@ -0,0 +1,261 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	// ScheduledSendCancel is the status of scheduled sends which are canceled.
+	ScheduledSendCancel = "cancel"
+
+	// ScheduledSendPause is the status of scheduled sends which are paused.
+	ScheduledSendPause = "pause"
+)
+
+type batch struct {
+	BatchID string `json:"batch_id,omitempty"`
+}
+
+// ScheduledSend is the cancellation or the pause of the scheduled sends of a batch.
+type ScheduledSend struct {
+	BatchID string `json:"batch_id,omitempty"`
+	Status  string `json:"status"`
+}
+
+func parseBatch(respBody string) (string, RequestError) {
+	var body batch
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing batch: %w", err),
+		}
+	}
+
+	return body.BatchID, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateBatchID generates a new batch ID, used to group scheduled sends.
+func (c *Client) CreateBatchID() (string, RequestError) {
+	respBody, statusCode, err := c.Post("POST", "/mail/batch", batch{})
+	if err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating batch ID: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return "", RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingBatchID, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseBatch(respBody)
+}
+
+// ReadBatchID validates a batch ID and returns it.
+func (c *Client) ReadBatchID(batchID string) (string, RequestError) {
+	if batchID == "" {
+		return "", RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrBatchIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/mail/batch/"+batchID)
+	if err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading batch ID: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return "", RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingBatchID, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseBatch(respBody)
+}
+
+func validateScheduledSendStatus(status string) RequestError {
+	if status != ScheduledSendCancel && status != ScheduledSendPause {
+		return RequestError{
+			StatusCode: http.StatusNotAcceptable,
+			Err:        fmt.Errorf("%w: %s", ErrInvalidScheduledSendStatus, status),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// scheduledSendAlreadySent tells if the errors returned by Sendgrid report the sends of the batch
+// as already sent, they can't be canceled or paused anymore.
+func scheduledSendAlreadySent(statusCode int, respBody string) bool {
+	if statusCode != http.StatusBadRequest {
+		return false
+	}
+
+	var body apiErrors
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return false
+	}
+
+	for _, e := range body.Errors {
+		if strings.Contains(strings.ToLower(e.Message), "already") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateScheduledSend cancels or pauses the scheduled sends of a batch.
+// If the sends already went out, the returned error wraps ErrScheduledSendAlreadySent.
+func (c *Client) CreateScheduledSend(batchID, status string) (*ScheduledSend, RequestError) {
+	if batchID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrBatchIDRequired}
+	}
+
+	if requestErr := validateScheduledSendStatus(status); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/user/scheduled_sends", ScheduledSend{
+		BatchID: batchID,
+		Status:  status,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating scheduled send: %w", err),
+		}
+	}
+
+	if scheduledSendAlreadySent(statusCode, respBody) {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        fmt.Errorf("%w: %s", ErrScheduledSendAlreadySent, batchID),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingScheduledSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return &ScheduledSend{BatchID: batchID, Status: status}, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadScheduledSend retrieves the cancellation or the pause of the scheduled sends of a batch.
+func (c *Client) ReadScheduledSend(batchID string) ([]ScheduledSend, RequestError) {
+	if batchID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrBatchIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/user/scheduled_sends/"+batchID)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading scheduled send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingScheduledSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body []ScheduledSend
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing scheduled send: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateScheduledSend switches the scheduled sends of a batch between canceled and paused.
+// If the sends already went out, the returned error wraps ErrScheduledSendAlreadySent.
+func (c *Client) UpdateScheduledSend(batchID, status string) (*ScheduledSend, RequestError) {
+	if batchID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrBatchIDRequired}
+	}
+
+	if requestErr := validateScheduledSendStatus(status); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/user/scheduled_sends/"+batchID, ScheduledSend{
+		Status: status,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating scheduled send: %w", err),
+		}
+	}
+
+	if scheduledSendAlreadySent(statusCode, respBody) {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err:        fmt.Errorf("%w: %s", ErrScheduledSendAlreadySent, batchID),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingScheduledSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return &ScheduledSend{BatchID: batchID, Status: status}, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteScheduledSend removes the cancellation or the pause of the scheduled sends of a batch,
+// which are sent again as planned. If the sends already went out, the returned error wraps ErrScheduledSendAlreadySent.
+func (c *Client) DeleteScheduledSend(batchID string) (bool, RequestError) {
+	if batchID == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrBatchIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/user/scheduled_sends/"+batchID)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting scheduled send: %w", err),
+		}
+	}
+
+	if scheduledSendAlreadySent(statusCode, respBody) {
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err:        fmt.Errorf("%w: %s", ErrScheduledSendAlreadySent, batchID),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingScheduledSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,60 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestUpdateScheduledSend(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PATCH" || r.URL.Path != "/user/scheduled_sends/batch" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if string(body) != `{"status":"cancel"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	scheduledSend, requestErr := c.UpdateScheduledSend("batch", sendgrid.ScheduledSendCancel)
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if scheduledSend.Status != sendgrid.ScheduledSendCancel {
+		t.Errorf("expected status cancel, got %s", scheduledSend.Status)
+	}
+}
+
+func TestUpdateScheduledSendAlreadySent(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		//nolint:errcheck
+		w.Write([]byte(`{"errors":[{"field":null,"message":"batch id has already been processed"}]}`))
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, requestErr := c.UpdateScheduledSend("batch", sendgrid.ScheduledSendCancel)
+	if !errors.Is(requestErr, sendgrid.ErrScheduledSendAlreadySent) {
+		t.Fatalf("expected ErrScheduledSendAlreadySent, got %v", requestErr.Err)
+	}
+
+	_, requestErr = c.DeleteScheduledSend("batch")
+	if !errors.Is(requestErr, sendgrid.ErrScheduledSendAlreadySent) {
+		t.Fatalf("expected ErrScheduledSendAlreadySent, got %v", requestErr.Err)
+	}
+}
@@ -0,0 +1,99 @@
+package sendgrid
+
+import (
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// cachedResponse is a response to a GET request kept by the cache, loaded once by the first caller.
+type cachedResponse struct {
+	mu         sync.Mutex
+	loaded     bool
+	body       string
+	statusCode int
+}
+
+// cacheKey identifies a cached response, the subusers don't get the responses of each other.
+type cacheKey struct {
+	onBehalfOf string
+	endpoint   string
+}
+
+// responseCache keeps the successful responses to the GET requests of the cached clients, per subuser and endpoint,
+// for the lifetime of a client and its copies, i.e. a provider run.
+type responseCache struct {
+	mu        sync.Mutex
+	responses map[cacheKey]*cachedResponse
+}
+
+func newResponseCache() *responseCache {
+	return &responseCache{responses: map[cacheKey]*cachedResponse{}}
+}
+
+// endpointPath returns the path of an endpoint, without its query.
+func endpointPath(endpoint string) string {
+	if i := strings.IndexByte(endpoint, '?'); i >= 0 {
+		return endpoint[:i]
+	}
+
+	return endpoint
+}
+
+// isRelatedPath tells whether a write to a path may change the response of another, i.e. one is under the other.
+func isRelatedPath(path, written string) bool {
+	return path == written || strings.HasPrefix(path, written+"/") || strings.HasPrefix(written, path+"/")
+}
+
+func (r *responseCache) response(onBehalfOf, endpoint string) *cachedResponse {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := cacheKey{onBehalfOf: onBehalfOf, endpoint: endpoint}
+
+	response, ok := r.responses[key]
+	if !ok {
+		response = &cachedResponse{}
+		r.responses[key] = response
+	}
+
+	return response
+}
+
+// get returns the cached response to the endpoint, loading it on the first call. The concurrent calls wait for
+// the first one instead of calling Sendgrid too. The failed responses aren't kept, the next call retries.
+func (r *responseCache) get(
+	onBehalfOf, endpoint string,
+	load func() (string, int, error),
+) (string, int, error) {
+	response := r.response(onBehalfOf, endpoint)
+
+	response.mu.Lock()
+	defer response.mu.Unlock()
+
+	if response.loaded {
+		return response.body, response.statusCode, nil
+	}
+
+	body, statusCode, err := load()
+	if err == nil && statusCode < http.StatusMultipleChoices {
+		response.body, response.statusCode, response.loaded = body, statusCode, true
+	}
+
+	return body, statusCode, err
+}
+
+// invalidate drops the responses related to the written endpoint, of every subuser. A load in progress
+// completes for its callers, but isn't kept.
+func (r *responseCache) invalidate(endpoint string) {
+	written := endpointPath(endpoint)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for key := range r.responses {
+		if isRelatedPath(endpointPath(key.endpoint), written) {
+			delete(r.responses, key)
+		}
+	}
+}
@@ -1,30 +1,175 @@
 package sendgrid
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"net/http"
 
 	"github.com/sendgrid/rest"
 	"github.com/sendgrid/sendgrid-go"
 )
 
-// Client is a Sendgrid client.
+// maxIdleConnsPerHost is the number of connections to Sendgrid kept alive between calls,
+// the default of 2 makes the parallel calls of Terraform open a new connection each time.
+const maxIdleConnsPerHost = 32
+
+const (
+	// GlobalHost is the base URL of the API of the global region, used by default.
+	GlobalHost = "https://api.sendgrid.com/v3"
+
+	// EUHost is the base URL of the API of the EU region, the API keys of each region only work with its host.
+	EUHost = "https://api.eu.sendgrid.com/v3"
+)
+
+// Client is a Sendgrid client, safe for concurrent use. Its copies, e.g. made by WithOnBehalfOf,
+// share its HTTP client and thus its connections, and the responses it cached.
 type Client struct {
 	apiKey     string
 	host       string
 	OnBehalfOf string
+	Backoff    Backoff
+	slots      chan struct{}
+	rest       *rest.Client
+	cache      *responseCache
+	cached     bool
+	// ctx is the context of the operation a copy made by WithContext is bound to.
+	ctx context.Context //nolint:containedctx
+}
+
+// newRESTClient creates a REST client whose connections are kept alive and reused by the concurrent calls.
+func newRESTClient() *rest.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = maxIdleConnsPerHost
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+
+	return &rest.Client{HTTPClient: &http.Client{Transport: transport}}
 }
 
 // NewClient creates a Sendgrid Client.
 func NewClient(apiKey, host, onBehalfOf string) *Client {
 	if host == "" {
-		host = "https://api.sendgrid.com/v3"
+		host = GlobalHost
 	}
 
 	return &Client{
 		apiKey:     apiKey,
 		host:       host,
 		OnBehalfOf: onBehalfOf,
+		Backoff: Backoff{
+			BaseDelay: DefaultRetryBaseDelay,
+			MaxDelay:  DefaultRetryMaxDelay,
+		},
+		rest:  newRESTClient(),
+		cache: newResponseCache(),
+	}
+}
+
+// SetParallelism limits the number of concurrent calls to the Sendgrid API,
+// 0 means unlimited.
+func (c *Client) SetParallelism(parallelism int) {
+	if parallelism <= 0 {
+		c.slots = nil
+
+		return
+	}
+
+	c.slots = make(chan struct{}, parallelism)
+}
+
+// Host returns the base URL of the API the client calls.
+func (c *Client) Host() string {
+	return c.host
+}
+
+// WithHost returns a copy of the client calling the API at the given base URL.
+// The copy doesn't share the responses cached by the client, they belong to another API.
+func (c *Client) WithHost(host string) *Client {
+	scoped := *c
+	scoped.host = host
+	scoped.cache = newResponseCache()
+
+	return &scoped
+}
+
+// Cached returns a copy of the client whose GET requests are cached for the lifetime of the client and its copies,
+// i.e. a provider run, until a write to a related path, e.g. /marketing/field_definitions/id for
+// /marketing/field_definitions. It's meant for the metadata which rarely changes, e.g. the scopes or the IPs,
+// read by many resources to validate them, not for the resources themselves.
+func (c *Client) Cached() *Client {
+	cached := *c
+	cached.cached = true
+
+	return &cached
+}
+
+// WithOnBehalfOf returns a copy of the client making its calls on behalf of the given subuser,
+// or the client itself if no subuser is given. The copy shares the parallelism of the client.
+func (c *Client) WithOnBehalfOf(subUser string) *Client {
+	if subUser == "" {
+		return c
+	}
+
+	scoped := *c
+	scoped.OnBehalfOf = subUser
+
+	return &scoped
+}
+
+// WithContext returns a copy of the client bound to the context of the operation being applied:
+// the requests it sends aren't retried anymore once the context is cancelled or its deadline is reached.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	bound := *c
+	bound.ctx = ctx
+
+	return &bound
+}
+
+// operationContext returns the context the client is bound to, the background context if it isn't bound to any.
+func (c *Client) operationContext() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+
+	return c.ctx
+}
+
+// WithoutOnBehalfOf returns a copy of the client making its calls as the parent account,
+// or the client itself if it isn't making its calls on behalf of a subuser.
+func (c *Client) WithoutOnBehalfOf() *Client {
+	if c.OnBehalfOf == "" {
+		return c
+	}
+
+	parent := *c
+	parent.OnBehalfOf = ""
+
+	return &parent
+}
+
+// send sends a request to Sendgrid, waiting for a free slot if the parallelism is limited.
+// The request is logged, without its sensitive fields, when Terraform logs at the DEBUG level.
+// The idempotent requests are sent again on transient server errors, the slot is released while waiting,
+// until the context of the operation is done.
+func (c *Client) send(req rest.Request) (*rest.Response, error) {
+	return c.retryTransient(c.operationContext(), req, func() (*rest.Response, error) {
+		if c.slots != nil {
+			c.slots <- struct{}{}
+			defer func() { <-c.slots }()
+		}
+
+		resp, err := c.rest.Send(req)
+		logRequest(req, resp, err)
+
+		return resp, err
+	})
+}
+
+// invalidate drops the cached responses the write to the endpoint may have changed, even if it failed:
+// it may have been applied anyway.
+func (c *Client) invalidate(endpoint string) {
+	if c.cache != nil {
+		c.cache.invalidate(endpoint)
 	}
 }
 
@@ -41,8 +186,19 @@ func bodyToJSON(body interface{}) ([]byte, error) {
 	return jsonBody, nil
 }
 
-// Get gets a resource from Sendgrid.
+// Get gets a resource from Sendgrid, it sends the requests without body of any method, e.g. DELETE.
+// The GET requests of a cached client are served from the cache.
 func (c *Client) Get(method rest.Method, endpoint string) (string, int, error) {
+	if method == rest.Get && c.cached && c.cache != nil {
+		return c.cache.get(c.OnBehalfOf, endpoint, func() (string, int, error) {
+			return c.get(method, endpoint)
+		})
+	}
+
+	return c.get(method, endpoint)
+}
+
+func (c *Client) get(method rest.Method, endpoint string) (string, int, error) {
 	var req rest.Request
 	if c.OnBehalfOf != "" {
 		req = sendgrid.GetRequestSubuser(c.apiKey, endpoint, c.host, c.OnBehalfOf)
@@ -52,15 +208,20 @@ func (c *Client) Get(method rest.Method, endpoint string) (string, int, error) {
 
 	req.Method = method
 
-	resp, err := sendgrid.API(req)
+	resp, err := c.send(req)
+	if method != rest.Get {
+		c.invalidate(endpoint)
+	}
+
 	if err != nil {
-		return "", resp.StatusCode, fmt.Errorf("failed getting resource: %w", err)
+		// there is no response when the request failed.
+		return "", 0, fmt.Errorf("failed getting resource: %w", err)
 	}
 
 	return resp.Body, resp.StatusCode, nil
 }
 
-// Post posts a resource to Sendgrid.
+// Post posts a resource to Sendgrid, it sends the requests with a JSON body of any method, e.g. PUT or PATCH.
 func (c *Client) Post(method rest.Method, endpoint string, body interface{}) (string, int, error) {
 	var err error
 
@@ -79,9 +240,12 @@ func (c *Client) Post(method rest.Method, endpoint string, body interface{}) (st
 		return "", 0, fmt.Errorf("failed preparing request body: %w", err)
 	}
 
-	resp, err := sendgrid.API(req)
+	resp, err := c.send(req)
+	c.invalidate(endpoint)
+
 	if err != nil {
-		return "", resp.StatusCode, fmt.Errorf("failed posting resource: %w", err)
+		// there is no response when the request failed.
+		return "", 0, fmt.Errorf("failed posting resource: %w", err)
 	}
 
 	return resp.Body, resp.StatusCode, nil
@@ -0,0 +1,344 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestClientParallelism(t *testing.T) {
+	var inFlight, maxInFlight int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+
+		for {
+			max := atomic.LoadInt32(&maxInFlight)
+			if current <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, current) {
+				break
+			}
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	c.SetParallelism(2)
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if _, _, err := c.Get("GET", "/scopes"); err != nil {
+				t.Errorf("unexpected error: %s", err)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 concurrent requests, got %d", maxInFlight)
+	}
+}
+
+func TestClientWithOnBehalfOf(t *testing.T) {
+	headers := make(chan string, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get("On-Behalf-Of")
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, _, err := c.WithOnBehalfOf("subuser").Get("GET", "/scopes"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, _, err := c.Get("GET", "/scopes"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := <-headers; got != "subuser" {
+		t.Fatalf("expected the scoped client to be on behalf of subuser, got %q", got)
+	}
+
+	if got := <-headers; got != "" {
+		t.Fatalf("expected the client to be left unchanged, got %q", got)
+	}
+}
+
+func TestClientWithoutOnBehalfOf(t *testing.T) {
+	headers := make(chan string, 2)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers <- r.Header.Get("On-Behalf-Of")
+
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "subuser")
+
+	if _, _, err := c.WithoutOnBehalfOf().Get("GET", "/scopes"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if _, _, err := c.Get("GET", "/scopes"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := <-headers; got != "" {
+		t.Fatalf("expected the parent client not to be on behalf of a subuser, got %q", got)
+	}
+
+	if got := <-headers; got != "subuser" {
+		t.Fatalf("expected the client to be left unchanged, got %q", got)
+	}
+}
+
+func TestClientConcurrentRequestsReuseConnections(t *testing.T) {
+	const workers, requests = 8, 25
+
+	var connections int32
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{}`)
+	}))
+	server.Config.ConnState = func(_ net.Conn, state http.ConnState) {
+		if state == http.StateNew {
+			atomic.AddInt32(&connections, 1)
+		}
+	}
+	server.Start()
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			// the scoped copies share the connections of the client.
+			scoped := c
+			if i%2 == 0 {
+				scoped = c.WithOnBehalfOf("subuser")
+			}
+
+			for j := 0; j < requests; j++ {
+				if _, _, err := scoped.Get("GET", "/scopes"); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+
+				if _, _, err := scoped.Post("POST", "/scopes", struct{}{}); err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			}
+		}(i)
+	}
+
+	wg.Wait()
+
+	if connections > workers {
+		t.Fatalf("expected at most %d connections to be opened, got %d", workers, connections)
+	}
+}
+
+func TestClientPostPatch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != http.MethodPatch || r.URL.Path != "/marketing/lists/1" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+
+		if r.Header.Get("Content-Type") != "application/json" || string(body) != `{"name":"list"}` {
+			t.Errorf("unexpected body: %s (%s)", body, r.Header.Get("Content-Type"))
+		}
+
+		w.WriteHeader(http.StatusOK)
+		fmt.Fprint(w, `{"id": "1", "name": "list"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	respBody, statusCode, err := c.Post("PATCH", "/marketing/lists/1", map[string]string{"name": "list"})
+	if err != nil || statusCode != http.StatusOK || respBody != `{"id": "1", "name": "list"}` {
+		t.Fatalf("unexpected response: %d, %s, %v", statusCode, respBody, err)
+	}
+}
+
+func TestClientGetDelete(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != http.MethodDelete || r.URL.Path != "/marketing/lists/1" || len(body) != 0 {
+			t.Errorf("unexpected request: %s %s, body: %s", r.Method, r.URL.Path, body)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, statusCode, err := c.Get("DELETE", "/marketing/lists/1"); err != nil || statusCode != http.StatusNoContent {
+		t.Fatalf("unexpected response: %d, %v", statusCode, err)
+	}
+}
+
+func TestClientRequestFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, statusCode, err := c.Get("GET", "/scopes"); err == nil || statusCode != 0 {
+		t.Fatalf("expected the failed request to return an error without status, got %d, %v", statusCode, err)
+	}
+}
+
+func TestClientListScopesIsCached(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		fmt.Fprintf(w, `{"scopes": ["mail.send", %q]}`, r.Header.Get("On-Behalf-Of"))
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	for i := 0; i < 3; i++ {
+		scopes, requestErr := c.ListScopes()
+		if requestErr.Err != nil {
+			t.Fatalf("unexpected error: %s", requestErr.Err)
+		}
+
+		if len(scopes) != 2 || scopes[0] != "mail.send" {
+			t.Fatalf("unexpected scopes: %v", scopes)
+		}
+
+		// modifying the returned scopes must not modify the cached ones.
+		scopes[0] = "modified"
+	}
+
+	// the copies share the cache, but not the scopes of another subuser.
+	if _, requestErr := c.WithOnBehalfOf("subuser").ListScopes(); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if _, requestErr := c.WithOnBehalfOf("subuser").ListScopes(); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls to Sendgrid, got %d", calls)
+	}
+}
+
+func TestClientCachedInvalidatedByWrites(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			atomic.AddInt32(&calls, 1)
+		}
+
+		fmt.Fprint(w, `{"custom_fields": [], "reserved_fields": []}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	get := func(c *sendgrid.Client, endpoint string) {
+		if _, _, err := c.Get("GET", endpoint); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	expectCalls := func(expected int32) {
+		t.Helper()
+
+		if calls != expected {
+			t.Fatalf("expected %d calls to Sendgrid, got %d", expected, calls)
+		}
+	}
+
+	get(c.Cached(), "/marketing/field_definitions")
+	get(c.WithOnBehalfOf("subuser").Cached(), "/marketing/field_definitions")
+	get(c.Cached(), "/marketing/field_definitions")
+	get(c.Cached().WithOnBehalfOf("subuser"), "/marketing/field_definitions")
+	expectCalls(2)
+
+	// the clients which aren't cached always call Sendgrid.
+	get(c, "/marketing/field_definitions")
+	expectCalls(3)
+
+	// an unrelated write keeps the responses.
+	if _, _, err := c.Post("PUT", "/marketing/contacts", struct{}{}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	get(c.Cached(), "/marketing/field_definitions")
+	expectCalls(3)
+
+	// a write to a related path drops the responses of every subuser.
+	if _, _, err := c.Get("DELETE", "/marketing/field_definitions/id"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	get(c.Cached(), "/marketing/field_definitions")
+	get(c.WithOnBehalfOf("subuser").Cached(), "/marketing/field_definitions")
+	expectCalls(5)
+}
+
+func TestClientCachedKeepsNoFailure(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+
+			return
+		}
+
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "").Cached()
+
+	for _, expected := range []int{http.StatusTooManyRequests, http.StatusOK, http.StatusOK} {
+		if _, statusCode, err := c.Get("GET", "/ips?limit=100&offset=0"); err != nil || statusCode != expected {
+			t.Fatalf("expected status %d, got %d, %v", expected, statusCode, err)
+		}
+	}
+
+	if calls != 2 {
+		t.Fatalf("expected 2 calls to Sendgrid, got %d", calls)
+	}
+}
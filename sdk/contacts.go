@@ -0,0 +1,535 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// FieldTypeText is the type of the custom fields holding text.
+	FieldTypeText = "Text"
+
+	// FieldTypeNumber is the type of the custom fields holding numbers.
+	FieldTypeNumber = "Number"
+
+	// FieldTypeDate is the type of the custom fields holding dates.
+	FieldTypeDate = "Date"
+
+	// fieldDateLayout is the layout of the dates accepted without a time.
+	fieldDateLayout = "2006-01-02"
+)
+
+const (
+	// ContactsImportStatusPending is the status of a job upserting contacts which isn't done yet.
+	ContactsImportStatusPending = "pending"
+
+	// ContactsImportStatusCompleted is the status of a job which upserted all the contacts.
+	ContactsImportStatusCompleted = "completed"
+
+	// ContactsImportStatusErrored is the status of a job which failed to upsert some of the contacts.
+	ContactsImportStatusErrored = "errored"
+
+	// ContactsImportStatusFailed is the status of a job which failed to upsert the contacts.
+	ContactsImportStatusFailed = "failed"
+)
+
+// contactsSearchEmailsPageSize is the number of emails searched per call, the maximum allowed.
+const contactsSearchEmailsPageSize = 100
+
+// Contact is a marketing contact, its custom fields are keyed by name.
+type Contact struct {
+	ID           string                 `json:"id"`
+	Email        string                 `json:"email"`
+	FirstName    string                 `json:"first_name,omitempty"`
+	LastName     string                 `json:"last_name,omitempty"`
+	ListIDs      []string               `json:"list_ids,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+	CreatedAt    string                 `json:"created_at,omitempty"`
+	UpdatedAt    string                 `json:"updated_at,omitempty"`
+}
+
+// ContactRequest is a marketing contact to create or update, its custom fields are keyed by ID
+// and their values must match their type, see FieldDefinition.Coerce.
+type ContactRequest struct {
+	Email        string                 `json:"email"`
+	FirstName    string                 `json:"first_name,omitempty"`
+	LastName     string                 `json:"last_name,omitempty"`
+	CustomFields map[string]interface{} `json:"custom_fields,omitempty"`
+}
+
+// FieldDefinition is the definition of a custom field of the marketing contacts.
+type FieldDefinition struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	FieldType string `json:"field_type"`
+}
+
+type fieldDefinitions struct {
+	CustomFields   []FieldDefinition `json:"custom_fields"`
+	ReservedFields []FieldDefinition `json:"reserved_fields"`
+}
+
+type contactsUpsert struct {
+	ListIDs  []string         `json:"list_ids,omitempty"`
+	Contacts []ContactRequest `json:"contacts"`
+}
+
+type contactsJob struct {
+	JobID string `json:"job_id"`
+}
+
+// ContactsImport is the job upserting marketing contacts asynchronously.
+type ContactsImport struct {
+	ID      string                `json:"id"`
+	Status  string                `json:"status"`
+	Results ContactsImportResults `json:"results"`
+}
+
+// ContactsImportResults are the numbers of contacts upserted by a job, and the ones which failed.
+type ContactsImportResults struct {
+	RequestedCount int    `json:"requested_count"`
+	CreatedCount   int    `json:"created_count"`
+	UpdatedCount   int    `json:"updated_count"`
+	ErroredCount   int    `json:"errored_count"`
+	ErrorsURL      string `json:"errors_url,omitempty"`
+}
+
+type contactsSearchEmails struct {
+	Emails []string `json:"emails"`
+}
+
+type contactsByEmail struct {
+	Result map[string]struct {
+		Contact Contact `json:"contact"`
+	} `json:"result"`
+}
+
+type contactsSearch struct {
+	Query string `json:"query"`
+}
+
+type contacts struct {
+	Result       []Contact    `json:"result"`
+	ContactCount int          `json:"contact_count"`
+	Metadata     pageMetadata `json:"_metadata"`
+}
+
+// SearchContacts retrieves all the marketing contacts matching the SGQL query, e.g. email LIKE '%@example.org',
+// following the cursors of the pages of results, and returns them.
+func (c *Client) SearchContacts(query string) ([]Contact, RequestError) {
+	if query == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactsQueryRequired}
+	}
+
+	var result []Contact
+
+	requestErr := c.followCursor(
+		"POST", "/marketing/contacts/search", contactsSearch{Query: query}, "searching contacts", ErrFailedSearchingContacts,
+		func(respBody string) (int, pageMetadata, RequestError) {
+			var page contacts
+			if err := json.Unmarshal([]byte(respBody), &page); err != nil {
+				return 0, pageMetadata{}, RequestError{
+					StatusCode: http.StatusInternalServerError,
+					Err:        fmt.Errorf("failed parsing contacts: %w", err),
+				}
+			}
+
+			result = append(result, page.Result...)
+
+			return len(page.Result), page.Metadata, RequestError{StatusCode: http.StatusOK, Err: nil}
+		})
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CountContacts returns the number of marketing contacts matching the SGQL query, without retrieving them.
+func (c *Client) CountContacts(query string) (int, RequestError) {
+	if query == "" {
+		return 0, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactsQueryRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/marketing/contacts/search", contactsSearch{Query: query})
+	if err != nil {
+		return 0, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed searching contacts: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return 0, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedSearchingContacts, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body contacts
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return 0, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contacts: %w", err),
+		}
+	}
+
+	return body.ContactCount, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// Coerce converts a value of the custom field to its type, so that it's sent as a JSON number for a Number field,
+// and as an ISO 8601 date for a Date field. Dates are accepted in the RFC 3339 and YYYY-MM-DD formats.
+func (f FieldDefinition) Coerce(value string) (interface{}, error) {
+	switch f.FieldType {
+	case FieldTypeNumber:
+		number, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s isn't a number: %q", ErrInvalidCustomFieldValue, f.Name, value)
+		}
+
+		return number, nil
+	case FieldTypeDate:
+		date, err := ParseFieldDate(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s isn't a date: %q", ErrInvalidCustomFieldValue, f.Name, value)
+		}
+
+		return date.Format(time.RFC3339), nil
+	default:
+		return value, nil
+	}
+}
+
+// ParseFieldDate parses the value of a Date custom field, in the RFC 3339 or YYYY-MM-DD format.
+func ParseFieldDate(value string) (time.Time, error) {
+	date, err := time.Parse(time.RFC3339, value)
+	if err == nil {
+		return date.UTC(), nil
+	}
+
+	date, err = time.Parse(fieldDateLayout, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed parsing date: %w", err)
+	}
+
+	return date, nil
+}
+
+func (c *Client) readFieldDefinitions() (*fieldDefinitions, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/marketing/field_definitions")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing field definitions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingFieldDefinitions, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body fieldDefinitions
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing field definitions: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListFieldDefinitions retrieves the definitions of the custom fields of the marketing contacts and returns them.
+func (c *Client) ListFieldDefinitions() ([]FieldDefinition, RequestError) {
+	body, requestErr := c.readFieldDefinitions()
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return body.CustomFields, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListReservedFieldDefinitions retrieves the definitions of the fields Sendgrid defines for every marketing contact,
+// e.g. email or list_ids, and returns them.
+func (c *Client) ListReservedFieldDefinitions() ([]FieldDefinition, RequestError) {
+	body, requestErr := c.readFieldDefinitions()
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return body.ReservedFields, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpsertContacts creates or updates marketing contacts, adding them to the lists, and returns the ID of the job.
+// The contacts are upserted asynchronously, they can't be read until the job is done.
+func (c *Client) UpsertContacts(listIDs []string, contacts []ContactRequest) (string, RequestError) {
+	for _, contact := range contacts {
+		if contact.Email == "" {
+			return "", RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactEmailRequired}
+		}
+	}
+
+	respBody, statusCode, err := c.Post("PUT", "/marketing/contacts", contactsUpsert{
+		ListIDs:  listIDs,
+		Contacts: contacts,
+	})
+	if err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed upserting contacts: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return "", RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpsertingContacts, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body contactsJob
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contacts job: %w", err),
+		}
+	}
+
+	return body.JobID, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadContact retrieves a marketing contact by ID and returns it.
+func (c *Client) ReadContact(id string) (*Contact, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/contacts/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading contact: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingContact, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body Contact
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contact: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadContactByEmail retrieves a marketing contact by email and returns it,
+// it isn't found until the job upserting it is done.
+func (c *Client) ReadContactByEmail(email string) (*Contact, RequestError) {
+	if email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/marketing/contacts/search/emails", contactsSearchEmails{
+		Emails: []string{email},
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading contact: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingContact, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body contactsByEmail
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contact: %w", err),
+		}
+	}
+
+	for _, result := range body.Result {
+		if strings.EqualFold(result.Contact.Email, email) {
+			contact := result.Contact
+
+			return &contact, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{
+		StatusCode:  http.StatusNotFound,
+		Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingContact, http.StatusNotFound, respBody),
+		FieldErrors: parseFieldErrors(respBody),
+	}
+}
+
+// ReadContactsByEmails retrieves the marketing contacts with the given emails, a hundred at a time,
+// and returns the ones found. The contacts aren't found until the job upserting them is done.
+func (c *Client) ReadContactsByEmails(emails []string) ([]Contact, RequestError) {
+	var found []Contact
+
+	for start := 0; start < len(emails); start += contactsSearchEmailsPageSize {
+		end := start + contactsSearchEmailsPageSize
+		if end > len(emails) {
+			end = len(emails)
+		}
+
+		respBody, statusCode, err := c.Post("POST", "/marketing/contacts/search/emails", contactsSearchEmails{
+			Emails: emails[start:end],
+		})
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed reading contacts: %w", err),
+			}
+		}
+
+		// Sendgrid answers not found when none of the emails is found.
+		if statusCode == http.StatusNotFound {
+			continue
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingContact, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		var body contactsByEmail
+		if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing contacts: %w", err),
+			}
+		}
+
+		// the emails which aren't found have an error instead of a contact.
+		for _, result := range body.Result {
+			if result.Contact.ID != "" {
+				found = append(found, result.Contact)
+			}
+		}
+	}
+
+	return found, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadContactsImport retrieves the job upserting marketing contacts, by the ID returned by UpsertContacts.
+func (c *Client) ReadContactsImport(id string) (*ContactsImport, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactsImportIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/contacts/imports/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading contacts import: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingContactsImport, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body ContactsImport
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contacts import: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteContacts deletes marketing contacts by ID, asynchronously.
+func (c *Client) DeleteContacts(ids []string) (bool, RequestError) {
+	if len(ids) == 0 {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactIDRequired}
+	}
+
+	query := url.Values{}
+	query.Set("ids", strings.Join(ids, ","))
+
+	respBody, statusCode, err := c.Get("DELETE", "/marketing/contacts?"+query.Encode())
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting contacts: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingContacts, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// RemoveContactFromList removes a marketing contact from a list, without deleting it.
+func (c *Client) RemoveContactFromList(listID, contactID string) (bool, RequestError) {
+	if contactID == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactIDRequired}
+	}
+
+	query := url.Values{}
+	query.Set("contact_ids", contactID)
+
+	respBody, statusCode, err := c.Get("DELETE", "/marketing/lists/"+listID+"/contacts?"+query.Encode())
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed removing contact from list: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedRemovingContactFromList, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,100 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ContactsExportStatusPending is the status of a contacts export which isn't ready yet.
+	ContactsExportStatusPending = "pending"
+
+	// ContactsExportStatusReady is the status of a contacts export whose files can be downloaded.
+	ContactsExportStatusReady = "ready"
+
+	// ContactsExportStatusFailure is the status of a contacts export which failed.
+	ContactsExportStatusFailure = "failure"
+)
+
+// ContactsExportRequest is the contacts to export, all of them if no list nor segment is given.
+type ContactsExportRequest struct {
+	ListIDs    []string `json:"list_ids,omitempty"`
+	SegmentIDs []string `json:"segment_ids,omitempty"`
+	FileType   string   `json:"file_type,omitempty"`
+}
+
+// ContactsExport is an asynchronous export of contacts.
+type ContactsExport struct {
+	ID           string   `json:"id"`
+	Status       string   `json:"status,omitempty"`
+	URLs         []string `json:"urls,omitempty"`
+	Message      string   `json:"message,omitempty"`
+	ContactCount int      `json:"contact_count,omitempty"`
+	CreatedAt    string   `json:"created_at,omitempty"`
+	CompletedAt  string   `json:"completed_at,omitempty"`
+	ExpiresAt    string   `json:"expires_at,omitempty"`
+}
+
+func parseContactsExport(respBody string) (*ContactsExport, RequestError) {
+	var body ContactsExport
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contacts export: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateContactsExport starts an export of contacts and returns it, its files are available
+// once ReadContactsExport returns it with the ready status.
+func (c *Client) CreateContactsExport(export ContactsExportRequest) (*ContactsExport, RequestError) {
+	respBody, statusCode, err := c.Post("POST", "/marketing/contacts/exports", export)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating contacts export: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedCreatingContactsExport, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseContactsExport(respBody)
+}
+
+// ReadContactsExport retrieves an export of contacts and returns it.
+func (c *Client) ReadContactsExport(id string) (*ContactsExport, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrContactsExportIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/contacts/exports/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading contacts export: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedReadingContactsExport, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseContactsExport(respBody)
+}
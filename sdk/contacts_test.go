@@ -0,0 +1,207 @@
+package sendgrid_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestSearchContactsFollowsCursor(t *testing.T) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "POST" || r.URL.Path != "/marketing/contacts/search" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// the query is sent again with every page.
+		if string(body) != `{"query":"email LIKE '%@example.org'"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprintf(w, `{"result": [{"id": "1", "email": "first@example.org"}],
+				"_metadata": {"next": "%s/v3/marketing/contacts/search?page_token=second%%3D"}}`, server.URL)
+		case "second=":
+			fmt.Fprint(w, `{"result": [{"id": "2", "email": "second@example.org"}], "_metadata": {}}`)
+		default:
+			t.Errorf("unexpected page: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	contacts, requestErr := c.SearchContacts("email LIKE '%@example.org'")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(contacts) != 2 || contacts[1].ID != "2" || contacts[1].Email != "second@example.org" {
+		t.Fatalf("unexpected contacts: %+v", contacts)
+	}
+}
+
+func TestFieldDefinitionCoerce(t *testing.T) {
+	number := sendgrid.FieldDefinition{ID: "e1_N", Name: "age", FieldType: sendgrid.FieldTypeNumber}
+	date := sendgrid.FieldDefinition{ID: "e2_D", Name: "signed_up_at", FieldType: sendgrid.FieldTypeDate}
+	text := sendgrid.FieldDefinition{ID: "e3_T", Name: "nickname", FieldType: sendgrid.FieldTypeText}
+
+	tests := []struct {
+		definition sendgrid.FieldDefinition
+		value      string
+		expected   interface{}
+	}{
+		{definition: number, value: "42", expected: float64(42)},
+		{definition: number, value: "4.2", expected: 4.2},
+		{definition: date, value: "2021-03-14", expected: "2021-03-14T00:00:00Z"},
+		{definition: date, value: "2021-03-14T16:09:26+01:00", expected: "2021-03-14T15:09:26Z"},
+		{definition: text, value: "42", expected: "42"},
+	}
+
+	for _, test := range tests {
+		coerced, err := test.definition.Coerce(test.value)
+		if err != nil {
+			t.Fatalf("unexpected error coercing %q: %s", test.value, err)
+		}
+
+		if coerced != test.expected {
+			t.Errorf("expected %q to be coerced to %#v, got %#v", test.value, test.expected, coerced)
+		}
+	}
+
+	for _, invalid := range []struct {
+		definition sendgrid.FieldDefinition
+		value      string
+	}{{definition: number, value: "forty-two"}, {definition: date, value: "14/03/2021"}} {
+		if _, err := invalid.definition.Coerce(invalid.value); !errors.Is(err, sendgrid.ErrInvalidCustomFieldValue) {
+			t.Errorf("expected %q to be rejected, got %v", invalid.value, err)
+		}
+	}
+}
+
+func TestUpsertContactsSendsTypedCustomFields(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PUT" || r.URL.Path != "/marketing/contacts" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if string(body) != `{"contacts":[{"email":"john@example.org","custom_fields":{"e1_N":42}}]}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"job_id": "job"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	age, _ := sendgrid.FieldDefinition{ID: "e1_N", Name: "age", FieldType: sendgrid.FieldTypeNumber}.Coerce("42")
+
+	jobID, requestErr := c.UpsertContacts(nil, []sendgrid.ContactRequest{{
+		Email:        "john@example.org",
+		CustomFields: map[string]interface{}{"e1_N": age},
+	}})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if jobID != "job" {
+		t.Fatalf("unexpected job ID: %s", jobID)
+	}
+}
+
+func TestReadContactsByEmailsBatches(t *testing.T) {
+	var batches []int
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "POST" || r.URL.Path != "/marketing/contacts/search/emails" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		var body struct {
+			Emails []string `json:"emails"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("unexpected body: %s", err)
+		}
+
+		batches = append(batches, len(body.Emails))
+
+		// none of the emails of the second batch is found.
+		if len(batches) == 2 {
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprint(w, `{"errors": [{"message": "No contacts found"}]}`)
+
+			return
+		}
+
+		fmt.Fprintf(w, `{"result": {
+			"%s": {"contact": {"id": "1", "email": "%s", "list_ids": ["list"]}},
+			"%s": {"error": "contact not found"}
+		}}`, body.Emails[0], body.Emails[0], body.Emails[1])
+	}))
+	defer server.Close()
+
+	emails := make([]string, 150)
+	for i := range emails {
+		emails[i] = fmt.Sprintf("contact%d@example.org", i)
+	}
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	contacts, requestErr := c.ReadContactsByEmails(emails)
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(batches) != 2 || batches[0] != 100 || batches[1] != 50 {
+		t.Fatalf("unexpected batches: %v", batches)
+	}
+
+	if len(contacts) != 1 || contacts[0].Email != "contact0@example.org" || contacts[0].ListIDs[0] != "list" {
+		t.Fatalf("unexpected contacts: %+v", contacts)
+	}
+}
+
+func TestReadContactsImport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/marketing/contacts/imports/job" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		fmt.Fprint(w, `{"id": "job", "status": "errored", "results": {
+			"requested_count": 2, "created_count": 1, "errored_count": 1, "errors_url": "https://example.org/errors"
+		}}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	job, requestErr := c.ReadContactsImport("job")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if job.Status != sendgrid.ContactsImportStatusErrored || job.Results.ErroredCount != 1 ||
+		job.Results.ErrorsURL != "https://example.org/errors" {
+		t.Fatalf("unexpected import: %+v", job)
+	}
+
+	if _, requestErr := c.ReadContactsImport(""); !errors.Is(requestErr.Err, sendgrid.ErrContactsImportIDRequired) {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+}
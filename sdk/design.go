@@ -0,0 +1,167 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// Design is a reusable design of the Design Library.
+type Design struct {
+	ID                   string   `json:"id,omitempty"`
+	Name                 string   `json:"name,omitempty"`
+	HTMLContent          string   `json:"html_content,omitempty"`
+	PlainContent         string   `json:"plain_content,omitempty"`
+	GeneratePlainContent bool     `json:"generate_plain_content"`
+	Subject              string   `json:"subject,omitempty"`
+	Editor               string   `json:"editor,omitempty"`
+	Categories           []string `json:"categories"`
+	ThumbnailURL         string   `json:"thumbnail_url,omitempty"`
+	CreatedAt            string   `json:"created_at,omitempty"`
+	UpdatedAt            string   `json:"updated_at,omitempty"`
+}
+
+type designDuplicate struct {
+	Name   string `json:"name,omitempty"`
+	Editor string `json:"editor,omitempty"`
+}
+
+func parseDesign(respBody string) (*Design, RequestError) {
+	var body Design
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing design: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateDesign creates a design and returns it.
+func (c *Client) CreateDesign(design Design) (*Design, RequestError) {
+	if design.Name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDesignNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/designs", design)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating design: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingDesign, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDesign(respBody)
+}
+
+// DuplicatePrebuiltDesign creates a design from a pre-built design of Sendgrid and returns it.
+func (c *Client) DuplicatePrebuiltDesign(prebuiltID, name, editor string) (*Design, RequestError) {
+	if prebuiltID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDesignIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/designs/pre-builts/"+prebuiltID, designDuplicate{
+		Name:   name,
+		Editor: editor,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed duplicating design: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDuplicatingDesign, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDesign(respBody)
+}
+
+// ReadDesign retrieves a design and returns it.
+func (c *Client) ReadDesign(id string) (*Design, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDesignIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/designs/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading design: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingDesign, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDesign(respBody)
+}
+
+// UpdateDesign edits a design and returns it.
+func (c *Client) UpdateDesign(design Design) (*Design, RequestError) {
+	if design.ID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDesignIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/designs/"+design.ID, design)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating design: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingDesign, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDesign(respBody)
+}
+
+// DeleteDesign deletes a design.
+func (c *Client) DeleteDesign(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDesignIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/designs/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting design: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingDesign, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,369 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// domainAuthenticationsPageSize is the number of domains retrieved per call when listing authenticated domains.
+const domainAuthenticationsPageSize = 100
+
+// DNSRecord is a DNS record to create to validate a domain authentication, a link branding or a reverse DNS.
+type DNSRecord struct {
+	Valid bool   `json:"valid"`
+	Type  string `json:"type"`
+	Host  string `json:"host"`
+	Data  string `json:"data"`
+}
+
+// DomainAuthentication is a domain authenticated to send emails from.
+type DomainAuthentication struct {
+	ID                int64                `json:"id,omitempty"`
+	UserID            int64                `json:"user_id,omitempty"`
+	Subdomain         string               `json:"subdomain,omitempty"`
+	Domain            string               `json:"domain,omitempty"`
+	Username          string               `json:"username,omitempty"`
+	IPs               []string             `json:"ips,omitempty"`
+	CustomSPF         bool                 `json:"custom_spf,omitempty"`
+	Default           bool                 `json:"default,omitempty"`
+	Legacy            bool                 `json:"legacy,omitempty"`
+	AutomaticSecurity bool                 `json:"automatic_security,omitempty"`
+	Valid             bool                 `json:"valid,omitempty"`
+	DNS               map[string]DNSRecord `json:"dns,omitempty"`
+}
+
+// DomainAuthenticationRequest is the configuration of a domain to authenticate.
+type DomainAuthenticationRequest struct {
+	Domain             string   `json:"domain"`
+	Subdomain          string   `json:"subdomain,omitempty"`
+	Username           string   `json:"username,omitempty"`
+	IPs                []string `json:"ips,omitempty"`
+	CustomSPF          bool     `json:"custom_spf"`
+	Default            bool     `json:"default"`
+	AutomaticSecurity  bool     `json:"automatic_security"`
+	CustomDKIMSelector string   `json:"custom_dkim_selector,omitempty"`
+}
+
+// DomainAuthenticationValidationResult is the result of the validation of a DNS record of an authenticated domain.
+type DomainAuthenticationValidationResult struct {
+	Valid  bool   `json:"valid"`
+	Reason string `json:"reason"`
+}
+
+// DomainAuthenticationValidation is the result of the validation of the DNS records of an authenticated domain.
+type DomainAuthenticationValidation struct {
+	ID                int64                                           `json:"id"`
+	Valid             bool                                            `json:"valid"`
+	ValidationResults map[string]DomainAuthenticationValidationResult `json:"validation_results"`
+}
+
+type domainAuthenticationValidate struct{}
+
+type domainAuthenticationAssociation struct {
+	Username string `json:"username"`
+}
+
+type domainAuthenticationUpdate struct {
+	CustomSPF bool `json:"custom_spf"`
+	Default   bool `json:"default"`
+}
+
+func parseDomainAuthentication(respBody string) (*DomainAuthentication, RequestError) {
+	var body DomainAuthentication
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing domain authentication: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateDomainAuthentication authenticates a domain and returns it, with the DNS records to create.
+func (c *Client) CreateDomainAuthentication(request DomainAuthenticationRequest) (*DomainAuthentication, RequestError) {
+	if request.Domain == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/domains", request)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedCreatingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDomainAuthentication(respBody)
+}
+
+// ReadDomainAuthentication retrieves an authenticated domain by ID and returns it.
+func (c *Client) ReadDomainAuthentication(id string) (*DomainAuthentication, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainAuthenticationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/whitelabel/domains/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedReadingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDomainAuthentication(respBody)
+}
+
+// UpdateDomainAuthentication edits the default and custom SPF settings of an authenticated domain.
+func (c *Client) UpdateDomainAuthentication(
+	id string,
+	isDefault, customSPF bool,
+) (*DomainAuthentication, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainAuthenticationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/whitelabel/domains/"+id, domainAuthenticationUpdate{
+		CustomSPF: customSPF,
+		Default:   isDefault,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedUpdatingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDomainAuthentication(respBody)
+}
+
+// DeleteDomainAuthentication deletes an authenticated domain.
+func (c *Client) DeleteDomainAuthentication(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainAuthenticationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/whitelabel/domains/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDeletingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListDomainAuthentications retrieves the authenticated domains, optionally filtered by domain, and returns them.
+func (c *Client) ListDomainAuthentications(domain string) ([]DomainAuthentication, RequestError) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(domainAuthenticationsPageSize))
+
+	if domain != "" {
+		query.Set("domain", domain)
+	}
+
+	var domains []DomainAuthentication
+
+	for offset := 0; ; offset += domainAuthenticationsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		respBody, statusCode, err := c.Get("GET", "/whitelabel/domains?"+query.Encode())
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing domain authentications: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err: fmt.Errorf(
+					"%w, status: %d, response: %s", ErrFailedListingDomainAuthentications, statusCode, respBody,
+				),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		var page []DomainAuthentication
+		if err = json.Unmarshal([]byte(respBody), &page); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing domain authentications: %w", err),
+			}
+		}
+
+		domains = append(domains, page...)
+
+		if len(page) < domainAuthenticationsPageSize {
+			return domains, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ValidateDomainAuthentication asks Sendgrid to check the DNS records of an authenticated domain
+// and returns the result of the validation of each of them.
+func (c *Client) ValidateDomainAuthentication(id string) (*DomainAuthenticationValidation, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainAuthenticationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/domains/"+id+"/validate", domainAuthenticationValidate{})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed validating domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedValidatingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body DomainAuthenticationValidation
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing domain authentication validation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AssociateDomainAuthentication associates an authenticated domain with a subuser, which then sends emails from it.
+func (c *Client) AssociateDomainAuthentication(id, username string) (*DomainAuthentication, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainAuthenticationIDRequired}
+	}
+
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/domains/"+id+"/subuser", domainAuthenticationAssociation{
+		Username: username,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed associating domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedAssociatingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDomainAuthentication(respBody)
+}
+
+// ReadSubuserDomainAuthentication retrieves the authenticated domain associated with a subuser and returns it.
+func (c *Client) ReadSubuserDomainAuthentication(username string) (*DomainAuthentication, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/whitelabel/domains/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading subuser domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedReadingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseDomainAuthentication(respBody)
+}
+
+// DisassociateDomainAuthentication removes the authenticated domain associated with a subuser.
+func (c *Client) DisassociateDomainAuthentication(username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/whitelabel/domains/subuser?username="+url.QueryEscape(username))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed disassociating domain authentication: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDisassociatingDomainAuthentication, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
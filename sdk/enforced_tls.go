@@ -0,0 +1,71 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EnforcedTLS is the enforced TLS setting: whether the recipients must support TLS,
+// and must present a valid certificate, for the emails to be delivered to them.
+type EnforcedTLS struct {
+	RequireTLS       bool `json:"require_tls"`
+	RequireValidCert bool `json:"require_valid_cert"`
+}
+
+// ReadEnforcedTLS retrieves the enforced TLS setting.
+func (c *Client) ReadEnforcedTLS() (*EnforcedTLS, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/user/settings/enforced_tls")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading enforced TLS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingEnforcedTLS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var setting EnforcedTLS
+	if err := json.Unmarshal([]byte(respBody), &setting); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing enforced TLS: %w", err),
+		}
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateEnforcedTLS edits the enforced TLS setting.
+func (c *Client) UpdateEnforcedTLS(setting EnforcedTLS) (*EnforcedTLS, RequestError) {
+	respBody, statusCode, err := c.Post("PATCH", "/user/settings/enforced_tls", setting)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating enforced TLS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingEnforcedTLS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err := json.Unmarshal([]byte(respBody), &setting); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing enforced TLS: %w", err),
+		}
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
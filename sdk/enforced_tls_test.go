@@ -0,0 +1,55 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestUpdateEnforcedTLS(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PATCH" || r.URL.Path != "/user/settings/enforced_tls" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// both fields are sent, so that resetting the setting disables them.
+		if string(body) != `{"require_tls":false,"require_valid_cert":false}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		fmt.Fprint(w, `{"require_tls": false, "require_valid_cert": false}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	setting, requestErr := c.UpdateEnforcedTLS(sendgrid.EnforcedTLS{})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if setting.RequireTLS || setting.RequireValidCert {
+		t.Fatalf("unexpected setting: %+v", setting)
+	}
+}
+
+func TestReadEnforcedTLSFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		fmt.Fprint(w, `{"errors": [{"field": null, "message": "access forbidden"}]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, requestErr := c.ReadEnforcedTLS()
+	if requestErr.StatusCode != http.StatusForbidden || requestErr.Err == nil {
+		t.Fatalf("expected the forbidden status, got: %+v", requestErr)
+	}
+}
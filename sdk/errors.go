@@ -1,16 +1,24 @@
 package sendgrid
 
 import (
-	"context"
+	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
-
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
 var (
+	// ErrUnauthorized error matched by the requests rejected because the API key is invalid.
+	ErrUnauthorized = errors.New("unauthorized")
+
+	// ErrForbidden error matched by the requests rejected because the API key lacks a scope.
+	ErrForbidden = errors.New("forbidden")
+
+	// ErrNotFound error matched by the requests on a resource which doesn't exist.
+	ErrNotFound = errors.New("not found")
+
+	// ErrConflict error matched by the requests conflicting with an existing resource.
+	ErrConflict = errors.New("conflict")
+
 	// ErrBodyNotNil low error displayed when the prepared body for a POST call
 	// to the API is nil.
 	ErrBodyNotNil = errors.New("body must not be nil")
@@ -24,9 +32,18 @@ var (
 	// ErrFailedCreatingAPIKey error displayed when the provider can not create an api key.
 	ErrFailedCreatingAPIKey = errors.New("failed creating apiKey")
 
+	// ErrFailedReadingAPIKey error displayed when the provider can not read an api key.
+	ErrFailedReadingAPIKey = errors.New("failed reading apiKey")
+
+	// ErrFailedUpdatingAPIKey error displayed when the provider can not update an api key.
+	ErrFailedUpdatingAPIKey = errors.New("failed updating apiKey")
+
 	// ErrFailedDeletingAPIKey error displayed when the provider can not delete an api key.
 	ErrFailedDeletingAPIKey = errors.New("failed deleting apiKey")
 
+	// ErrFailedListingAPIKeys error displayed when the provider can not list the api keys.
+	ErrFailedListingAPIKeys = errors.New("failed listing apiKeys")
+
 	// ErrUsernameRequired error displayed when a subUser username wasn't specified.
 	ErrUsernameRequired = errors.New("a username is required")
 
@@ -36,21 +53,649 @@ var (
 	// ErrPasswordRequired error displayed when a subUser password wasn't specified.
 	ErrPasswordRequired = errors.New("a password is required")
 
-	// ErrIPRequired error displayed when at least one IP per subUser wasn't specified.
+	// ErrIPRequired error displayed when at least one IP per subUser wasn't specified,
+	// while the account has dedicated IPs.
 	ErrIPRequired = errors.New("at least one ip address is required")
 
 	// ErrFailedCreatingSubUser error displayed when the provider can not create a subuser.
 	ErrFailedCreatingSubUser = errors.New("failed creating subUser")
 
+	// ErrSubUserAlreadyExists error displayed when the username of a created subuser is already taken.
+	ErrSubUserAlreadyExists = errors.New("subUser already exists")
+
+	// ErrFailedReadingSubUser error displayed when the provider can not read a subuser.
+	ErrFailedReadingSubUser = errors.New("failed reading subUser")
+
+	// ErrFailedListingSubUsers error displayed when the provider can not list the subusers.
+	ErrFailedListingSubUsers = errors.New("failed listing subUsers")
+
+	// ErrFailedUpdatingSubUser error displayed when the provider can not update a subuser.
+	ErrFailedUpdatingSubUser = errors.New("failed updating subUser")
+
+	// ErrFailedUpdatingSubUserEmail error displayed when the provider can not update the email of a subuser.
+	ErrFailedUpdatingSubUserEmail = errors.New("failed updating subUser email")
+
 	// ErrFailedDeletingSubUser error displayed when the provider can not delete a subuser.
 	ErrFailedDeletingSubUser = errors.New("failed deleting subUser")
 
+	// ErrInvalidCreditsType error displayed when the type of the credits of a subuser isn't
+	// one of unlimited, recurring or nonrecurring.
+	ErrInvalidCreditsType = errors.New("the type of credits must be one of unlimited, recurring or nonrecurring")
+
+	// ErrUnlimitedCreditsWithLimit error displayed when unlimited credits are given a total or a reset frequency.
+	ErrUnlimitedCreditsWithLimit = errors.New("unlimited credits can't have a total nor a reset frequency")
+
+	// ErrCreditsResetFrequencyRequired error displayed when recurring credits don't have a reset frequency.
+	ErrCreditsResetFrequencyRequired = errors.New("recurring credits require a reset frequency")
+
+	// ErrCreditsResetFrequencyNotAllowed error displayed when nonrecurring credits have a reset frequency.
+	ErrCreditsResetFrequencyNotAllowed = errors.New("nonrecurring credits can't have a reset frequency")
+
+	// ErrFailedReadingSubUserCredits error displayed when the provider can not read the credits of a subuser.
+	ErrFailedReadingSubUserCredits = errors.New("failed reading subUser credits")
+
+	// ErrFailedUpdatingSubUserCredits error displayed when the provider can not update the credits of a subuser.
+	ErrFailedUpdatingSubUserCredits = errors.New("failed updating subUser credits")
+
+	// ErrFailedCreatingSubUserMonitor error displayed when the provider can not create a subuser monitor.
+	ErrFailedCreatingSubUserMonitor = errors.New("failed creating subUser monitor")
+
+	// ErrFailedReadingSubUserMonitor error displayed when the provider can not read a subuser monitor.
+	ErrFailedReadingSubUserMonitor = errors.New("failed reading subUser monitor")
+
+	// ErrFailedUpdatingSubUserMonitor error displayed when the provider can not update a subuser monitor.
+	ErrFailedUpdatingSubUserMonitor = errors.New("failed updating subUser monitor")
+
+	// ErrFailedDeletingSubUserMonitor error displayed when the provider can not delete a subuser monitor.
+	ErrFailedDeletingSubUserMonitor = errors.New("failed deleting subUser monitor")
+
+	// ErrIPAddressRequired error displayed when an IP address wasn't specified.
+	ErrIPAddressRequired = errors.New("an IP address is required")
+
+	// ErrFailedStartingIPWarmup error displayed when the provider can not start warming up an IP address.
+	ErrFailedStartingIPWarmup = errors.New("failed starting IP warmup")
+
+	// ErrFailedReadingIPWarmup error displayed when the provider can not read the warmup of an IP address.
+	ErrFailedReadingIPWarmup = errors.New("failed reading IP warmup")
+
+	// ErrFailedStoppingIPWarmup error displayed when the provider can not stop warming up an IP address.
+	ErrFailedStoppingIPWarmup = errors.New("failed stopping IP warmup")
+
+	// ErrIPWarmupNotFound error displayed when an IP address isn't being warmed up.
+	ErrIPWarmupNotFound = errors.New("IP address isn't being warmed up")
+
+	// ErrIPPoolNameRequired error displayed when the name of an IP pool wasn't specified.
+	ErrIPPoolNameRequired = errors.New("an IP pool name is required")
+
+	// ErrFailedCreatingIPPool error displayed when the provider can not create an IP pool.
+	ErrFailedCreatingIPPool = errors.New("failed creating IP pool")
+
+	// ErrFailedReadingIPPool error displayed when the provider can not read an IP pool.
+	ErrFailedReadingIPPool = errors.New("failed reading IP pool")
+
+	// ErrFailedRenamingIPPool error displayed when the provider can not rename an IP pool.
+	ErrFailedRenamingIPPool = errors.New("failed renaming IP pool")
+
+	// ErrFailedDeletingIPPool error displayed when the provider can not delete an IP pool.
+	ErrFailedDeletingIPPool = errors.New("failed deleting IP pool")
+
+	// ErrFailedAddingIPToPool error displayed when the provider can not add an IP address to an IP pool.
+	ErrFailedAddingIPToPool = errors.New("failed adding IP address to IP pool")
+
+	// ErrFailedRemovingIPFromPool error displayed when the provider can not remove an IP address from an IP pool.
+	ErrFailedRemovingIPFromPool = errors.New("failed removing IP address from IP pool")
+
+	// ErrFailedReadingAccount error displayed when the provider can not read the account.
+	ErrFailedReadingAccount = errors.New("failed reading account")
+
+	// ErrFailedReadingProfile error displayed when the provider can not read the profile of the user.
+	ErrFailedReadingProfile = errors.New("failed reading profile")
+
+	// ErrFailedUpdatingProfile error displayed when the provider can not update the profile of the user.
+	ErrFailedUpdatingProfile = errors.New("failed updating profile")
+
+	// ErrFailedReadingUserEmail error displayed when the provider can not read the email of the user.
+	ErrFailedReadingUserEmail = errors.New("failed reading user email")
+
+	// ErrContactsQueryRequired error displayed when the query of a contacts search wasn't specified.
+	ErrContactsQueryRequired = errors.New("a contacts query is required")
+
+	// ErrFailedSearchingContacts error displayed when the provider can not search the marketing contacts.
+	ErrFailedSearchingContacts = errors.New("failed searching contacts")
+
+	// ErrContactIDRequired error displayed when a contact ID wasn't specified.
+	ErrContactIDRequired = errors.New("a contact ID is required")
+
+	// ErrContactEmailRequired error displayed when the email of a contact wasn't specified.
+	ErrContactEmailRequired = errors.New("a contact email is required")
+
+	// ErrFailedUpsertingContacts error displayed when the provider can not create or update marketing contacts.
+	ErrFailedUpsertingContacts = errors.New("failed upserting contacts")
+
+	// ErrFailedReadingContact error displayed when the provider can not read a marketing contact.
+	ErrFailedReadingContact = errors.New("failed reading contact")
+
+	// ErrFailedDeletingContacts error displayed when the provider can not delete marketing contacts.
+	ErrFailedDeletingContacts = errors.New("failed deleting contacts")
+
+	// ErrFailedRemovingContactFromList error displayed when the provider can not remove a contact from a list.
+	ErrFailedRemovingContactFromList = errors.New("failed removing contact from list")
+
+	// ErrContactsImportIDRequired error displayed when the ID of a job upserting contacts wasn't specified.
+	ErrContactsImportIDRequired = errors.New("a contacts import ID is required")
+
+	// ErrFailedReadingContactsImport error displayed when the provider can not read a job upserting contacts.
+	ErrFailedReadingContactsImport = errors.New("failed reading contacts import")
+
+	// ErrFailedListingFieldDefinitions error displayed when the provider can not list the custom fields.
+	ErrFailedListingFieldDefinitions = errors.New("failed listing field definitions")
+
+	// ErrInvalidCustomFieldValue error displayed when the value of a custom field doesn't match its type.
+	ErrInvalidCustomFieldValue = errors.New("invalid custom field value")
+
+	// ErrContactsExportIDRequired error displayed when a contacts export ID wasn't specified.
+	ErrContactsExportIDRequired = errors.New("a contacts export ID is required")
+
+	// ErrFailedCreatingContactsExport error displayed when the provider can not start a contacts export.
+	ErrFailedCreatingContactsExport = errors.New("failed creating contacts export")
+
+	// ErrFailedReadingContactsExport error displayed when the provider can not read a contacts export.
+	ErrFailedReadingContactsExport = errors.New("failed reading contacts export")
+
+	// ErrMarketingListIDRequired error displayed when a marketing list ID wasn't specified.
+	ErrMarketingListIDRequired = errors.New("a marketing list ID is required")
+
+	// ErrMarketingListNameRequired error displayed when a marketing list name wasn't specified.
+	ErrMarketingListNameRequired = errors.New("a marketing list name is required")
+
+	// ErrFailedCreatingMarketingList error displayed when the provider can not create a marketing list.
+	ErrFailedCreatingMarketingList = errors.New("failed creating marketing list")
+
+	// ErrFailedReadingMarketingList error displayed when the provider can not read a marketing list.
+	ErrFailedReadingMarketingList = errors.New("failed reading marketing list")
+
+	// ErrFailedUpdatingMarketingList error displayed when the provider can not update a marketing list.
+	ErrFailedUpdatingMarketingList = errors.New("failed updating marketing list")
+
+	// ErrFailedDeletingMarketingList error displayed when the provider can not delete a marketing list.
+	ErrFailedDeletingMarketingList = errors.New("failed deleting marketing list")
+
+	// ErrSegmentIDRequired error displayed when a segment ID wasn't specified.
+	ErrSegmentIDRequired = errors.New("a segment ID is required")
+
+	// ErrSegmentNameRequired error displayed when a segment name wasn't specified.
+	ErrSegmentNameRequired = errors.New("a segment name is required")
+
+	// ErrSegmentQueryRequired error displayed when the query of a segment wasn't specified.
+	ErrSegmentQueryRequired = errors.New("a segment query is required")
+
+	// ErrInvalidSegmentQuery error displayed when the query of a segment is malformed,
+	// or references a field which isn't defined.
+	ErrInvalidSegmentQuery = errors.New("invalid segment query")
+
+	// ErrFailedCreatingSegment error displayed when the provider can not create a segment.
+	ErrFailedCreatingSegment = errors.New("failed creating segment")
+
+	// ErrFailedReadingSegment error displayed when the provider can not read a segment.
+	ErrFailedReadingSegment = errors.New("failed reading segment")
+
+	// ErrFailedUpdatingSegment error displayed when the provider can not update a segment.
+	ErrFailedUpdatingSegment = errors.New("failed updating segment")
+
+	// ErrFailedDeletingSegment error displayed when the provider can not delete a segment.
+	ErrFailedDeletingSegment = errors.New("failed deleting segment")
+
+	// ErrDesignIDRequired error displayed when a design ID wasn't specified.
+	ErrDesignIDRequired = errors.New("a design ID is required")
+
+	// ErrDesignNameRequired error displayed when a design name wasn't specified.
+	ErrDesignNameRequired = errors.New("a design name is required")
+
+	// ErrFailedCreatingDesign error displayed when the provider can not create a design.
+	ErrFailedCreatingDesign = errors.New("failed creating design")
+
+	// ErrFailedDuplicatingDesign error displayed when the provider can not duplicate a pre-built design.
+	ErrFailedDuplicatingDesign = errors.New("failed duplicating design")
+
+	// ErrFailedReadingDesign error displayed when the provider can not read a design.
+	ErrFailedReadingDesign = errors.New("failed reading design")
+
+	// ErrFailedUpdatingDesign error displayed when the provider can not update a design.
+	ErrFailedUpdatingDesign = errors.New("failed updating design")
+
+	// ErrFailedDeletingDesign error displayed when the provider can not delete a design.
+	ErrFailedDeletingDesign = errors.New("failed deleting design")
+
+	// ErrSenderIdentityIDRequired error displayed when a sender identity ID wasn't specified.
+	ErrSenderIdentityIDRequired = errors.New("a sender identity ID is required")
+
+	// ErrSenderIdentityFromEmailRequired error displayed when a sender identity from email wasn't specified.
+	ErrSenderIdentityFromEmailRequired = errors.New("a sender identity from email is required")
+
+	// ErrFailedCreatingSenderIdentity error displayed when the provider can not create a sender identity.
+	ErrFailedCreatingSenderIdentity = errors.New("failed creating sender identity")
+
+	// ErrFailedReadingSenderIdentity error displayed when the provider can not read a sender identity.
+	ErrFailedReadingSenderIdentity = errors.New("failed reading sender identity")
+
+	// ErrFailedUpdatingSenderIdentity error displayed when the provider can not update a sender identity.
+	ErrFailedUpdatingSenderIdentity = errors.New("failed updating sender identity")
+
+	// ErrFailedDeletingSenderIdentity error displayed when the provider can not delete a sender identity.
+	ErrFailedDeletingSenderIdentity = errors.New("failed deleting sender identity")
+
+	// ErrVerifiedSenderIDRequired error displayed when a verified sender ID wasn't specified.
+	ErrVerifiedSenderIDRequired = errors.New("a verified sender ID is required")
+
+	// ErrVerifiedSenderFromEmailRequired error displayed when a verified sender from email wasn't specified.
+	ErrVerifiedSenderFromEmailRequired = errors.New("a verified sender from email is required")
+
+	// ErrFailedCreatingVerifiedSender error displayed when the provider can not create a verified sender.
+	ErrFailedCreatingVerifiedSender = errors.New("failed creating verified sender")
+
+	// ErrFailedReadingVerifiedSender error displayed when the provider can not read a verified sender.
+	ErrFailedReadingVerifiedSender = errors.New("failed reading verified sender")
+
+	// ErrFailedUpdatingVerifiedSender error displayed when the provider can not update a verified sender.
+	ErrFailedUpdatingVerifiedSender = errors.New("failed updating verified sender")
+
+	// ErrFailedDeletingVerifiedSender error displayed when the provider can not delete a verified sender.
+	ErrFailedDeletingVerifiedSender = errors.New("failed deleting verified sender")
+
+	// ErrFailedResendingVerifiedSenderVerification error displayed when the provider can not resend
+	// the verification email of a verified sender.
+	ErrFailedResendingVerifiedSenderVerification = errors.New("failed resending verified sender verification")
+
+	// ErrVerifiedSenderNotFound error displayed when a verified sender doesn't exist.
+	ErrVerifiedSenderNotFound = errors.New("verified sender wasn't found")
+
+	// ErrVerifiedSenderAlreadyExists error displayed when a verified sender with the same from email already exists.
+	ErrVerifiedSenderAlreadyExists = errors.New("a verified sender with this from email already exists")
+
+	// ErrVerifiedSenderDomainAuthenticated error displayed when a verified sender is rejected
+	// because the domain of its from email is already authenticated.
+	ErrVerifiedSenderDomainAuthenticated = errors.New("the domain of the verified sender is already authenticated")
+
+	// ErrFailedListingVerifiedSenders error displayed when the provider can not list the verified senders.
+	ErrFailedListingVerifiedSenders = errors.New("failed listing verified senders")
+
+	// ErrFailedListingAutomations error displayed when the provider can not list the marketing automations.
+	ErrFailedListingAutomations = errors.New("failed listing automations")
+
+	// ErrFailedListingIPs error displayed when the provider can not list the IP addresses.
+	ErrFailedListingIPs = errors.New("failed listing IPs")
+
+	// ErrEventWebhookURLRequired error displayed when an event webhook URL wasn't specified.
+	ErrEventWebhookURLRequired = errors.New("an event webhook URL is required")
+
+	// ErrFailedTestingEventWebhook error displayed when the provider can not send a test event.
+	ErrFailedTestingEventWebhook = errors.New("failed testing event webhook")
+
+	// ErrFailedReadingEventWebhook error displayed when the provider can not read the event webhook.
+	ErrFailedReadingEventWebhook = errors.New("failed reading event webhook")
+
+	// ErrFailedUpdatingEventWebhook error displayed when the provider can not update the event webhook.
+	ErrFailedUpdatingEventWebhook = errors.New("failed updating event webhook")
+
+	// ErrFailedReadingEventWebhookSigning error displayed when the provider can not read
+	// the signature settings of the event webhook.
+	ErrFailedReadingEventWebhookSigning = errors.New("failed reading event webhook signing")
+
+	// ErrFailedUpdatingEventWebhookSigning error displayed when the provider can not update
+	// the signature settings of the event webhook.
+	ErrFailedUpdatingEventWebhookSigning = errors.New("failed updating event webhook signing")
+
+	// ErrParseWebhookHostnameRequired error displayed when the hostname of a parse webhook wasn't specified.
+	ErrParseWebhookHostnameRequired = errors.New("a parse webhook hostname is required")
+
+	// ErrParseWebhookURLRequired error displayed when the URL of a parse webhook wasn't specified.
+	ErrParseWebhookURLRequired = errors.New("a parse webhook URL is required")
+
+	// ErrFailedCreatingParseWebhook error displayed when the provider can not create a parse webhook.
+	ErrFailedCreatingParseWebhook = errors.New("failed creating parse webhook")
+
+	// ErrFailedReadingParseWebhook error displayed when the provider can not read a parse webhook.
+	ErrFailedReadingParseWebhook = errors.New("failed reading parse webhook")
+
+	// ErrFailedListingParseWebhooks error displayed when the provider can not list the parse webhooks.
+	ErrFailedListingParseWebhooks = errors.New("failed listing parse webhooks")
+
+	// ErrFailedUpdatingParseWebhook error displayed when the provider can not update a parse webhook.
+	ErrFailedUpdatingParseWebhook = errors.New("failed updating parse webhook")
+
+	// ErrFailedDeletingParseWebhook error displayed when the provider can not delete a parse webhook.
+	ErrFailedDeletingParseWebhook = errors.New("failed deleting parse webhook")
+
+	// ErrFailedReadingMailSetting error displayed when the provider can not read a mail setting.
+	ErrFailedReadingMailSetting = errors.New("failed reading mail setting")
+
+	// ErrFailedUpdatingMailSetting error displayed when the provider can not update a mail setting.
+	ErrFailedUpdatingMailSetting = errors.New("failed updating mail setting")
+
+	// ErrFailedReadingTrackingSetting error displayed when the provider can not read a tracking setting.
+	ErrFailedReadingTrackingSetting = errors.New("failed reading tracking setting")
+
+	// ErrFailedUpdatingTrackingSetting error displayed when the provider can not update a tracking setting.
+	ErrFailedUpdatingTrackingSetting = errors.New("failed updating tracking setting")
+
+	// ErrFailedReadingEnforcedTLS error displayed when the provider can not read the enforced TLS setting.
+	ErrFailedReadingEnforcedTLS = errors.New("failed reading enforced TLS")
+
+	// ErrFailedUpdatingEnforcedTLS error displayed when the provider can not update the enforced TLS setting.
+	ErrFailedUpdatingEnforcedTLS = errors.New("failed updating enforced TLS")
+
+	// ErrAccessRuleIPRequired error displayed when no IP address was given to allow.
+	ErrAccessRuleIPRequired = errors.New("at least one IP address is required")
+
+	// ErrFailedListingAccessRules error displayed when the provider can not list the allowed IP addresses.
+	ErrFailedListingAccessRules = errors.New("failed listing access rules")
+
+	// ErrFailedCreatingAccessRules error displayed when the provider can not allow IP addresses.
+	ErrFailedCreatingAccessRules = errors.New("failed creating access rules")
+
+	// ErrFailedDeletingAccessRules error displayed when the provider can not remove allowed IP addresses.
+	ErrFailedDeletingAccessRules = errors.New("failed deleting access rules")
+
+	// ErrFailedListingAccessActivity error displayed when the provider can not list the recent accesses.
+	ErrFailedListingAccessActivity = errors.New("failed listing access activity")
+
+	// ErrStatsStartDateRequired error displayed when the start date of the stats wasn't specified.
+	ErrStatsStartDateRequired = errors.New("a start date is required to list stats")
+
+	// ErrStatsBreakdownInvalid error displayed when the stats are broken down by an unknown dimension.
+	ErrStatsBreakdownInvalid = errors.New("the stats can only be broken down by category, mailbox_provider or browser")
+
+	// ErrStatsCategoriesRequired error displayed when the stats are broken down by category without categories.
+	ErrStatsCategoriesRequired = errors.New("categories are required to break down the stats by category")
+
+	// ErrFailedListingStats error displayed when the provider can not list the stats.
+	ErrFailedListingStats = errors.New("failed listing stats")
+
+	// ErrFailedReadingScopes error displayed when the provider can not read the scopes of its API key.
+	ErrFailedReadingScopes = errors.New("failed reading scopes")
+
+	// ErrTeammateEmailRequired error displayed when a teammate email wasn't specified.
+	ErrTeammateEmailRequired = errors.New("a teammate email is required")
+
+	// ErrTeammateInviteTokenRequired error displayed when the token of a teammate invite wasn't specified.
+	ErrTeammateInviteTokenRequired = errors.New("a teammate invite token is required")
+
+	// ErrFailedInvitingTeammate error displayed when the provider can not invite a teammate.
+	ErrFailedInvitingTeammate = errors.New("failed inviting teammate")
+
+	// ErrFailedReadingTeammate error displayed when the provider can not read a teammate.
+	ErrFailedReadingTeammate = errors.New("failed reading teammate")
+
+	// ErrFailedListingTeammates error displayed when the provider can not list the teammates.
+	ErrFailedListingTeammates = errors.New("failed listing teammates")
+
+	// ErrFailedUpdatingTeammate error displayed when the provider can not update a teammate.
+	ErrFailedUpdatingTeammate = errors.New("failed updating teammate")
+
+	// ErrFailedDeletingTeammate error displayed when the provider can not delete a teammate.
+	ErrFailedDeletingTeammate = errors.New("failed deleting teammate")
+
+	// ErrFailedListingPendingTeammates error displayed when the provider can not list the pending teammate invites.
+	ErrFailedListingPendingTeammates = errors.New("failed listing pending teammates")
+
+	// ErrFailedResendingTeammateInvite error displayed when the provider can not resend a teammate invite.
+	ErrFailedResendingTeammateInvite = errors.New("failed resending teammate invite")
+
+	// ErrFailedDeletingTeammateInvite error displayed when the provider can not delete a teammate invite.
+	ErrFailedDeletingTeammateInvite = errors.New("failed deleting teammate invite")
+
+	// ErrTeammateNameRequired error displayed when the first or the last name of an SSO teammate wasn't specified.
+	ErrTeammateNameRequired = errors.New("a first and a last name are required for an SSO teammate")
+
+	// ErrFailedCreatingSSOTeammate error displayed when the provider can not create an SSO teammate.
+	ErrFailedCreatingSSOTeammate = errors.New("failed creating SSO teammate")
+
+	// ErrFailedUpdatingSSOTeammate error displayed when the provider can not update an SSO teammate.
+	ErrFailedUpdatingSSOTeammate = errors.New("failed updating SSO teammate")
+
+	// ErrSSOIntegrationNameRequired error displayed when the name of an SSO integration wasn't specified.
+	ErrSSOIntegrationNameRequired = errors.New("a name is required for an SSO integration")
+
+	// ErrSSOIntegrationIDRequired error displayed when the ID of an SSO integration wasn't specified.
+	ErrSSOIntegrationIDRequired = errors.New("an SSO integration ID is required")
+
+	// ErrFailedCreatingSSOIntegration error displayed when the provider can not create an SSO integration.
+	ErrFailedCreatingSSOIntegration = errors.New("failed creating SSO integration")
+
+	// ErrFailedReadingSSOIntegration error displayed when the provider can not read an SSO integration.
+	ErrFailedReadingSSOIntegration = errors.New("failed reading SSO integration")
+
+	// ErrFailedUpdatingSSOIntegration error displayed when the provider can not update an SSO integration.
+	ErrFailedUpdatingSSOIntegration = errors.New("failed updating SSO integration")
+
+	// ErrFailedDeletingSSOIntegration error displayed when the provider can not delete an SSO integration.
+	ErrFailedDeletingSSOIntegration = errors.New("failed deleting SSO integration")
+
+	// ErrSSOCertificateRequired error displayed when the public certificate of an SSO certificate wasn't specified.
+	ErrSSOCertificateRequired = errors.New("a public certificate is required for an SSO certificate")
+
+	// ErrSSOCertificateIDRequired error displayed when the ID of an SSO certificate wasn't specified.
+	ErrSSOCertificateIDRequired = errors.New("an SSO certificate ID is required")
+
+	// ErrFailedCreatingSSOCertificate error displayed when the provider can not create an SSO certificate.
+	ErrFailedCreatingSSOCertificate = errors.New("failed creating SSO certificate")
+
+	// ErrFailedReadingSSOCertificate error displayed when the provider can not read an SSO certificate.
+	ErrFailedReadingSSOCertificate = errors.New("failed reading SSO certificate")
+
+	// ErrFailedUpdatingSSOCertificate error displayed when the provider can not update an SSO certificate.
+	ErrFailedUpdatingSSOCertificate = errors.New("failed updating SSO certificate")
+
+	// ErrFailedDeletingSSOCertificate error displayed when the provider can not delete an SSO certificate.
+	ErrFailedDeletingSSOCertificate = errors.New("failed deleting SSO certificate")
+
+	// ErrTeammateNotFound error displayed when no teammate nor pending invite has the given email.
+	ErrTeammateNotFound = errors.New("teammate wasn't found")
+
+	// ErrFailedReadingTeammateSubuserAccess error displayed when the provider can not read
+	// the subusers a teammate has access to.
+	ErrFailedReadingTeammateSubuserAccess = errors.New("failed reading teammate subuser access")
+
+	// ErrFailedUpdatingTeammateSubuserAccess error displayed when the provider can not update
+	// the subusers a teammate has access to.
+	ErrFailedUpdatingTeammateSubuserAccess = errors.New("failed updating teammate subuser access")
+
+	// ErrDomainAuthenticationIDRequired error displayed when a domain authentication ID wasn't specified.
+	ErrDomainAuthenticationIDRequired = errors.New("a domain authentication ID is required")
+
+	// ErrDomainRequired error displayed when a domain wasn't specified.
+	ErrDomainRequired = errors.New("a domain is required")
+
+	// ErrFailedCreatingDomainAuthentication error displayed when the provider can not create a domain authentication.
+	ErrFailedCreatingDomainAuthentication = errors.New("failed creating domain authentication")
+
+	// ErrFailedUpdatingDomainAuthentication error displayed when the provider can not update a domain authentication.
+	ErrFailedUpdatingDomainAuthentication = errors.New("failed updating domain authentication")
+
+	// ErrFailedDeletingDomainAuthentication error displayed when the provider can not delete a domain authentication.
+	ErrFailedDeletingDomainAuthentication = errors.New("failed deleting domain authentication")
+
+	// ErrFailedValidatingDomainAuthentication error displayed when the provider can not validate a domain authentication.
+	ErrFailedValidatingDomainAuthentication = errors.New("failed validating domain authentication")
+
+	// ErrFailedAssociatingDomainAuthentication error displayed when the provider can not associate
+	// a domain authentication with a subuser.
+	ErrFailedAssociatingDomainAuthentication = errors.New("failed associating domain authentication")
+
+	// ErrFailedDisassociatingDomainAuthentication error displayed when the provider can not disassociate
+	// a domain authentication from a subuser.
+	ErrFailedDisassociatingDomainAuthentication = errors.New("failed disassociating domain authentication")
+
+	// ErrFailedReadingDomainAuthentication error displayed when the provider can not read a domain authentication.
+	ErrFailedReadingDomainAuthentication = errors.New("failed reading domain authentication")
+
+	// ErrFailedListingDomainAuthentications error displayed when the provider can not list the domain authentications.
+	ErrFailedListingDomainAuthentications = errors.New("failed listing domain authentications")
+
+	// ErrLinkBrandingIDRequired error displayed when a link branding ID wasn't specified.
+	ErrLinkBrandingIDRequired = errors.New("a link branding ID is required")
+
+	// ErrFailedCreatingLinkBranding error displayed when the provider can not create a link branding.
+	ErrFailedCreatingLinkBranding = errors.New("failed creating link branding")
+
+	// ErrFailedReadingLinkBranding error displayed when the provider can not read a link branding.
+	ErrFailedReadingLinkBranding = errors.New("failed reading link branding")
+
+	// ErrFailedUpdatingLinkBranding error displayed when the provider can not update a link branding.
+	ErrFailedUpdatingLinkBranding = errors.New("failed updating link branding")
+
+	// ErrFailedDeletingLinkBranding error displayed when the provider can not delete a link branding.
+	ErrFailedDeletingLinkBranding = errors.New("failed deleting link branding")
+
+	// ErrReverseDNSIDRequired error displayed when a reverse DNS ID wasn't specified.
+	ErrReverseDNSIDRequired = errors.New("a reverse DNS ID is required")
+
+	// ErrFailedCreatingReverseDNS error displayed when the provider can not create a reverse DNS.
+	ErrFailedCreatingReverseDNS = errors.New("failed creating reverse DNS")
+
+	// ErrFailedReadingReverseDNS error displayed when the provider can not read a reverse DNS.
+	ErrFailedReadingReverseDNS = errors.New("failed reading reverse DNS")
+
+	// ErrFailedDeletingReverseDNS error displayed when the provider can not delete a reverse DNS.
+	ErrFailedDeletingReverseDNS = errors.New("failed deleting reverse DNS")
+
+	// ErrFailedValidatingReverseDNS error displayed when the provider can not validate a reverse DNS.
+	ErrFailedValidatingReverseDNS = errors.New("failed validating reverse DNS")
+
+	// ErrAlertIDRequired error displayed when an alert ID wasn't specified.
+	ErrAlertIDRequired = errors.New("an alert ID is required")
+
+	// ErrInvalidAlertType error displayed when the type of an alert isn't stats_notification or usage_limit.
+	ErrInvalidAlertType = errors.New("invalid alert type, supported values: stats_notification, usage_limit")
+
+	// ErrAlertFrequencyRequired error displayed when a stats notification doesn't have a frequency.
+	ErrAlertFrequencyRequired = errors.New("a stats_notification alert requires a frequency")
+
+	// ErrAlertFrequencyNotAllowed error displayed when a usage limit alert has a frequency.
+	ErrAlertFrequencyNotAllowed = errors.New("a usage_limit alert can't have a frequency")
+
+	// ErrAlertPercentageNotAllowed error displayed when a stats notification has a percentage.
+	ErrAlertPercentageNotAllowed = errors.New("a stats_notification alert can't have a percentage")
+
+	// ErrInvalidAlertPercentage error displayed when the percentage of a usage limit alert isn't between 1 and 100.
+	ErrInvalidAlertPercentage = errors.New("a usage_limit alert requires a percentage between 1 and 100")
+
+	// ErrFailedCreatingAlert error displayed when the provider can not create an alert.
+	ErrFailedCreatingAlert = errors.New("failed creating alert")
+
+	// ErrFailedReadingAlert error displayed when the provider can not read an alert.
+	ErrFailedReadingAlert = errors.New("failed reading alert")
+
+	// ErrFailedUpdatingAlert error displayed when the provider can not update an alert.
+	ErrFailedUpdatingAlert = errors.New("failed updating alert")
+
+	// ErrFailedDeletingAlert error displayed when the provider can not delete an alert.
+	ErrFailedDeletingAlert = errors.New("failed deleting alert")
+
+	// ErrBatchIDRequired error displayed when a batch ID wasn't specified.
+	ErrBatchIDRequired = errors.New("a batch ID is required")
+
+	// ErrInvalidScheduledSendStatus error displayed when the status of a scheduled send isn't cancel or pause.
+	ErrInvalidScheduledSendStatus = errors.New("invalid scheduled send status, supported values: cancel, pause")
+
+	// ErrFailedCreatingBatchID error displayed when the provider can not create a batch ID.
+	ErrFailedCreatingBatchID = errors.New("failed creating batch ID")
+
+	// ErrFailedReadingBatchID error displayed when the provider can not read a batch ID.
+	ErrFailedReadingBatchID = errors.New("failed reading batch ID")
+
+	// ErrFailedCreatingScheduledSend error displayed when the provider can not cancel or pause a scheduled send.
+	ErrFailedCreatingScheduledSend = errors.New("failed creating scheduled send")
+
+	// ErrFailedReadingScheduledSend error displayed when the provider can not read a scheduled send.
+	ErrFailedReadingScheduledSend = errors.New("failed reading scheduled send")
+
+	// ErrFailedUpdatingScheduledSend error displayed when the provider can not update a scheduled send.
+	ErrFailedUpdatingScheduledSend = errors.New("failed updating scheduled send")
+
+	// ErrFailedDeletingScheduledSend error displayed when the provider can not delete a scheduled send.
+	ErrFailedDeletingScheduledSend = errors.New("failed deleting scheduled send")
+
+	// ErrScheduledSendAlreadySent error displayed when the sends of a batch already went out,
+	// so they can't be canceled or paused anymore.
+	ErrScheduledSendAlreadySent = errors.New("the sends of the batch already went out, they can't be canceled or paused")
+
+	// ErrMailFromRequired error displayed when the sender of an email wasn't specified.
+	ErrMailFromRequired = errors.New("a from email is required")
+
+	// ErrMailToRequired error displayed when no recipient of an email was specified.
+	ErrMailToRequired = errors.New("at least one recipient is required")
+
+	// ErrFailedSendingMail error displayed when the provider can not send an email.
+	ErrFailedSendingMail = errors.New("failed sending mail")
+
+	// ErrSingleSendIDRequired error displayed when a single send ID wasn't specified.
+	ErrSingleSendIDRequired = errors.New("a single send ID is required")
+
+	// ErrSingleSendNameRequired error displayed when a single send name wasn't specified.
+	ErrSingleSendNameRequired = errors.New("a single send name is required")
+
+	// ErrFailedCreatingSingleSend error displayed when the provider can not create a single send.
+	ErrFailedCreatingSingleSend = errors.New("failed creating single send")
+
+	// ErrFailedReadingSingleSend error displayed when the provider can not read a single send.
+	ErrFailedReadingSingleSend = errors.New("failed reading single send")
+
+	// ErrFailedUpdatingSingleSend error displayed when the provider can not update a single send.
+	ErrFailedUpdatingSingleSend = errors.New("failed updating single send")
+
+	// ErrFailedDeletingSingleSend error displayed when the provider can not delete a single send.
+	ErrFailedDeletingSingleSend = errors.New("failed deleting single send")
+
+	// ErrFailedSchedulingSingleSend error displayed when the provider can not schedule a single send.
+	ErrFailedSchedulingSingleSend = errors.New("failed scheduling single send")
+
+	// ErrFailedUnschedulingSingleSend error displayed when the provider can not unschedule a single send.
+	ErrFailedUnschedulingSingleSend = errors.New("failed unscheduling single send")
+
+	// ErrSuppressionEmailRequired error displayed when a suppressed email address wasn't specified.
+	ErrSuppressionEmailRequired = errors.New("a suppressed email address is required")
+
+	// ErrInvalidSuppressionKind error displayed when the kind of a suppression is unknown.
+	ErrInvalidSuppressionKind = errors.New("invalid suppression kind")
+
+	// ErrSuppressionNotFound error displayed when an address isn't in a suppression list.
+	ErrSuppressionNotFound = errors.New("suppression wasn't found")
+
+	// ErrFailedCreatingSuppression error displayed when the provider can not create a suppression.
+	ErrFailedCreatingSuppression = errors.New("failed creating suppression")
+
+	// ErrFailedReadingSuppression error displayed when the provider can not read a suppression.
+	ErrFailedReadingSuppression = errors.New("failed reading suppression")
+
+	// ErrFailedDeletingSuppression error displayed when the provider can not delete a suppression.
+	ErrFailedDeletingSuppression = errors.New("failed deleting suppression")
+
+	// ErrSuppressionGroupIDRequired error displayed when a suppression group ID wasn't specified.
+	ErrSuppressionGroupIDRequired = errors.New("a suppression group ID is required")
+
+	// ErrFailedAddingGroupSuppressions error displayed when the provider can not add addresses to a suppression group.
+	ErrFailedAddingGroupSuppressions = errors.New("failed adding group suppressions")
+
+	// ErrFailedSearchingGroupSuppressions error displayed when the provider can not search a suppression group.
+	ErrFailedSearchingGroupSuppressions = errors.New("failed searching group suppressions")
+
+	// ErrFailedDeletingGroupSuppression error displayed when the provider can not remove an address
+	// from a suppression group.
+	ErrFailedDeletingGroupSuppression = errors.New("failed deleting group suppression")
+
 	// ErrTemplateIDRequired error displayed when a template ID wasn't specified.
 	ErrTemplateIDRequired = errors.New("a template ID is required")
 
 	// ErrTemplateNameRequired error displayed when a template name wasn't specified.
 	ErrTemplateNameRequired = errors.New("a template name is required")
 
+	// ErrFailedListingTemplates error displayed when the provider can not list the transactional templates.
+	ErrFailedListingTemplates = errors.New("failed listing templates")
+
+	// ErrFailedReadingTemplate error displayed when the provider can not read a transactional template.
+	ErrFailedReadingTemplate = errors.New("failed reading template")
+
 	// ErrTemplateVersionIDRequired error displayed when a template version ID wasn't specified.
 	ErrTemplateVersionIDRequired = errors.New("a template version ID is required")
 
@@ -59,46 +704,95 @@ var (
 
 	// ErrTemplateVersionSubjectRequired error displayed when a template version subject wasn't specified.
 	ErrTemplateVersionSubjectRequired = errors.New("a template version subject is required")
+
+	// ErrFailedReadingTemplateVersion error displayed when the provider can not read a version
+	// of a transactional template.
+	ErrFailedReadingTemplateVersion = errors.New("failed reading template version")
 )
 
 // RequestError struct permits to embed to return the statucode and the error to the parent function.
+// FieldErrors are the errors listed by Sendgrid in the body of the failed response, if any.
 type RequestError struct {
-	StatusCode int
-	Err        error
+	StatusCode  int
+	Err         error
+	FieldErrors []FieldError
+}
+
+// Error returns the message of the embedded error, so that a RequestError
+// can be returned as an error and retrieved later with errors.As.
+func (e RequestError) Error() string {
+	if e.Err == nil {
+		return ""
+	}
+
+	return e.Err.Error()
+}
+
+// Unwrap returns the embedded error.
+func (e RequestError) Unwrap() error {
+	return e.Err
 }
 
-type subUserError struct {
+// Is matches a failed RequestError with the error of its status code,
+// so that callers can branch with errors.Is, e.g. errors.Is(err, ErrNotFound).
+func (e RequestError) Is(target error) bool {
+	if e.Err == nil || target == nil {
+		return false
+	}
+
+	return target == statusError(e.StatusCode)
+}
+
+// statusError returns the error matched by the requests failing with the given status code, if any.
+func statusError(statusCode int) error {
+	switch statusCode {
+	case http.StatusUnauthorized:
+		return ErrUnauthorized
+	case http.StatusForbidden:
+		return ErrForbidden
+	case http.StatusNotFound:
+		return ErrNotFound
+	case http.StatusConflict:
+		return ErrConflict
+	default:
+		return nil
+	}
+}
+
+// FieldError is an error returned by Sendgrid, about a field of the request if Field is set.
+type FieldError struct {
 	Field   string `json:"field,omitempty"`
 	Message string `json:"message,omitempty"`
 }
 
-type subUserErrors struct {
-	Errors []subUserError `json:"errors,omitempty"`
+// String returns the error as "field: message", or only the message if it isn't about a field.
+func (e FieldError) String() string {
+	if e.Field == "" {
+		return e.Message
+	}
+
+	return e.Field + ": " + e.Message
+}
+
+type apiErrors struct {
+	Errors []FieldError `json:"errors,omitempty"`
 }
 
-// RetryOnRateLimit management of RequestErrors, and launch a retry if needed.
-func RetryOnRateLimit(
-	ctx context.Context, d *schema.ResourceData, f func() (interface{}, RequestError)) (interface{}, error) {
-	var resp interface{}
-
-	err := resource.RetryContext(
-		ctx,
-		d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
-			var requestErr RequestError
-			resp, requestErr = f()
-			if requestErr.Err != nil {
-				if requestErr.StatusCode == http.StatusTooManyRequests {
-					return resource.RetryableError(requestErr.Err)
-				}
-
-				return resource.NonRetryableError(requestErr.Err)
-			}
-
-			return nil
-		})
-	if err != nil {
-		return resp, fmt.Errorf("request failed: %w", err)
+// parseFieldErrors returns the errors listed in the body of a failed response,
+// or nil if the body isn't the JSON errors of Sendgrid.
+func parseFieldErrors(respBody string) []FieldError {
+	var body apiErrors
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil
+	}
+
+	var fieldErrors []FieldError
+
+	for _, e := range body.Errors {
+		if e.Message != "" {
+			fieldErrors = append(fieldErrors, e)
+		}
 	}
 
-	return resp, nil
+	return fieldErrors
 }
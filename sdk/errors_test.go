@@ -0,0 +1,95 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestRequestErrorIs(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		target     error
+	}{
+		{http.StatusUnauthorized, sendgrid.ErrUnauthorized},
+		{http.StatusForbidden, sendgrid.ErrForbidden},
+		{http.StatusNotFound, sendgrid.ErrNotFound},
+		{http.StatusConflict, sendgrid.ErrConflict},
+	}
+
+	for _, test := range tests {
+		requestErr := sendgrid.RequestError{StatusCode: test.statusCode, Err: sendgrid.ErrFailedReadingAPIKey}
+
+		if !errors.Is(requestErr, test.target) {
+			t.Errorf("expected status %d to match %q", test.statusCode, test.target)
+		}
+
+		if !errors.Is(fmt.Errorf("request failed: %w", requestErr), test.target) {
+			t.Errorf("expected wrapped status %d to match %q", test.statusCode, test.target)
+		}
+
+		if !errors.Is(requestErr, sendgrid.ErrFailedReadingAPIKey) {
+			t.Errorf("expected status %d to still match the embedded error", test.statusCode)
+		}
+	}
+
+	if errors.Is(sendgrid.RequestError{StatusCode: http.StatusBadRequest, Err: sendgrid.ErrFailedReadingAPIKey},
+		sendgrid.ErrNotFound) {
+		t.Error("expected status 400 not to match ErrNotFound")
+	}
+
+	if errors.Is(sendgrid.RequestError{StatusCode: http.StatusNotFound, Err: nil}, sendgrid.ErrNotFound) {
+		t.Error("expected a RequestError without error not to match ErrNotFound")
+	}
+}
+
+func TestRequestErrorFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [
+			{"field": "name", "message": "is required"},
+			{"field": "contact_count", "message": "is read only"},
+			{"field": null, "message": "the request is invalid"}
+		]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, requestErr := c.UpdateMarketingList("list", "newsletter")
+	if !errors.Is(requestErr.Err, sendgrid.ErrFailedUpdatingMarketingList) {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	expected := []string{"name: is required", "contact_count: is read only", "the request is invalid"}
+	if len(requestErr.FieldErrors) != len(expected) {
+		t.Fatalf("expected %d field errors, got %v", len(expected), requestErr.FieldErrors)
+	}
+
+	for i, fieldErr := range requestErr.FieldErrors {
+		if fieldErr.String() != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], fieldErr.String())
+		}
+	}
+}
+
+func TestRequestErrorWithoutFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		fmt.Fprint(w, "<html>Bad Gateway</html>")
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	_, requestErr := c.ReadMarketingList("list")
+	if requestErr.Err == nil || requestErr.FieldErrors != nil {
+		t.Errorf("expected an error without field errors, got: %v, %v", requestErr.Err, requestErr.FieldErrors)
+	}
+}
@@ -0,0 +1,186 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// EventWebhookTest is the configuration a test event is posted with.
+type EventWebhookTest struct {
+	URL               string `json:"url"`
+	OAuthClientID     string `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	OAuthTokenURL     string `json:"oauth_token_url,omitempty"`
+}
+
+// TestEventWebhook makes Sendgrid post a fake event to the given URL.
+func (c *Client) TestEventWebhook(test EventWebhookTest) (bool, RequestError) {
+	if test.URL == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEventWebhookURLRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/user/webhooks/event/test", test)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed testing event webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedTestingEventWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// EventWebhook is the configuration of the event webhook of the account: the URL the events are posted to,
+// and the kinds of events posted. The OAuth client secret is never returned by Sendgrid.
+// The OAuth client ID and token URL are always sent, so that emptying them disables OAuth.
+type EventWebhook struct {
+	Enabled           bool   `json:"enabled"`
+	URL               string `json:"url"`
+	GroupResubscribe  bool   `json:"group_resubscribe"`
+	Delivered         bool   `json:"delivered"`
+	GroupUnsubscribe  bool   `json:"group_unsubscribe"`
+	SpamReport        bool   `json:"spam_report"`
+	Bounce            bool   `json:"bounce"`
+	Deferred          bool   `json:"deferred"`
+	Unsubscribe       bool   `json:"unsubscribe"`
+	Processed         bool   `json:"processed"`
+	Open              bool   `json:"open"`
+	Click             bool   `json:"click"`
+	Dropped           bool   `json:"dropped"`
+	OAuthClientID     string `json:"oauth_client_id"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	OAuthTokenURL     string `json:"oauth_token_url"`
+}
+
+func parseEventWebhook(respBody string) (*EventWebhook, RequestError) {
+	var body EventWebhook
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing event webhook: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadEventWebhook retrieves the configuration of the event webhook of the account, which always exists.
+func (c *Client) ReadEventWebhook() (*EventWebhook, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/user/webhooks/event/settings")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading event webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingEventWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseEventWebhook(respBody)
+}
+
+// UpdateEventWebhook replaces the configuration of the event webhook of the account and returns it.
+func (c *Client) UpdateEventWebhook(webhook EventWebhook) (*EventWebhook, RequestError) {
+	if webhook.Enabled && webhook.URL == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEventWebhookURLRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/user/webhooks/event/settings", webhook)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating event webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingEventWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseEventWebhook(respBody)
+}
+
+// EventWebhookSigning is the signature of the events posted by the event webhook.
+type EventWebhookSigning struct {
+	Enabled   bool   `json:"enabled"`
+	PublicKey string `json:"public_key,omitempty"`
+}
+
+func parseEventWebhookSigning(respBody string) (*EventWebhookSigning, RequestError) {
+	var body EventWebhookSigning
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing event webhook signing: %w", err),
+		}
+	}
+
+	body.Enabled = body.PublicKey != ""
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadEventWebhookSigning retrieves the public key verifying the signature of the events,
+// which is empty when the events aren't signed.
+func (c *Client) ReadEventWebhookSigning() (*EventWebhookSigning, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/user/webhooks/event/settings/signed")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading event webhook signing: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingEventWebhookSigning, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseEventWebhookSigning(respBody)
+}
+
+// UpdateEventWebhookSigning enables or disables the signature of the events.
+// Each time the signature is enabled, Sendgrid generates a new key pair.
+func (c *Client) UpdateEventWebhookSigning(enabled bool) (*EventWebhookSigning, RequestError) {
+	respBody, statusCode, err := c.Post("PATCH", "/user/webhooks/event/settings/signed", EventWebhookSigning{
+		Enabled: enabled,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating event webhook signing: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingEventWebhookSigning, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseEventWebhookSigning(respBody)
+}
@@ -0,0 +1,48 @@
+package sendgrid_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestUpdateEventWebhookDisablesOAuth(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PATCH" || r.URL.Path != "/user/webhooks/event/settings" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// the emptied OAuth configuration must be sent for Sendgrid to disable it.
+		if !strings.Contains(string(body), `"oauth_client_id":"","oauth_token_url":""`) {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		if strings.Contains(string(body), "oauth_client_secret") {
+			t.Errorf("unexpected client secret: %s", body)
+		}
+
+		//nolint:errcheck
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	webhook, requestErr := c.UpdateEventWebhook(sendgrid.EventWebhook{
+		Enabled: true,
+		URL:     "https://example.org/sendgrid/events",
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if webhook.OAuthClientID != "" {
+		t.Fatalf("unexpected event webhook: %+v", webhook)
+	}
+}
@@ -0,0 +1,72 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// ipsPageSize is the number of IPs retrieved per call when listing IPs.
+const ipsPageSize = 100
+
+// IP is an IP address of the Sendgrid account.
+type IP struct {
+	IP           string   `json:"ip,omitempty"`
+	Subusers     []string `json:"subusers,omitempty"`
+	Pools        []string `json:"pools,omitempty"`
+	RDNS         string   `json:"rdns,omitempty"`
+	Warmup       bool     `json:"warmup,omitempty"`
+	StartDate    int64    `json:"start_date,omitempty"`
+	Whitelabeled bool     `json:"whitelabeled,omitempty"`
+	AssignedAt   int64    `json:"assigned_at,omitempty"`
+	Region       string   `json:"region,omitempty"`
+}
+
+func parseIPs(respBody string) ([]IP, RequestError) {
+	var body []IP
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IPs: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListIPs retrieves all the IP addresses of the account, page by page, and returns them.
+func (c *Client) ListIPs() ([]IP, RequestError) {
+	var ips []IP
+
+	for offset := 0; ; offset += ipsPageSize {
+		endpoint := "/ips?limit=" + strconv.Itoa(ipsPageSize) + "&offset=" + strconv.Itoa(offset)
+
+		respBody, statusCode, err := c.Get("GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing IPs: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingIPs, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		page, requestErr := parseIPs(respBody)
+		if requestErr.Err != nil {
+			return nil, requestErr
+		}
+
+		ips = append(ips, page...)
+
+		if len(page) < ipsPageSize {
+			return ips, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
@@ -0,0 +1,196 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IPPool is a pool of dedicated IP addresses, identified by its name.
+type IPPool struct {
+	Name string     `json:"pool_name,omitempty"`
+	IPs  []IPPoolIP `json:"ips,omitempty"`
+}
+
+// IPPoolIP is an IP address of an IP pool.
+type IPPoolIP struct {
+	IP        string `json:"ip,omitempty"`
+	StartDate int64  `json:"start_date,omitempty"`
+	Warmup    bool   `json:"warmup,omitempty"`
+}
+
+type ipPoolName struct {
+	Name string `json:"name,omitempty"`
+}
+
+func parseIPPool(respBody string) (*IPPool, RequestError) {
+	var body IPPool
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP pool: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateIPPool creates an empty IP pool.
+func (c *Client) CreateIPPool(name string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/ips/pools", ipPoolName{Name: name})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingIPPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadIPPool retrieves an IP pool and its IP addresses.
+func (c *Client) ReadIPPool(name string) (*IPPool, RequestError) {
+	if name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/ips/pools/"+url.PathEscape(name))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingIPPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseIPPool(respBody)
+}
+
+// RenameIPPool renames an IP pool. Sendgrid identifies the pools by name,
+// the pool keeps its IP addresses but has to be referenced by its new name afterwards.
+func (c *Client) RenameIPPool(name, newName string) (bool, RequestError) {
+	if name == "" || newName == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PUT", "/ips/pools/"+url.PathEscape(name), ipPoolName{Name: newName})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed renaming IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedRenamingIPPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteIPPool deletes an IP pool, its IP addresses aren't deleted.
+func (c *Client) DeleteIPPool(name string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/ips/pools/"+url.PathEscape(name))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingIPPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddIPToPool adds an IP address to an IP pool.
+func (c *Client) AddIPToPool(name, ip string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	if ip == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/ips/pools/"+url.PathEscape(name)+"/ips", IPPoolIP{IP: ip})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed adding IP address to IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedAddingIPToPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// RemoveIPFromPool removes an IP address from an IP pool.
+func (c *Client) RemoveIPFromPool(name, ip string) (bool, RequestError) {
+	if name == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPPoolNameRequired}
+	}
+
+	if ip == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/ips/pools/"+url.PathEscape(name)+"/ips/"+url.PathEscape(ip))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed removing IP address from IP pool: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedRemovingIPFromPool, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,55 @@
+package sendgrid_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestRenameIPPoolKeepsIPs(t *testing.T) {
+	ips := map[string][]string{"old": {"192.0.2.1"}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "PUT" && r.URL.Path == "/ips/pools/old":
+			var body struct {
+				Name string `json:"name"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected body: %v", err)
+
+				return
+			}
+
+			ips[body.Name] = ips["old"]
+			delete(ips, "old")
+
+			fmt.Fprintf(w, `{"name": %q}`, body.Name)
+		case r.Method == "GET" && r.URL.Path == "/ips/pools/new":
+			fmt.Fprintf(w, `{"pool_name": "new", "ips": [{"ip": %q, "warmup": false}]}`, ips["new"][0])
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, requestErr := c.RenameIPPool("old", "new"); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	pool, requestErr := c.ReadIPPool("new")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if pool.Name != "new" || len(pool.IPs) != 1 || pool.IPs[0].IP != "192.0.2.1" {
+		t.Errorf("unexpected pool: %+v", pool)
+	}
+}
@@ -0,0 +1,123 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// IPWarmup is an IP address being warmed up.
+type IPWarmup struct {
+	IP        string `json:"ip,omitempty"`
+	StartDate int64  `json:"start_date,omitempty"`
+}
+
+func parseIPWarmups(respBody string) ([]IPWarmup, RequestError) {
+	var body []IPWarmup
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing IP warmup: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// firstIPWarmup returns the warmup of the IP address, Sendgrid returns it in a list.
+func firstIPWarmup(warmups []IPWarmup, ip string) (*IPWarmup, RequestError) {
+	for i := range warmups {
+		if warmups[i].IP == ip {
+			return &warmups[i], RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrIPWarmupNotFound}
+}
+
+// StartIPWarmup starts warming up an IP address and returns its warmup.
+func (c *Client) StartIPWarmup(ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/ips/warmup", IPWarmup{IP: ip})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed starting IP warmup: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedStartingIPWarmup, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	warmups, requestErr := parseIPWarmups(respBody)
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return firstIPWarmup(warmups, ip)
+}
+
+// ReadIPWarmup retrieves the warmup of an IP address. If the IP address isn't being warmed up,
+// the returned error has the status http.StatusNotFound.
+func (c *Client) ReadIPWarmup(ip string) (*IPWarmup, RequestError) {
+	if ip == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading IP warmup: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingIPWarmup, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	warmups, requestErr := parseIPWarmups(respBody)
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return firstIPWarmup(warmups, ip)
+}
+
+// StopIPWarmup stops warming up an IP address.
+func (c *Client) StopIPWarmup(ip string) (bool, RequestError) {
+	if ip == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/ips/warmup/"+url.PathEscape(ip))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed stopping IP warmup: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedStoppingIPWarmup, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
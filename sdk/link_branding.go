@@ -0,0 +1,143 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// LinkBranding is a domain the links of the emails are rewritten to, instead of sendgrid.net.
+type LinkBranding struct {
+	ID        int64                `json:"id,omitempty"`
+	Domain    string               `json:"domain,omitempty"`
+	Subdomain string               `json:"subdomain,omitempty"`
+	Username  string               `json:"username,omitempty"`
+	Default   bool                 `json:"default,omitempty"`
+	Legacy    bool                 `json:"legacy,omitempty"`
+	Valid     bool                 `json:"valid,omitempty"`
+	DNS       map[string]DNSRecord `json:"dns,omitempty"`
+}
+
+// LinkBrandingRequest is the configuration of a link branding to create.
+type LinkBrandingRequest struct {
+	Domain    string `json:"domain"`
+	Subdomain string `json:"subdomain,omitempty"`
+	Default   bool   `json:"default"`
+}
+
+type linkBrandingUpdate struct {
+	Default bool `json:"default"`
+}
+
+func parseLinkBranding(respBody string) (*LinkBranding, RequestError) {
+	var body LinkBranding
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing link branding: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateLinkBranding creates a link branding and returns it, with the DNS records to create.
+func (c *Client) CreateLinkBranding(request LinkBrandingRequest) (*LinkBranding, RequestError) {
+	if request.Domain == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/links", request)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating link branding: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingLinkBranding, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseLinkBranding(respBody)
+}
+
+// ReadLinkBranding retrieves a link branding by ID and returns it.
+func (c *Client) ReadLinkBranding(id string) (*LinkBranding, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrLinkBrandingIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/whitelabel/links/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading link branding: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingLinkBranding, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseLinkBranding(respBody)
+}
+
+// UpdateLinkBranding sets whether a link branding is the default one. Sendgrid allows a single default
+// link branding: setting it on a link branding unsets it on the previous default one.
+func (c *Client) UpdateLinkBranding(id string, isDefault bool) (*LinkBranding, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrLinkBrandingIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/whitelabel/links/"+id, linkBrandingUpdate{Default: isDefault})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating link branding: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingLinkBranding, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseLinkBranding(respBody)
+}
+
+// DeleteLinkBranding deletes a link branding.
+func (c *Client) DeleteLinkBranding(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrLinkBrandingIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/whitelabel/links/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting link branding: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingLinkBranding, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,83 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/sendgrid/rest"
+)
+
+// redacted replaces the values of the sensitive fields in the logs.
+const redacted = "REDACTED"
+
+// sensitiveFields returns the fields of the request bodies which are never logged.
+func sensitiveFields() []string {
+	return []string{"password", "api_key", "oauth_client_secret"}
+}
+
+func isSensitiveField(field string) bool {
+	for _, sensitive := range sensitiveFields() {
+		if field == sensitive {
+			return true
+		}
+	}
+
+	return false
+}
+
+// redact replaces the values of the sensitive fields of a decoded JSON value, at any depth.
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			if isSensitiveField(field) {
+				v[field] = redacted
+			} else {
+				v[field] = redact(fieldValue)
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redact(item)
+		}
+	}
+
+	return value
+}
+
+// sanitizeBody returns the body of a request as it can be logged, without its sensitive fields.
+func sanitizeBody(body []byte) string {
+	if len(body) == 0 {
+		return ""
+	}
+
+	var decoded interface{}
+	if err := json.Unmarshal(body, &decoded); err != nil {
+		return "(body isn't JSON, not logged)"
+	}
+
+	sanitized, err := json.Marshal(redact(decoded))
+	if err != nil {
+		return "(body can't be sanitized, not logged)"
+	}
+
+	return string(sanitized)
+}
+
+// logRequest logs a request sent to Sendgrid and the status of its response,
+// when Terraform logs at the DEBUG or TRACE level.
+func logRequest(req rest.Request, resp *rest.Response, err error) {
+	if !logging.IsDebugOrHigher() {
+		return
+	}
+
+	log.Printf("[DEBUG] Sendgrid request: %s %s %s", req.Method, req.BaseURL, sanitizeBody(req.Body))
+
+	switch {
+	case err != nil:
+		log.Printf("[DEBUG] Sendgrid request failed: %s %s: %s", req.Method, req.BaseURL, err)
+	case resp != nil:
+		log.Printf("[DEBUG] Sendgrid response: %s %s: %d", req.Method, req.BaseURL, resp.StatusCode)
+	}
+}
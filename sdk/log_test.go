@@ -0,0 +1,146 @@
+package sendgrid_test
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestClientLogsSanitizedRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+
+	var logs bytes.Buffer
+
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, _, err := c.Post("POST", "/subusers", map[string]interface{}{
+		"username": "subuser",
+		"password": "s3cr3t-password",
+		"nested":   []interface{}{map[string]interface{}{"api_key": "SG.s3cr3t-key"}},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	output := logs.String()
+
+	if strings.Contains(output, "s3cr3t") {
+		t.Errorf("expected the sensitive fields to be redacted: %s", output)
+	}
+
+	for _, expected := range []string{"POST", "/subusers", `"username":"subuser"`, "REDACTED", "201"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected the logs to contain %q: %s", expected, output)
+		}
+	}
+}
+
+func TestClientDoesNotLogByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	os.Unsetenv("TF_LOG")
+
+	var logs bytes.Buffer
+
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, _, err := c.Get("GET", "/scopes"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if logs.Len() != 0 {
+		t.Errorf("expected no logs: %s", logs.String())
+	}
+}
+
+func TestCreateAPIKeyDoesNotLogSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"api_key_id": "id", "name": "key", "api_key": "SG.s3cr3t-key", "scopes": ["mail.send"]}`)
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+
+	var logs bytes.Buffer
+
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	apiKey, requestErr := c.CreateAPIKey("key", []string{"mail.send"})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if apiKey.APIKey != "SG.s3cr3t-key" {
+		t.Fatalf("unexpected API key: %+v", apiKey)
+	}
+
+	// only the status of the response is logged, never the secret it returns.
+	if output := logs.String(); strings.Contains(output, "s3cr3t") || !strings.Contains(output, "201") {
+		t.Errorf("expected the secret not to be logged: %s", output)
+	}
+}
+
+func TestUpdateEventWebhookDoesNotLogOAuthSecret(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"enabled": true, "url": "https://example.com/events", "oauth_client_id": "client"}`)
+	}))
+	defer server.Close()
+
+	os.Setenv("TF_LOG", "DEBUG")
+	defer os.Unsetenv("TF_LOG")
+
+	var logs bytes.Buffer
+
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, requestErr := c.UpdateEventWebhook(sendgrid.EventWebhook{
+		Enabled:           true,
+		URL:               "https://example.com/events",
+		OAuthClientID:     "client",
+		OAuthClientSecret: "s3cr3t-oauth",
+		OAuthTokenURL:     "https://example.com/token",
+	}); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	output := logs.String()
+	if strings.Contains(output, "s3cr3t") {
+		t.Errorf("expected the OAuth client secret to be redacted: %s", output)
+	}
+
+	for _, expected := range []string{"/user/webhooks/event/settings", `"oauth_client_id":"client"`, "REDACTED"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("expected the logs to contain %q: %s", expected, output)
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package sendgrid
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// MailAddress is an email address and the name displayed with it.
+type MailAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// Mail is an email sent with a dynamic template, to every recipient at once.
+type Mail struct {
+	From                MailAddress
+	To                  []MailAddress
+	TemplateID          string
+	DynamicTemplateData map[string]interface{}
+	BatchID             string
+	SandboxMode         bool
+}
+
+type mailPersonalization struct {
+	To                  []MailAddress          `json:"to"`
+	DynamicTemplateData map[string]interface{} `json:"dynamic_template_data,omitempty"`
+}
+
+type mailSandboxMode struct {
+	Enable bool `json:"enable"`
+}
+
+type mailSettings struct {
+	SandboxMode mailSandboxMode `json:"sandbox_mode"`
+}
+
+type mailSend struct {
+	Personalizations []mailPersonalization `json:"personalizations"`
+	From             MailAddress           `json:"from"`
+	TemplateID       string                `json:"template_id,omitempty"`
+	BatchID          string                `json:"batch_id,omitempty"`
+	MailSettings     mailSettings          `json:"mail_settings"`
+}
+
+// SendMail sends an email. In sandbox mode, Sendgrid only validates it.
+func (c *Client) SendMail(mail Mail) (bool, RequestError) {
+	if mail.From.Email == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMailFromRequired}
+	}
+
+	if len(mail.To) == 0 {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMailToRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/mail/send", mailSend{
+		Personalizations: []mailPersonalization{{
+			To:                  mail.To,
+			DynamicTemplateData: mail.DynamicTemplateData,
+		}},
+		From:         mail.From,
+		TemplateID:   mail.TemplateID,
+		BatchID:      mail.BatchID,
+		MailSettings: mailSettings{SandboxMode: mailSandboxMode{Enable: mail.SandboxMode}},
+	})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed sending mail: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedSendingMail, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,44 @@
+package sendgrid_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestSendMail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != http.MethodPost || r.URL.Path != "/mail/send" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		expected := `{"personalizations":[{"to":[{"email":"to@example.org"}],` +
+			`"dynamic_template_data":{"name":"Terraform"}}],"from":{"email":"from@example.org","name":"From"},` +
+			`"template_id":"d-1","batch_id":"batch","mail_settings":{"sandbox_mode":{"enable":true}}}`
+		if string(body) != expected {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, requestErr := c.SendMail(sendgrid.Mail{
+		From:                sendgrid.MailAddress{Email: "from@example.org", Name: "From"},
+		To:                  []sendgrid.MailAddress{{Email: "to@example.org"}},
+		TemplateID:          "d-1",
+		DynamicTemplateData: map[string]interface{}{"name": "Terraform"},
+		BatchID:             "batch",
+		SandboxMode:         true,
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+}
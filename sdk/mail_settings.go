@@ -0,0 +1,230 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// MailSettingAddressWhitelist is the list of addresses and domains never suppressed.
+type MailSettingAddressWhitelist struct {
+	Enabled bool     `json:"enabled"`
+	List    []string `json:"list"`
+}
+
+// MailSettingFooter is the footer appended to every email.
+type MailSettingFooter struct {
+	Enabled      bool   `json:"enabled"`
+	HTMLContent  string `json:"html_content"`
+	PlainContent string `json:"plain_content"`
+}
+
+// MailSettingBCC is the address every email is blind copied to.
+type MailSettingBCC struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingBouncePurge is the number of days after which the bounces are purged.
+type MailSettingBouncePurge struct {
+	Enabled     bool `json:"enabled"`
+	SoftBounces int  `json:"soft_bounces"`
+	HardBounces int  `json:"hard_bounces"`
+}
+
+// MailSettingForwardSpam is the addresses the spam reports are forwarded to.
+type MailSettingForwardSpam struct {
+	Enabled bool   `json:"enabled"`
+	Email   string `json:"email"`
+}
+
+// MailSettingSpamCheck is the spam score above which the emails are dropped, or posted to a URL.
+type MailSettingSpamCheck struct {
+	Enabled  bool   `json:"enabled"`
+	URL      string `json:"url"`
+	MaxScore int    `json:"max_score"`
+}
+
+func (c *Client) readMailSetting(name string, setting interface{}) RequestError {
+	respBody, statusCode, err := c.Get("GET", "/mail_settings/"+name)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading mail setting %s: %w", name, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w %s, status: %d, response: %s", ErrFailedReadingMailSetting, name, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err = json.Unmarshal([]byte(respBody), setting); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing mail setting %s: %w", name, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func (c *Client) updateMailSetting(name string, setting interface{}) RequestError {
+	respBody, statusCode, err := c.Post("PATCH", "/mail_settings/"+name, setting)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating mail setting %s: %w", name, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w %s, status: %d, response: %s", ErrFailedUpdatingMailSetting, name, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err = json.Unmarshal([]byte(respBody), setting); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing mail setting %s: %w", name, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingAddressWhitelist retrieves the address whitelist mail setting.
+func (c *Client) ReadMailSettingAddressWhitelist() (*MailSettingAddressWhitelist, RequestError) {
+	var setting MailSettingAddressWhitelist
+
+	if requestErr := c.readMailSetting("address_whitelist", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingAddressWhitelist edits the address whitelist mail setting.
+func (c *Client) UpdateMailSettingAddressWhitelist(
+	setting MailSettingAddressWhitelist,
+) (*MailSettingAddressWhitelist, RequestError) {
+	if setting.List == nil {
+		setting.List = []string{}
+	}
+
+	if requestErr := c.updateMailSetting("address_whitelist", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingFooter retrieves the footer mail setting.
+func (c *Client) ReadMailSettingFooter() (*MailSettingFooter, RequestError) {
+	var setting MailSettingFooter
+
+	if requestErr := c.readMailSetting("footer", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingFooter edits the footer mail setting.
+func (c *Client) UpdateMailSettingFooter(setting MailSettingFooter) (*MailSettingFooter, RequestError) {
+	if requestErr := c.updateMailSetting("footer", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingBCC retrieves the BCC mail setting.
+func (c *Client) ReadMailSettingBCC() (*MailSettingBCC, RequestError) {
+	var setting MailSettingBCC
+
+	if requestErr := c.readMailSetting("bcc", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingBCC edits the BCC mail setting.
+func (c *Client) UpdateMailSettingBCC(setting MailSettingBCC) (*MailSettingBCC, RequestError) {
+	if requestErr := c.updateMailSetting("bcc", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingBouncePurge retrieves the bounce purge mail setting.
+func (c *Client) ReadMailSettingBouncePurge() (*MailSettingBouncePurge, RequestError) {
+	var setting MailSettingBouncePurge
+
+	if requestErr := c.readMailSetting("bounce_purge", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingBouncePurge edits the bounce purge mail setting.
+func (c *Client) UpdateMailSettingBouncePurge(setting MailSettingBouncePurge) (*MailSettingBouncePurge, RequestError) {
+	if requestErr := c.updateMailSetting("bounce_purge", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingForwardSpam retrieves the forward spam mail setting.
+func (c *Client) ReadMailSettingForwardSpam() (*MailSettingForwardSpam, RequestError) {
+	var setting MailSettingForwardSpam
+
+	if requestErr := c.readMailSetting("forward_spam", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingForwardSpam edits the forward spam mail setting.
+func (c *Client) UpdateMailSettingForwardSpam(setting MailSettingForwardSpam) (*MailSettingForwardSpam, RequestError) {
+	if requestErr := c.updateMailSetting("forward_spam", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadMailSettingSpamCheck retrieves the spam check mail setting.
+func (c *Client) ReadMailSettingSpamCheck() (*MailSettingSpamCheck, RequestError) {
+	var setting MailSettingSpamCheck
+
+	if requestErr := c.readMailSetting("spam_check", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateMailSettingSpamCheck edits the spam check mail setting.
+func (c *Client) UpdateMailSettingSpamCheck(setting MailSettingSpamCheck) (*MailSettingSpamCheck, RequestError) {
+	if requestErr := c.updateMailSetting("spam_check", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
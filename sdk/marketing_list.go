@@ -0,0 +1,156 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// MarketingList is a list of marketing contacts.
+type MarketingList struct {
+	ID           string `json:"id,omitempty"`
+	Name         string `json:"name,omitempty"`
+	ContactCount int    `json:"contact_count,omitempty"`
+}
+
+func parseMarketingList(respBody string) (*MarketingList, RequestError) {
+	var body MarketingList
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing marketing list: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateMarketingList creates a marketing list and returns it.
+func (c *Client) CreateMarketingList(name string) (*MarketingList, RequestError) {
+	if name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMarketingListNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/marketing/lists", MarketingList{Name: name})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating marketing list: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingMarketingList, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseMarketingList(respBody)
+}
+
+// ReadMarketingList retrieves a marketing list and returns it.
+func (c *Client) ReadMarketingList(id string) (*MarketingList, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMarketingListIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/lists/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading marketing list: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingMarketingList, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseMarketingList(respBody)
+}
+
+// UpdateMarketingList renames a marketing list and returns it.
+func (c *Client) UpdateMarketingList(id, name string) (*MarketingList, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMarketingListIDRequired}
+	}
+
+	if name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMarketingListNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/marketing/lists/"+id, MarketingList{Name: name})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating marketing list: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingMarketingList, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseMarketingList(respBody)
+}
+
+// DeleteMarketingList deletes a marketing list, and its contacts too if deleteContacts is true.
+// The contacts are deleted asynchronously, the ID of the job deleting them is returned,
+// it's empty when only the list is deleted.
+func (c *Client) DeleteMarketingList(id string, deleteContacts bool) (string, RequestError) {
+	if id == "" {
+		return "", RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrMarketingListIDRequired}
+	}
+
+	endpoint := "/marketing/lists/" + id
+	if deleteContacts {
+		query := url.Values{}
+		query.Set("delete_contacts", "true")
+		endpoint += "?" + query.Encode()
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", endpoint)
+	if err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting marketing list: %w", err),
+		}
+	}
+
+	if statusCode == http.StatusNotFound { // ignore not found
+		return "", RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return "", RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingMarketingList, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if !deleteContacts || respBody == "" {
+		return "", RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	var body contactsJob
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return "", RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing contacts job: %w", err),
+		}
+	}
+
+	return body.JobID, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,63 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestDeleteMarketingListKeepsContacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/marketing/lists/list" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if r.URL.RawQuery != "" {
+			t.Errorf("the contacts mustn't be deleted: %s", r.URL)
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	jobID, requestErr := c.DeleteMarketingList("list", false)
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if jobID != "" {
+		t.Errorf("expected no job, got %s", jobID)
+	}
+}
+
+func TestDeleteMarketingListWithContacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "DELETE" || r.URL.Path != "/marketing/lists/list" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if r.URL.Query().Get("delete_contacts") != "true" {
+			t.Errorf("the contacts must be deleted: %s", r.URL)
+		}
+
+		w.WriteHeader(http.StatusAccepted)
+		fmt.Fprint(w, `{"job_id": "job"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	jobID, requestErr := c.DeleteMarketingList("list", true)
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if jobID != "job" {
+		t.Errorf("expected job job, got %s", jobID)
+	}
+}
@@ -0,0 +1,92 @@
+package sendgrid
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/sendgrid/rest"
+)
+
+// pageMetadata is the _metadata of a page of results, its next cursor is the URL of the following page.
+type pageMetadata struct {
+	Next string `json:"next,omitempty"`
+}
+
+// nextPageEndpoint returns the endpoint of the page behind the next cursor returned by Sendgrid,
+// or "" if there is no next page. The cursor is an absolute URL, e.g.
+// https://api.sendgrid.com/v3/marketing/automations?page_token=..., its query is kept as is.
+func nextPageEndpoint(next string) (string, error) {
+	if next == "" {
+		return "", nil
+	}
+
+	u, err := url.Parse(next)
+	if err != nil {
+		return "", fmt.Errorf("failed parsing next page %s: %w", next, err)
+	}
+
+	endpoint := strings.TrimPrefix(u.Path, "/v3")
+	if u.RawQuery != "" {
+		endpoint += "?" + u.RawQuery
+	}
+
+	return endpoint, nil
+}
+
+// followCursor calls the endpoint, then the pages behind the _metadata.next cursors, until a page has no
+// next cursor or no results. The body, if not nil, is sent with every page, action describes the calls
+// in the errors. parsePage decodes a page, keeps its results, and returns their number with its metadata.
+func (c *Client) followCursor(
+	method rest.Method,
+	endpoint string,
+	body interface{},
+	action string,
+	errFailed error,
+	parsePage func(respBody string) (int, pageMetadata, RequestError),
+) RequestError {
+	for endpoint != "" {
+		var (
+			respBody   string
+			statusCode int
+			err        error
+		)
+
+		if body == nil {
+			respBody, statusCode, err = c.Get(method, endpoint)
+		} else {
+			respBody, statusCode, err = c.Post(method, endpoint, body)
+		}
+
+		if err != nil {
+			return RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed %s: %w", action, err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", errFailed, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		count, metadata, requestErr := parsePage(respBody)
+		if requestErr.Err != nil {
+			return requestErr
+		}
+
+		if count == 0 {
+			break
+		}
+
+		if endpoint, err = nextPageEndpoint(metadata.Next); err != nil {
+			return RequestError{StatusCode: http.StatusInternalServerError, Err: err}
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,176 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// ParseWebhook is the configuration of the inbound parse webhook of a hostname: the emails received by
+// the hostname are parsed and posted to the URL.
+type ParseWebhook struct {
+	Hostname  string `json:"hostname"`
+	URL       string `json:"url"`
+	SpamCheck bool   `json:"spam_check"`
+	SendRaw   bool   `json:"send_raw"`
+}
+
+func parseParseWebhook(respBody string) (*ParseWebhook, RequestError) {
+	var body ParseWebhook
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing parse webhook: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+type parseWebhooks struct {
+	Result []ParseWebhook `json:"result"`
+}
+
+func validateParseWebhook(webhook ParseWebhook) RequestError {
+	if webhook.Hostname == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrParseWebhookHostnameRequired}
+	}
+
+	if webhook.URL == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrParseWebhookURLRequired}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateParseWebhook creates the inbound parse webhook of a hostname and returns it.
+func (c *Client) CreateParseWebhook(webhook ParseWebhook) (*ParseWebhook, RequestError) {
+	if requestErr := validateParseWebhook(webhook); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/user/webhooks/parse/settings", webhook)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating parse webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingParseWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseParseWebhook(respBody)
+}
+
+// ReadParseWebhook retrieves the inbound parse webhook of a hostname and returns it.
+func (c *Client) ReadParseWebhook(hostname string) (*ParseWebhook, RequestError) {
+	if hostname == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrParseWebhookHostnameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/user/webhooks/parse/settings/"+url.PathEscape(hostname))
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading parse webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingParseWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseParseWebhook(respBody)
+}
+
+// ListParseWebhooks retrieves the inbound parse webhooks of all the hostnames and returns them.
+func (c *Client) ListParseWebhooks() ([]ParseWebhook, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/user/webhooks/parse/settings")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing parse webhooks: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingParseWebhooks, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body parseWebhooks
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing parse webhooks: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateParseWebhook edits the inbound parse webhook of a hostname and returns it.
+func (c *Client) UpdateParseWebhook(webhook ParseWebhook) (*ParseWebhook, RequestError) {
+	if requestErr := validateParseWebhook(webhook); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post(
+		"PATCH", "/user/webhooks/parse/settings/"+url.PathEscape(webhook.Hostname), webhook,
+	)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating parse webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingParseWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseParseWebhook(respBody)
+}
+
+// DeleteParseWebhook deletes the inbound parse webhook of a hostname.
+func (c *Client) DeleteParseWebhook(hostname string) (bool, RequestError) {
+	if hostname == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrParseWebhookHostnameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/user/webhooks/parse/settings/"+url.PathEscape(hostname))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting parse webhook: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingParseWebhook, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,35 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestListParseWebhooks(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/user/webhooks/parse/settings" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		fmt.Fprint(w, `{"result": [
+			{"hostname": "parse.example.org", "url": "https://example.org/parse", "spam_check": true},
+			{"hostname": "raw.example.org", "url": "https://example.org/raw", "send_raw": true}
+		]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	webhooks, requestErr := c.ListParseWebhooks()
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(webhooks) != 2 || !webhooks[0].SpamCheck || webhooks[1].Hostname != "raw.example.org" || !webhooks[1].SendRaw {
+		t.Fatalf("unexpected webhooks: %+v", webhooks)
+	}
+}
@@ -0,0 +1,164 @@
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/sendgrid/rest"
+)
+
+const (
+	// DefaultRetryBaseDelay is the delay before the first retry of a failed request.
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+
+	// DefaultRetryMaxDelay is the maximum delay between two retries of a failed request.
+	DefaultRetryMaxDelay = 30 * time.Second
+
+	// maxBackoffShift avoids overflowing the delay when shifting the base delay.
+	maxBackoffShift = 32
+)
+
+// Backoff computes the delays between the attempts of a retried request.
+// MaxRetries bounds the number of retries after the first attempt, 0 means until the timeout.
+type Backoff struct {
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+	MaxRetries int
+}
+
+// Delay returns the delay to wait after the given attempt (starting at 0).
+// The delay grows exponentially from BaseDelay and is capped at MaxDelay.
+// Its second half is randomized (equal jitter) so that resources rate limited at the same time
+// don't retry all together, while a delay is never shorter than the previous one.
+func (b Backoff) Delay(attempt int) time.Duration {
+	delay := b.MaxDelay
+	if attempt < maxBackoffShift {
+		if exp := b.BaseDelay << uint(attempt); exp > 0 && exp < b.MaxDelay {
+			delay = exp
+		}
+	}
+
+	half := delay / 2
+
+	//nolint:gosec
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// transientRetries bounds the retries of an idempotent request failing with a transient server error,
+// unless the backoff bounds them.
+const transientRetries = 3
+
+// isRetryable tells if a request which failed with the given status code can be retried, whatever its method:
+// when rate limited, or when Sendgrid is unavailable, the request wasn't processed. The errors raised by
+// the client itself, e.g. a missing ID or a response which can't be parsed, aren't retried.
+func isRetryable(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusServiceUnavailable
+}
+
+// isTransientServerError tells if a response is a transient server error, after which the request may
+// have been processed anyway.
+func isTransientServerError(statusCode int) bool {
+	switch statusCode {
+	case http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// isIdempotent tells if a request can be sent again without creating a duplicate, e.g. after a transient
+// server error: unlike POST and PATCH, sending it twice has the same effect as once.
+func isIdempotent(method rest.Method) bool {
+	switch method {
+	case rest.Get, rest.Put, rest.Delete:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryTransient sends an idempotent request again while Sendgrid responds with a transient server error,
+// up to the maximum number of retries of the backoff, or transientRetries. It stops waiting when the context
+// is done, and returns the last response.
+func (c *Client) retryTransient(
+	ctx context.Context,
+	req rest.Request,
+	send func() (*rest.Response, error),
+) (*rest.Response, error) {
+	maxRetries := c.Backoff.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = transientRetries
+	}
+
+	for attempt := 0; ; attempt++ {
+		resp, err := send()
+		if err != nil || !isIdempotent(req.Method) || !isTransientServerError(resp.StatusCode) ||
+			attempt >= maxRetries {
+			return resp, err
+		}
+
+		timer := time.NewTimer(c.Backoff.Delay(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return resp, nil
+		case <-timer.C:
+		}
+	}
+}
+
+// Retry calls f until it succeeds, fails with a non retryable error, the maximum number of retries
+// or the timeout is reached. Between two attempts, it waits for the delay computed by the backoff of the client.
+func (c *Client) Retry(
+	ctx context.Context, timeout time.Duration, f func() (interface{}, RequestError)) (interface{}, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	for attempt := 0; ; attempt++ {
+		resp, requestErr := f()
+		if requestErr.Err == nil {
+			return resp, nil
+		}
+
+		if !isRetryable(requestErr.StatusCode) {
+			return resp, fmt.Errorf("request failed: %w", requestErr)
+		}
+
+		if c.Backoff.MaxRetries > 0 && attempt >= c.Backoff.MaxRetries {
+			return resp, fmt.Errorf("request failed after %d attempts: %w", attempt+1, requestErr)
+		}
+
+		timer := time.NewTimer(c.Backoff.Delay(attempt))
+
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return resp, fmt.Errorf(
+				"request failed, timeout reached while retrying after %d attempts: %w", attempt+1, requestErr,
+			)
+		case <-timer.C:
+		}
+	}
+}
+
+// RetryOnRateLimit management of RequestErrors, and launch a retry if needed:
+// on rate limits (429) and when Sendgrid is unavailable (503). The idempotent requests are also retried
+// by the client on transient server errors (500, 502 and 504). It retries until the timeout of the operation
+// being applied, given by its key, e.g. schema.TimeoutUpdate.
+func (c *Client) RetryOnRateLimit(
+	ctx context.Context,
+	d *schema.ResourceData,
+	timeoutKey string,
+	f func() (interface{}, RequestError),
+) (interface{}, error) {
+	return c.Retry(ctx, d.Timeout(timeoutKey), f)
+}
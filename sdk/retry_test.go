@@ -0,0 +1,256 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestBackoffDelayGrows(t *testing.T) {
+	b := sendgrid.Backoff{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  10 * time.Second,
+	}
+
+	previous := time.Duration(0)
+
+	// 100ms << 6 = 6.4s is the last delay below the cap.
+	for attempt := 0; attempt <= 6; attempt++ {
+		delay := b.Delay(attempt)
+		if delay < previous {
+			t.Fatalf("attempt %d: delay %s is shorter than the previous one %s", attempt, delay, previous)
+		}
+
+		if delay < b.BaseDelay<<uint(attempt)/2 || delay > b.BaseDelay<<uint(attempt) {
+			t.Fatalf("attempt %d: delay %s is out of bounds", attempt, delay)
+		}
+
+		previous = delay
+	}
+
+	for attempt := 7; attempt < 100; attempt++ {
+		if delay := b.Delay(attempt); delay > b.MaxDelay || delay < b.MaxDelay/2 {
+			t.Fatalf("attempt %d: delay %s isn't capped by %s", attempt, delay, b.MaxDelay)
+		}
+	}
+}
+
+func TestRetryOnRateLimit(t *testing.T) {
+	c := sendgrid.NewClient("", "", "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	attempts := 0
+
+	resp, err := c.Retry(context.Background(), time.Second, func() (interface{}, sendgrid.RequestError) {
+		attempts++
+		if attempts < 3 {
+			return nil, sendgrid.RequestError{StatusCode: http.StatusTooManyRequests, Err: sendgrid.ErrBodyNotNil}
+		}
+
+		return "ok", sendgrid.RequestError{StatusCode: http.StatusOK}
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if resp.(string) != "ok" || attempts != 3 {
+		t.Fatalf("expected a success after 3 attempts, got %v after %d attempts", resp, attempts)
+	}
+}
+
+// testRetryServer returns a server responding with the given statuses in turn, then 200, and counting the requests.
+func testRetryServer(t *testing.T, body string, statuses ...int) (*httptest.Server, *int32) {
+	t.Helper()
+
+	var requests int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if i := int(atomic.AddInt32(&requests, 1)) - 1; i < len(statuses) {
+			w.WriteHeader(statuses[i])
+
+			return
+		}
+
+		fmt.Fprint(w, body)
+	}))
+
+	return server, &requests
+}
+
+func testRetryClient(url string) *sendgrid.Client {
+	c := sendgrid.NewClient("key", url, "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	return c
+}
+
+func TestRetryOnServiceUnavailable(t *testing.T) {
+	server, requests := testRetryServer(t, `{"email": "monitor@example.org", "frequency": 10}`,
+		http.StatusServiceUnavailable)
+	defer server.Close()
+
+	c := testRetryClient(server.URL)
+
+	monitor, err := c.Retry(context.Background(), time.Second, func() (interface{}, sendgrid.RequestError) {
+		return c.ReadSubUserMonitor("user")
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if monitor.(*sendgrid.SubUserMonitor).Email != "monitor@example.org" || atomic.LoadInt32(requests) != 2 {
+		t.Fatalf("expected a success after 2 requests, got %v after %d requests", monitor, *requests)
+	}
+}
+
+func TestRetryIdempotentOnServerError(t *testing.T) {
+	server, requests := testRetryServer(t, `{"email": "monitor@example.org", "frequency": 10}`,
+		http.StatusInternalServerError, http.StatusBadGateway, http.StatusGatewayTimeout)
+	defer server.Close()
+
+	c := testRetryClient(server.URL)
+
+	if _, requestErr := c.ReadSubUserMonitor("user"); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if atomic.LoadInt32(requests) != 4 {
+		t.Fatalf("expected a success after 4 requests, got %d requests", *requests)
+	}
+}
+
+func TestRetryIdempotentOnServerErrorUntilContextDone(t *testing.T) {
+	server, requests := testRetryServer(t, `{"email": "monitor@example.org", "frequency": 10}`,
+		http.StatusInternalServerError, http.StatusInternalServerError)
+	defer server.Close()
+
+	c := testRetryClient(server.URL)
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Minute, MaxDelay: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+
+	// the operation timed out, the retry doesn't wait for its delay.
+	_, requestErr := c.WithContext(ctx).ReadSubUserMonitor("user")
+	if requestErr.StatusCode != http.StatusInternalServerError || atomic.LoadInt32(requests) != 1 {
+		t.Fatalf("expected a server error after 1 request, got %v after %d requests", requestErr, *requests)
+	}
+
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected the retry to stop with the context, it took %s", elapsed)
+	}
+}
+
+func TestRetryNotNonIdempotentOnServerError(t *testing.T) {
+	server, requests := testRetryServer(t, `{"email": "monitor@example.org", "frequency": 10}`,
+		http.StatusInternalServerError)
+	defer server.Close()
+
+	c := testRetryClient(server.URL)
+
+	// the monitor may have been created anyway, creating it again could make a duplicate.
+	_, err := c.Retry(context.Background(), time.Second, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSubUserMonitor("user", sendgrid.SubUserMonitor{Email: "monitor@example.org", Frequency: 10})
+	})
+	if err == nil || atomic.LoadInt32(requests) != 1 {
+		t.Fatalf("expected a failure after 1 request, got %v after %d requests", err, *requests)
+	}
+}
+
+func TestRetryNotOnClientFailure(t *testing.T) {
+	server, requests := testRetryServer(t, `not json`)
+	defer server.Close()
+
+	c := testRetryClient(server.URL)
+
+	// the response can't be parsed, whatever the number of attempts.
+	_, err := c.Retry(context.Background(), time.Minute, func() (interface{}, sendgrid.RequestError) {
+		return c.ReadSubUserMonitor("user")
+	})
+	if err == nil || atomic.LoadInt32(requests) != 1 {
+		t.Fatalf("expected a failure after 1 request, got %v after %d requests", err, *requests)
+	}
+}
+
+func TestRetryNotOnClientError(t *testing.T) {
+	c := sendgrid.NewClient("", "", "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	attempts := 0
+
+	_, err := c.Retry(context.Background(), time.Second, func() (interface{}, sendgrid.RequestError) {
+		attempts++
+
+		return nil, sendgrid.RequestError{StatusCode: http.StatusBadRequest, Err: sendgrid.ErrBodyNotNil}
+	})
+	if err == nil || attempts != 1 {
+		t.Fatalf("expected a failure without retry, got %v after %d attempts", err, attempts)
+	}
+}
+
+func TestRetryTimeout(t *testing.T) {
+	c := sendgrid.NewClient("", "", "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	_, err := c.Retry(context.Background(), 50*time.Millisecond, func() (interface{}, sendgrid.RequestError) {
+		return nil, sendgrid.RequestError{StatusCode: http.StatusTooManyRequests, Err: sendgrid.ErrBodyNotNil}
+	})
+	if err == nil {
+		t.Fatal("expected an error once the timeout is reached")
+	}
+}
+
+func TestRetryOnRateLimitOperationTimeout(t *testing.T) {
+	c := sendgrid.NewClient("", "", "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 5 * time.Millisecond}
+
+	createTimeout, deleteTimeout := time.Minute, 50*time.Millisecond
+	d := (&schema.Resource{
+		Timeouts: &schema.ResourceTimeout{Create: &createTimeout, Delete: &deleteTimeout},
+	}).Data(nil)
+
+	start := time.Now()
+
+	_, err := c.RetryOnRateLimit(context.Background(), d, schema.TimeoutDelete,
+		func() (interface{}, sendgrid.RequestError) {
+			return nil, sendgrid.RequestError{StatusCode: http.StatusTooManyRequests, Err: sendgrid.ErrBodyNotNil}
+		})
+	if err == nil {
+		t.Fatal("expected an error once the timeout is reached")
+	}
+
+	// the delete gives up after its own timeout, not after the timeout of the create.
+	if elapsed := time.Since(start); elapsed > 10*time.Second {
+		t.Fatalf("expected the delete timeout to be used, gave up after %s", elapsed)
+	}
+}
+
+func TestRetryMaxRetries(t *testing.T) {
+	c := sendgrid.NewClient("", "", "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, MaxRetries: 2}
+
+	attempts := 0
+
+	_, err := c.Retry(context.Background(), time.Minute, func() (interface{}, sendgrid.RequestError) {
+		attempts++
+
+		return nil, sendgrid.RequestError{StatusCode: http.StatusTooManyRequests, Err: sendgrid.ErrBodyNotNil}
+	})
+	if err == nil || attempts != 3 {
+		t.Fatalf("expected a failure after 3 attempts, got %v after %d attempts", err, attempts)
+	}
+
+	if !strings.Contains(err.Error(), "after 3 attempts") {
+		t.Fatalf("expected the attempts in the error, got %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// ReverseDNS is the reverse DNS of a dedicated IP address, so that its PTR record matches the domain sending from it.
+type ReverseDNS struct {
+	ID        int64     `json:"id,omitempty"`
+	IP        string    `json:"ip,omitempty"`
+	RDNS      string    `json:"rdns,omitempty"`
+	Domain    string    `json:"domain,omitempty"`
+	Subdomain string    `json:"subdomain,omitempty"`
+	Valid     bool      `json:"valid,omitempty"`
+	Legacy    bool      `json:"legacy,omitempty"`
+	ARecord   DNSRecord `json:"a_record,omitempty"`
+}
+
+// ReverseDNSRequest is the configuration of the reverse DNS of an IP address.
+type ReverseDNSRequest struct {
+	IP        string `json:"ip"`
+	Domain    string `json:"domain"`
+	Subdomain string `json:"subdomain,omitempty"`
+}
+
+// ReverseDNSValidation is the result of the validation of the A record of a reverse DNS.
+type ReverseDNSValidation struct {
+	ID                int64                       `json:"id"`
+	Valid             bool                        `json:"valid"`
+	ValidationResults ReverseDNSValidationResults `json:"validation_results"`
+}
+
+// ReverseDNSValidationResults are the results of the validation of each DNS record of a reverse DNS.
+type ReverseDNSValidationResults struct {
+	ARecord DomainAuthenticationValidationResult `json:"a_record"`
+}
+
+type reverseDNSValidate struct{}
+
+func parseReverseDNS(respBody string) (*ReverseDNS, RequestError) {
+	var body ReverseDNS
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing reverse DNS: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateReverseDNS sets up the reverse DNS of an IP address and returns it, with the A record to create.
+func (c *Client) CreateReverseDNS(request ReverseDNSRequest) (*ReverseDNS, RequestError) {
+	if request.IP == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPAddressRequired}
+	}
+
+	if request.Domain == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrDomainRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/ips", request)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating reverse DNS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingReverseDNS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+// ReadReverseDNS retrieves a reverse DNS by ID and returns it.
+func (c *Client) ReadReverseDNS(id string) (*ReverseDNS, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrReverseDNSIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/whitelabel/ips/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading reverse DNS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingReverseDNS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseReverseDNS(respBody)
+}
+
+// DeleteReverseDNS deletes a reverse DNS.
+func (c *Client) DeleteReverseDNS(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrReverseDNSIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/whitelabel/ips/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting reverse DNS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingReverseDNS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ValidateReverseDNS asks Sendgrid to check the A record of a reverse DNS and returns the result of the validation.
+func (c *Client) ValidateReverseDNS(id string) (*ReverseDNSValidation, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrReverseDNSIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/whitelabel/ips/"+id+"/validate", reverseDNSValidate{})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed validating reverse DNS: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedValidatingReverseDNS, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body ReverseDNSValidation
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing reverse DNS validation: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
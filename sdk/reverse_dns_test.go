@@ -0,0 +1,38 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestValidateReverseDNSNotPropagated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/whitelabel/ips/1/validate" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		fmt.Fprint(w, `{"id": 1, "valid": false, "validation_results": {"a_record": {"valid": false, `+
+			`"reason": "Expected your A record to point to 192.0.2.1 but found 198.51.100.1."}}}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	validation, requestErr := c.ValidateReverseDNS("1")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	aRecord := validation.ValidationResults.ARecord
+	if validation.Valid || aRecord.Valid || !strings.Contains(aRecord.Reason, "but found 198.51.100.1") {
+		t.Fatalf("unexpected validation: %+v", validation)
+	}
+}
@@ -0,0 +1,120 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type scopes struct {
+	Scopes []string `json:"scopes"`
+}
+
+// ReadScopes retrieves the scopes of the API key the client authenticates with.
+func (c *Client) ReadScopes() ([]string, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/scopes")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading scopes: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingScopes, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body scopes
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing scopes: %w", err),
+		}
+	}
+
+	return body.Scopes, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListScopes retrieves the scopes of the API key the client authenticates with, like ReadScopes,
+// but Sendgrid is only called once per subuser, the following calls return the same scopes.
+func (c *Client) ListScopes() ([]string, RequestError) {
+	return c.Cached().ReadScopes()
+}
+
+// crudScopes returns the create, delete, read and update scopes of a family of scopes,
+// the form in which Sendgrid names and returns the scopes of a family, e.g. `templates.read`.
+func crudScopes(family string) []string {
+	return []string{family + ".create", family + ".delete", family + ".read", family + ".update"}
+}
+
+// ScopeIn tells if a scope is in the given scopes.
+func ScopeIn(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+
+	return false
+}
+
+func scopesIn(scopes, subset []string) bool {
+	for _, scope := range subset {
+		if !ScopeIn(scopes, scope) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ScopeGranted tells if a scope is granted by the given scopes: itself, or when it's a family of scopes,
+// all its create, delete, read and update scopes.
+func ScopeGranted(granted []string, scope string) bool {
+	return ScopeIn(granted, scope) || scopesIn(granted, crudScopes(scope))
+}
+
+// NormalizeScopes returns the scopes of an API key, as returned by Sendgrid, in the form they were declared:
+// a declared family of scopes replaces its create, delete, read and update scopes, only when Sendgrid
+// returned all of them. The other scopes are returned as is, so that a partially granted family shows up as a diff.
+func NormalizeScopes(scopes, declared []string) []string {
+	var collapsed []string
+
+	for _, family := range declared {
+		if scopesIn(scopes, crudScopes(family)) {
+			collapsed = append(collapsed, family)
+		}
+	}
+
+	normalized := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if ScopeIn(declared, scope) || !ScopeIn(collapsed, scopeFamily(scope)) {
+			normalized = append(normalized, scope)
+		}
+	}
+
+	for _, family := range collapsed {
+		if !ScopeIn(normalized, family) {
+			normalized = append(normalized, family)
+		}
+	}
+
+	return normalized
+}
+
+// scopeFamily returns the family of a create, delete, read or update scope, an empty string for the other scopes.
+func scopeFamily(scope string) string {
+	for _, suffix := range []string{".create", ".delete", ".read", ".update"} {
+		if strings.HasSuffix(scope, suffix) {
+			return strings.TrimSuffix(scope, suffix)
+		}
+	}
+
+	return ""
+}
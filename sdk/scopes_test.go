@@ -0,0 +1,78 @@
+package sendgrid_test
+
+import (
+	"sort"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestNormalizeScopes(t *testing.T) {
+	tests := map[string]struct {
+		scopes   []string
+		declared []string
+		expected []string
+	}{
+		"declared parent returned with its children": {
+			scopes: []string{
+				"mail.send", "templates", "templates.create", "templates.delete", "templates.read", "templates.update",
+			},
+			declared: []string{"mail.send", "templates"},
+			expected: []string{"mail.send", "templates"},
+		},
+		"declared parent returned as its children": {
+			scopes: []string{
+				"templates.create", "templates.delete", "templates.read", "templates.update",
+			},
+			declared: []string{"templates"},
+			expected: []string{"templates"},
+		},
+		"declared parent partially granted": {
+			scopes:   []string{"templates.read"},
+			declared: []string{"templates"},
+			expected: []string{"templates.read"},
+		},
+		"undeclared family returned as its children kept as is": {
+			scopes: []string{
+				"templates.create", "templates.delete", "templates.read", "templates.update",
+			},
+			declared: []string{"templates.read"},
+			expected: []string{"templates.create", "templates.delete", "templates.read", "templates.update"},
+		},
+		"scopes outside of a family kept as is": {
+			scopes:   []string{"mail.send", "mail.batch", "alerts"},
+			declared: []string{"mail.send", "mail.batch.read"},
+			expected: []string{"alerts", "mail.batch", "mail.send"},
+		},
+	}
+
+	for name, test := range tests {
+		normalized := sendgrid.NormalizeScopes(test.scopes, test.declared)
+		sort.Strings(normalized)
+
+		if strings.Join(normalized, ",") != strings.Join(test.expected, ",") {
+			t.Errorf("%s: expected %v, got %v", name, test.expected, normalized)
+		}
+	}
+}
+
+func TestScopeGranted(t *testing.T) {
+	for scope, granted := range map[string]bool{
+		"mail.send":        true,
+		"templates.read":   false,
+		"api_keys":         true,
+		"api_keys.create":  true,
+		"alerts.read":      false,
+		"mail.batch":       false,
+		"mail.batch.read":  true,
+		"mail.batch.write": false,
+	} {
+		if sendgrid.ScopeGranted([]string{
+			"mail.send", "templates", "api_keys.create", "api_keys.delete", "api_keys.read", "api_keys.update",
+			"mail.batch.read",
+		}, scope) != granted {
+			t.Errorf("%s: expected granted %t", scope, granted)
+		}
+	}
+}
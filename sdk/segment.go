@@ -0,0 +1,277 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
+)
+
+// Segment is a segment of marketing contacts, the contacts matching its SGQL query,
+// e.g. email LIKE '%@example.org', among all the contacts or the ones of its parent list.
+type Segment struct {
+	ID            string `json:"id,omitempty"`
+	Name          string `json:"name,omitempty"`
+	QueryDSL      string `json:"query_dsl,omitempty"`
+	ParentListID  string `json:"parent_list_id,omitempty"`
+	ContactsCount int    `json:"contacts_count,omitempty"`
+}
+
+// segmentQueryKeywords are the words of the SGQL queries which aren't field references.
+func segmentQueryKeywords() []string {
+	return []string{
+		"AND", "OR", "NOT", "LIKE", "IN", "IS", "NULL", "TRUE", "FALSE", "BETWEEN", "ESCAPE",
+		"INTERVAL", "MINUTE", "HOUR", "DAY", "WEEK", "MONTH", "YEAR",
+	}
+}
+
+func isSegmentQueryKeyword(word string) bool {
+	for _, keyword := range segmentQueryKeywords() {
+		if strings.EqualFold(word, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isSegmentQueryIdentifier(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
+
+// segmentQueryField is a field referenced by a query, at its position, counted in characters from 1.
+type segmentQueryField struct {
+	name     string
+	position int
+}
+
+// scanSegmentQuery checks that the strings and the parentheses of the query are closed,
+// and returns the fields it references: the words which aren't keywords, strings, numbers nor functions.
+func scanSegmentQuery(query string) ([]segmentQueryField, error) {
+	runes := []rune(query)
+
+	var (
+		opened []int
+		fields []segmentQueryField
+	)
+
+	for i := 0; i < len(runes); i++ {
+		switch r := runes[i]; {
+		case r == '\'' || r == '"':
+			start := i
+
+			// a quote doubled to escape it reads as two strings, which is the same.
+			i++
+			for i < len(runes) && runes[i] != r {
+				i++
+			}
+
+			if i == len(runes) {
+				return nil, invalidSegmentQuery("unterminated string", start)
+			}
+		case r == '(':
+			opened = append(opened, i)
+		case r == ')':
+			if len(opened) == 0 {
+				return nil, invalidSegmentQuery("unbalanced ')'", i)
+			}
+
+			opened = opened[:len(opened)-1]
+		case unicode.IsDigit(r):
+			for i+1 < len(runes) && (isSegmentQueryIdentifier(runes[i+1]) || runes[i+1] == '.') {
+				i++
+			}
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+
+			for i+1 < len(runes) && isSegmentQueryIdentifier(runes[i+1]) {
+				i++
+			}
+
+			word := string(runes[start : i+1])
+
+			// the functions, e.g. CONTAINS(list_ids, 'id'), are followed by their arguments.
+			next := i + 1
+			for next < len(runes) && unicode.IsSpace(runes[next]) {
+				next++
+			}
+
+			if !isSegmentQueryKeyword(word) && (next == len(runes) || runes[next] != '(') {
+				fields = append(fields, segmentQueryField{name: word, position: start + 1})
+			}
+		}
+	}
+
+	if len(opened) > 0 {
+		return nil, invalidSegmentQuery("unclosed '('", opened[len(opened)-1])
+	}
+
+	return fields, nil
+}
+
+func invalidSegmentQuery(reason string, index int) error {
+	return fmt.Errorf("%w: %s at character %d", ErrInvalidSegmentQuery, reason, index+1)
+}
+
+// ValidateSegmentQuery checks that the strings and the parentheses of a SGQL query are closed,
+// the errors point at the character of the problem.
+func ValidateSegmentQuery(query string) error {
+	_, err := scanSegmentQuery(query)
+
+	return err
+}
+
+// ValidateSegmentQueryFields checks that the fields a SGQL query references are defined,
+// either reserved or custom fields, the errors point at the character of the first unknown field.
+func ValidateSegmentQueryFields(query string, definitions []FieldDefinition) error {
+	fields, err := scanSegmentQuery(query)
+	if err != nil {
+		return err
+	}
+
+	for _, field := range fields {
+		known := false
+
+		for _, definition := range definitions {
+			if strings.EqualFold(definition.Name, field.name) {
+				known = true
+
+				break
+			}
+		}
+
+		if !known {
+			return fmt.Errorf("%w: unknown field %q at character %d", ErrInvalidSegmentQuery, field.name, field.position)
+		}
+	}
+
+	return nil
+}
+
+func parseSegment(respBody string) (*Segment, RequestError) {
+	var body Segment
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing segment: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSegment creates a segment and returns it.
+func (c *Client) CreateSegment(segment Segment) (*Segment, RequestError) {
+	if segment.Name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSegmentNameRequired}
+	}
+
+	if segment.QueryDSL == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSegmentQueryRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/marketing/segments", Segment{
+		Name:         segment.Name,
+		QueryDSL:     segment.QueryDSL,
+		ParentListID: segment.ParentListID,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating segment: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSegment, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSegment(respBody)
+}
+
+// ReadSegment retrieves a segment and returns it.
+func (c *Client) ReadSegment(id string) (*Segment, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSegmentIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/segments/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading segment: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSegment, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSegment(respBody)
+}
+
+// UpdateSegment renames a segment and changes its query, and returns it. Its parent list can't be changed.
+func (c *Client) UpdateSegment(id string, segment Segment) (*Segment, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSegmentIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/marketing/segments/"+id, Segment{
+		Name:     segment.Name,
+		QueryDSL: segment.QueryDSL,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating segment: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSegment, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSegment(respBody)
+}
+
+// DeleteSegment deletes a segment, its contacts are kept.
+func (c *Client) DeleteSegment(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSegmentIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/marketing/segments/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting segment: %w", err),
+		}
+	}
+
+	if statusCode == http.StatusNotFound { // ignore not found
+		return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSegment, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
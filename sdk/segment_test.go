@@ -0,0 +1,115 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestValidateSegmentQuery(t *testing.T) {
+	tests := map[string]string{
+		"email LIKE '%@example.org'":                                "",
+		"(email LIKE 'a(%') AND CONTAINS(list_ids, 'id')":           "",
+		"first_name = 'O''Brien'":                                   "",
+		"(email LIKE '%@example.org'":                               "unclosed '(' at character 1",
+		"email LIKE '%@example.org')":                               "unbalanced ')' at character 27",
+		"email LIKE '%@example.org":                                 "unterminated string at character 12",
+		"email = 'a' AND ((first_name = 'b') OR last_name = 'c'":    "unclosed '(' at character 17",
+		"created_at > 2021-01-01 AND CONTAINS (list_ids, 'id', 42)": "",
+	}
+
+	for query, expected := range tests {
+		err := sendgrid.ValidateSegmentQuery(query)
+
+		switch {
+		case expected == "" && err != nil:
+			t.Errorf("expected %q to be valid, got: %s", query, err)
+		case expected != "" && (!errors.Is(err, sendgrid.ErrInvalidSegmentQuery) || !strings.Contains(err.Error(), expected)):
+			t.Errorf("expected %q to fail with %q, got: %v", query, expected, err)
+		}
+	}
+}
+
+func TestValidateSegmentQueryFields(t *testing.T) {
+	definitions := []sendgrid.FieldDefinition{{Name: "email"}, {Name: "list_ids"}, {Name: "age"}}
+
+	if err := sendgrid.ValidateSegmentQueryFields(
+		"EMAIL like '%@example.org' and age > 18 and not contains(list_ids, 'id') and age is not null", definitions,
+	); err != nil {
+		t.Errorf("expected the fields to be known, got: %s", err)
+	}
+
+	err := sendgrid.ValidateSegmentQueryFields("email LIKE '%@example.org' AND agee > 18", definitions)
+	if !errors.Is(err, sendgrid.ErrInvalidSegmentQuery) ||
+		!strings.Contains(err.Error(), `unknown field "agee" at character 32`) {
+		t.Errorf("expected the unknown field to be reported, got: %v", err)
+	}
+}
+
+func TestCountContacts(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "POST" || r.URL.Path != "/marketing/contacts/search" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if string(body) != `{"query":"email LIKE '%@example.org'"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		fmt.Fprint(w, `{"result": [{"id": "1", "email": "first@example.org"}], "contact_count": 42}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	count, requestErr := c.CountContacts("email LIKE '%@example.org'")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if count != 42 {
+		t.Fatalf("expected 42 contacts, got %d", count)
+	}
+}
+
+func TestCreateSegment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "POST" || r.URL.Path != "/marketing/segments" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if string(body) != `{"name":"segment","query_dsl":"email LIKE '%@example.org'","parent_list_id":"list"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"id": "id", "name": "segment", "query_dsl": "email LIKE '%@example.org'",
+			"parent_list_id": "list", "contacts_count": 0}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	segment, requestErr := c.CreateSegment(sendgrid.Segment{
+		Name:         "segment",
+		QueryDSL:     "email LIKE '%@example.org'",
+		ParentListID: "list",
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if segment.ID != "id" || segment.ParentListID != "list" {
+		t.Fatalf("unexpected segment: %+v", segment)
+	}
+}
@@ -0,0 +1,155 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SenderIdentityAddress is an email address and the name displayed with it.
+type SenderIdentityAddress struct {
+	Email string `json:"email"`
+	Name  string `json:"name,omitempty"`
+}
+
+// SenderIdentityVerification is the verification status of a sender identity.
+type SenderIdentityVerification struct {
+	Status bool   `json:"status"`
+	Reason string `json:"reason,omitempty"`
+}
+
+// SenderIdentity is a legacy marketing sender identity, used by the single sends.
+type SenderIdentity struct {
+	ID       int64                       `json:"id,omitempty"`
+	Nickname string                      `json:"nickname"`
+	From     SenderIdentityAddress       `json:"from"`
+	ReplyTo  *SenderIdentityAddress      `json:"reply_to,omitempty"`
+	Address  string                      `json:"address"`
+	Address2 string                      `json:"address_2,omitempty"`
+	City     string                      `json:"city"`
+	State    string                      `json:"state,omitempty"`
+	Zip      string                      `json:"zip,omitempty"`
+	Country  string                      `json:"country"`
+	Verified *SenderIdentityVerification `json:"verified,omitempty"`
+	Locked   bool                        `json:"locked,omitempty"`
+}
+
+func parseSenderIdentity(respBody string) (*SenderIdentity, RequestError) {
+	var body SenderIdentity
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing sender identity: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSenderIdentity creates a sender identity and returns it.
+func (c *Client) CreateSenderIdentity(sender SenderIdentity) (*SenderIdentity, RequestError) {
+	if sender.From.Email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSenderIdentityFromEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/senders", sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating sender identity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedCreatingSenderIdentity, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSenderIdentity(respBody)
+}
+
+// ReadSenderIdentity retrieves a sender identity and returns it.
+func (c *Client) ReadSenderIdentity(id string) (*SenderIdentity, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSenderIdentityIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/senders/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading sender identity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedReadingSenderIdentity, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSenderIdentity(respBody)
+}
+
+// UpdateSenderIdentity edits a sender identity and returns it.
+func (c *Client) UpdateSenderIdentity(id string, sender SenderIdentity) (*SenderIdentity, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSenderIdentityIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/senders/"+id, sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating sender identity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedUpdatingSenderIdentity, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSenderIdentity(respBody)
+}
+
+// DeleteSenderIdentity deletes a sender identity.
+func (c *Client) DeleteSenderIdentity(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSenderIdentityIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/senders/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting sender identity: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDeletingSenderIdentity, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
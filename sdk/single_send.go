@@ -0,0 +1,223 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// SingleSendStatusDraft is the status of a single send which isn't scheduled.
+	SingleSendStatusDraft = "draft"
+
+	// SingleSendStatusScheduled is the status of a single send waiting for its send date.
+	SingleSendStatusScheduled = "scheduled"
+
+	// SingleSendStatusTriggered is the status of a single send which was sent.
+	SingleSendStatusTriggered = "triggered"
+)
+
+// SingleSendTo is the recipients of a single send.
+type SingleSendTo struct {
+	ListIDs    []string `json:"list_ids,omitempty"`
+	SegmentIDs []string `json:"segment_ids,omitempty"`
+	All        bool     `json:"all,omitempty"`
+}
+
+// SingleSendEmailConfig is the content of the email of a single send.
+type SingleSendEmailConfig struct {
+	Subject              string `json:"subject,omitempty"`
+	HTMLContent          string `json:"html_content,omitempty"`
+	PlainContent         string `json:"plain_content,omitempty"`
+	GeneratePlainContent bool   `json:"generate_plain_content"`
+	Editor               string `json:"editor,omitempty"`
+	SuppressionGroupID   int    `json:"suppression_group_id,omitempty"`
+	CustomUnsubscribeURL string `json:"custom_unsubscribe_url,omitempty"`
+	SenderID             int    `json:"sender_id,omitempty"`
+	IPPool               string `json:"ip_pool,omitempty"`
+}
+
+// SingleSend is a Sendgrid marketing campaign sent once.
+type SingleSend struct {
+	ID          string                 `json:"id,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Status      string                 `json:"status,omitempty"`
+	Categories  []string               `json:"categories,omitempty"`
+	SendAt      string                 `json:"send_at,omitempty"`
+	SendTo      *SingleSendTo          `json:"send_to,omitempty"`
+	EmailConfig *SingleSendEmailConfig `json:"email_config,omitempty"`
+	CreatedAt   string                 `json:"created_at,omitempty"`
+	UpdatedAt   string                 `json:"updated_at,omitempty"`
+}
+
+type singleSendSchedule struct {
+	SendAt string `json:"send_at,omitempty"`
+	Status string `json:"status,omitempty"`
+}
+
+func parseSingleSend(respBody string) (*SingleSend, RequestError) {
+	var body SingleSend
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing single send: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSingleSend creates a draft single send and returns it.
+func (c *Client) CreateSingleSend(s SingleSend) (*SingleSend, RequestError) {
+	if s.Name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/marketing/singlesends", s)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSingleSend(respBody)
+}
+
+// ReadSingleSend retrieves a single send and returns it.
+func (c *Client) ReadSingleSend(id string) (*SingleSend, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/marketing/singlesends/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSingleSend(respBody)
+}
+
+// UpdateSingleSend edits a single send and returns it.
+func (c *Client) UpdateSingleSend(s SingleSend) (*SingleSend, RequestError) {
+	if s.ID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendIDRequired}
+	}
+
+	id := s.ID
+	s.ID = ""
+
+	respBody, statusCode, err := c.Post("PATCH", "/marketing/singlesends/"+id, s)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSingleSend(respBody)
+}
+
+// DeleteSingleSend deletes a single send.
+func (c *Client) DeleteSingleSend(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/marketing/singlesends/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ScheduleSingleSend schedules a single send at the given date (RFC3339), or "now".
+func (c *Client) ScheduleSingleSend(id, sendAt string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PUT", "/marketing/singlesends/"+id+"/schedule", singleSendSchedule{
+		SendAt: sendAt,
+	})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed scheduling single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedSchedulingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UnscheduleSingleSend cancels the schedule of a single send, which becomes a draft again.
+func (c *Client) UnscheduleSingleSend(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSingleSendIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/marketing/singlesends/"+id+"/schedule")
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed unscheduling single send: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUnschedulingSingleSend, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
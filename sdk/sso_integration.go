@@ -0,0 +1,310 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SSOIntegration is the integration of an identity provider, e.g. Okta, for the single sign-on of the teammates.
+// It's only complete, and the teammates can only sign in, once a certificate of the identity provider is added.
+type SSOIntegration struct {
+	ID                   string `json:"id,omitempty"`
+	Name                 string `json:"name"`
+	Enabled              bool   `json:"enabled"`
+	SigninURL            string `json:"signin_url"`
+	SignoutURL           string `json:"signout_url"`
+	EntityID             string `json:"entity_id"`
+	CompletedIntegration bool   `json:"completed_integration,omitempty"`
+	SingleSignonURL      string `json:"single_signon_url,omitempty"`
+	AudienceURL          string `json:"audience_url,omitempty"`
+	LastUpdated          int64  `json:"last_updated,omitempty"`
+}
+
+// SSOCertificate is a certificate of an identity provider, signing the SAML responses of an SSO integration.
+type SSOCertificate struct {
+	ID                int64  `json:"id,omitempty"`
+	PublicCertificate string `json:"public_certificate"`
+	Enabled           bool   `json:"enabled"`
+	IntegrationID     string `json:"integration_id"`
+	NotBefore         int64  `json:"not_before,omitempty"`
+	NotAfter          int64  `json:"not_after,omitempty"`
+}
+
+// ssoCertificateResponse is a certificate as returned by Sendgrid, which misspells the ID of its integration.
+type ssoCertificateResponse struct {
+	SSOCertificate
+	IntergrationID string `json:"intergration_id,omitempty"`
+}
+
+func parseSSOIntegration(respBody string) (*SSOIntegration, RequestError) {
+	var body SSOIntegration
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing SSO integration: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func parseSSOCertificate(respBody string) (*SSOCertificate, RequestError) {
+	var body ssoCertificateResponse
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing SSO certificate: %w", err),
+		}
+	}
+
+	if body.IntegrationID == "" {
+		body.IntegrationID = body.IntergrationID
+	}
+
+	return &body.SSOCertificate, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSSOIntegration creates an SSO integration and returns it, incomplete until a certificate is added.
+func (c *Client) CreateSSOIntegration(integration SSOIntegration) (*SSOIntegration, RequestError) {
+	if integration.Name == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOIntegrationNameRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/sso/integrations", SSOIntegration{
+		Name:       integration.Name,
+		Enabled:    integration.Enabled,
+		SigninURL:  integration.SigninURL,
+		SignoutURL: integration.SignoutURL,
+		EntityID:   integration.EntityID,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating SSO integration: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOIntegration, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOIntegration(respBody)
+}
+
+// ReadSSOIntegration retrieves an SSO integration, with whether it's complete, and returns it.
+func (c *Client) ReadSSOIntegration(id string) (*SSOIntegration, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOIntegrationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/sso/integrations/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading SSO integration: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSSOIntegration, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOIntegration(respBody)
+}
+
+// UpdateSSOIntegration updates an SSO integration and returns it.
+func (c *Client) UpdateSSOIntegration(id string, integration SSOIntegration) (*SSOIntegration, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOIntegrationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/sso/integrations/"+id, SSOIntegration{
+		Name:       integration.Name,
+		Enabled:    integration.Enabled,
+		SigninURL:  integration.SigninURL,
+		SignoutURL: integration.SignoutURL,
+		EntityID:   integration.EntityID,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating SSO integration: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOIntegration, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOIntegration(respBody)
+}
+
+// DeleteSSOIntegration deletes an SSO integration, its teammates can't sign in anymore.
+func (c *Client) DeleteSSOIntegration(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOIntegrationIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/sso/integrations/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting SSO integration: %w", err),
+		}
+	}
+
+	if statusCode == http.StatusNotFound { // ignore not found
+		return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSSOIntegration, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func validateSSOCertificate(certificate SSOCertificate) RequestError {
+	if certificate.PublicCertificate == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOCertificateRequired}
+	}
+
+	if certificate.IntegrationID == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOIntegrationIDRequired}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSSOCertificate adds a certificate to an SSO integration, which completes it, and returns the certificate.
+func (c *Client) CreateSSOCertificate(certificate SSOCertificate) (*SSOCertificate, RequestError) {
+	if requestErr := validateSSOCertificate(certificate); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/sso/certificates", SSOCertificate{
+		PublicCertificate: certificate.PublicCertificate,
+		Enabled:           certificate.Enabled,
+		IntegrationID:     certificate.IntegrationID,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating SSO certificate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOCertificate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOCertificate(respBody)
+}
+
+// ReadSSOCertificate retrieves a certificate of an SSO integration and returns it.
+func (c *Client) ReadSSOCertificate(id string) (*SSOCertificate, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOCertificateIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/sso/certificates/"+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading SSO certificate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSSOCertificate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOCertificate(respBody)
+}
+
+// UpdateSSOCertificate replaces a certificate of an SSO integration, e.g. when it expires, and returns it.
+func (c *Client) UpdateSSOCertificate(id string, certificate SSOCertificate) (*SSOCertificate, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOCertificateIDRequired}
+	}
+
+	if requestErr := validateSSOCertificate(certificate); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/sso/certificates/"+id, SSOCertificate{
+		PublicCertificate: certificate.PublicCertificate,
+		Enabled:           certificate.Enabled,
+		IntegrationID:     certificate.IntegrationID,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating SSO certificate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOCertificate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOCertificate(respBody)
+}
+
+// DeleteSSOCertificate removes a certificate from an SSO integration.
+func (c *Client) DeleteSSOCertificate(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSSOCertificateIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/sso/certificates/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting SSO certificate: %w", err),
+		}
+	}
+
+	if statusCode == http.StatusNotFound { // ignore not found
+		return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSSOCertificate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
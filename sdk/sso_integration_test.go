@@ -0,0 +1,72 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestCreateSSOIntegration(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "POST" || r.URL.Path != "/sso/integrations" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// the computed fields aren't sent, enabled is sent even if false.
+		if string(body) != `{"name":"Okta","enabled":false,"signin_url":"https://idp/sso",`+
+			`"signout_url":"https://idp/signout","entity_id":"idp"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		fmt.Fprint(w, `{"id": "abc", "name": "Okta", "completed_integration": false,
+			"audience_url": "https://sendgrid/audience"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	integration, requestErr := c.CreateSSOIntegration(sendgrid.SSOIntegration{
+		Name:                 "Okta",
+		SigninURL:            "https://idp/sso",
+		SignoutURL:           "https://idp/signout",
+		EntityID:             "idp",
+		CompletedIntegration: true,
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if integration.ID != "abc" || integration.CompletedIntegration || integration.AudienceURL == "" {
+		t.Fatalf("unexpected integration: %+v", integration)
+	}
+}
+
+func TestReadSSOCertificate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/sso/certificates/42" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// Sendgrid misspells the ID of the integration.
+		fmt.Fprint(w, `{"id": 42, "public_certificate": "PEM", "not_before": 1, "not_after": 2,
+			"intergration_id": "abc"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	certificate, requestErr := c.ReadSSOCertificate("42")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if certificate.ID != 42 || certificate.IntegrationID != "abc" || certificate.NotAfter != 2 {
+		t.Fatalf("unexpected certificate: %+v", certificate)
+	}
+}
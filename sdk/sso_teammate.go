@@ -0,0 +1,99 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// SSOTeammate is a teammate signing in with the single sign-on of the account, so it has no password.
+// Its scopes are either set, or derived by Sendgrid from its persona, e.g. developer.
+type SSOTeammate struct {
+	Email     string   `json:"email,omitempty"`
+	FirstName string   `json:"first_name"`
+	LastName  string   `json:"last_name"`
+	IsAdmin   bool     `json:"is_admin"`
+	Scopes    []string `json:"scopes,omitempty"`
+	Persona   string   `json:"persona,omitempty"`
+}
+
+func parseSSOTeammate(respBody string) (*SSOTeammate, RequestError) {
+	var body SSOTeammate
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing SSO teammate: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func validateSSOTeammate(teammate SSOTeammate) RequestError {
+	if teammate.FirstName == "" || teammate.LastName == "" {
+		return RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateNameRequired}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateSSOTeammate creates a teammate signing in with the single sign-on of the account, no invite is sent.
+// Once created, it's read, updated and deleted like the other teammates, by its username.
+func (c *Client) CreateSSOTeammate(teammate SSOTeammate) (*SSOTeammate, RequestError) {
+	if teammate.Email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateEmailRequired}
+	}
+
+	if requestErr := validateSSOTeammate(teammate); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/sso/teammates", teammate)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating SSO teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSSOTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOTeammate(respBody)
+}
+
+// UpdateSSOTeammate edits the name and the permissions of an SSO teammate, its email can't be changed.
+func (c *Client) UpdateSSOTeammate(username string, teammate SSOTeammate) (*SSOTeammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if requestErr := validateSSOTeammate(teammate); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	teammate.Email = ""
+
+	respBody, statusCode, err := c.Post("PATCH", "/sso/teammates/"+username, teammate)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating SSO teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSSOTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSSOTeammate(respBody)
+}
@@ -0,0 +1,188 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// statsPageSize is the number of dates retrieved per call when listing stats.
+const statsPageSize = 500
+
+// StatsMetrics is the email activity of a period.
+type StatsMetrics struct {
+	Requests         int `json:"requests"`
+	Processed        int `json:"processed"`
+	Delivered        int `json:"delivered"`
+	Deferred         int `json:"deferred"`
+	Bounces          int `json:"bounces"`
+	BounceDrops      int `json:"bounce_drops"`
+	Blocks           int `json:"blocks"`
+	InvalidEmails    int `json:"invalid_emails"`
+	Opens            int `json:"opens"`
+	UniqueOpens      int `json:"unique_opens"`
+	Clicks           int `json:"clicks"`
+	UniqueClicks     int `json:"unique_clicks"`
+	SpamReports      int `json:"spam_reports"`
+	SpamReportDrops  int `json:"spam_report_drops"`
+	Unsubscribes     int `json:"unsubscribes"`
+	UnsubscribeDrops int `json:"unsubscribe_drops"`
+}
+
+// The dimensions the email activity can be broken down by.
+const (
+	StatsByCategory        = "category"
+	StatsByMailboxProvider = "mailbox_provider"
+	StatsByBrowser         = "browser"
+)
+
+// statsBreakdown is the endpoint of the stats broken down by a dimension, and the parameter filtering them.
+type statsBreakdown struct {
+	endpoint string
+	param    string
+}
+
+// statsBreakdowns are the endpoints of the stats broken down, by dimension.
+var statsBreakdowns = map[string]statsBreakdown{
+	StatsByCategory:        {endpoint: "/categories/stats", param: "categories"},
+	StatsByMailboxProvider: {endpoint: "/mailbox_providers/stats", param: "mailbox_providers"},
+	StatsByBrowser:         {endpoint: "/browsers/stats", param: "browsers"},
+}
+
+// Stats is the email activity of the account for a date, or of a category, mailbox provider or browser
+// for a date when the stats are broken down.
+type Stats struct {
+	Date    string       `json:"date"`
+	Type    string       `json:"type,omitempty"`
+	Name    string       `json:"name,omitempty"`
+	Metrics StatsMetrics `json:"-"`
+}
+
+type statsBody struct {
+	Date  string `json:"date"`
+	Stats []struct {
+		Type    string       `json:"type"`
+		Name    string       `json:"name"`
+		Metrics StatsMetrics `json:"metrics"`
+	} `json:"stats"`
+}
+
+// parseStats returns the stats of a page and its number of dates. The dates without activity are kept
+// unless the stats are broken down, there's nothing to break down then.
+func parseStats(respBody string, brokenDown bool) ([]Stats, int, RequestError) {
+	var body []statsBody
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, 0, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing stats: %w", err),
+		}
+	}
+
+	stats := make([]Stats, 0, len(body))
+
+	for _, date := range body {
+		if len(date.Stats) == 0 && !brokenDown {
+			stats = append(stats, Stats{Date: date.Date})
+		}
+
+		for _, s := range date.Stats {
+			stats = append(stats, Stats{Date: date.Date, Type: s.Type, Name: s.Name, Metrics: s.Metrics})
+		}
+	}
+
+	return stats, len(body), RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func statsQuery(startDate, endDate, aggregatedBy string) url.Values {
+	query := url.Values{}
+	query.Set("start_date", startDate)
+
+	if endDate != "" {
+		query.Set("end_date", endDate)
+	}
+
+	if aggregatedBy != "" {
+		query.Set("aggregated_by", aggregatedBy)
+	}
+
+	query.Set("limit", strconv.Itoa(statsPageSize))
+
+	return query
+}
+
+// listStats retrieves the stats of the endpoint page by page.
+func (c *Client) listStats(endpoint string, query url.Values, brokenDown bool) ([]Stats, RequestError) {
+	var stats []Stats
+
+	for offset := 0; ; offset += statsPageSize {
+		query.Set("offset", strconv.Itoa(offset))
+
+		respBody, statusCode, err := c.Get("GET", endpoint+"?"+query.Encode())
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing stats: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingStats, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		page, dates, requestErr := parseStats(respBody, brokenDown)
+		if requestErr.Err != nil {
+			return nil, requestErr
+		}
+
+		stats = append(stats, page...)
+
+		if dates < statsPageSize {
+			return stats, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ListStats retrieves the email activity between two dates (YYYY-MM-DD), aggregated by
+// day, week or month, page by page, and returns it. An empty end date means today.
+func (c *Client) ListStats(startDate, endDate, aggregatedBy string) ([]Stats, RequestError) {
+	if startDate == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrStatsStartDateRequired}
+	}
+
+	return c.listStats("/stats", statsQuery(startDate, endDate, aggregatedBy), false)
+}
+
+// ListStatsBreakdown retrieves the email activity between two dates (YYYY-MM-DD) broken down by category,
+// mailbox provider or browser, aggregated by day, week or month, and returns it per date then name.
+// The names filter the stats, they're required for the categories, otherwise every name is returned.
+func (c *Client) ListStatsBreakdown(
+	breakdown string,
+	names []string,
+	startDate, endDate, aggregatedBy string,
+) ([]Stats, RequestError) {
+	if startDate == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrStatsStartDateRequired}
+	}
+
+	b, ok := statsBreakdowns[breakdown]
+	if !ok {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrStatsBreakdownInvalid}
+	}
+
+	if breakdown == StatsByCategory && len(names) == 0 {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrStatsCategoriesRequired}
+	}
+
+	query := statsQuery(startDate, endDate, aggregatedBy)
+	for _, name := range names {
+		query.Add(b.param, name)
+	}
+
+	return c.listStats(b.endpoint, query, true)
+}
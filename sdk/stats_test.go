@@ -0,0 +1,101 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestListStatsPaginates(t *testing.T) {
+	const pageSize, total = 500, 503
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var offset int
+		if _, err := fmt.Sscan(r.URL.Query().Get("offset"), &offset); err != nil {
+			t.Errorf("invalid offset: %s", err)
+		}
+
+		dates := make([]string, 0, pageSize)
+		for i := offset; i < total && i < offset+pageSize; i++ {
+			dates = append(dates, fmt.Sprintf(`{"date":"day-%d","stats":[{"metrics":{"delivered":%d}}]}`, i, i))
+		}
+
+		fmt.Fprintf(w, "[%s]", strings.Join(dates, ","))
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	stats, requestErr := c.ListStats("2021-01-01", "", "day")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(stats) != total {
+		t.Fatalf("expected %d dates, got %d", total, len(stats))
+	}
+
+	if last := stats[total-1]; last.Date != "day-502" || last.Metrics.Delivered != 502 {
+		t.Fatalf("unexpected last date: %+v", last)
+	}
+}
+
+func TestListStatsBreakdownByCategory(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/categories/stats" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		if categories := r.URL.Query()["categories"]; len(categories) != 2 || categories[1] != "beta" {
+			t.Errorf("unexpected categories: %v", categories)
+		}
+
+		fmt.Fprint(w, `[
+			{"date": "2021-06-01", "stats": [
+				{"type": "category", "name": "newsletter", "metrics": {"delivered": 10}},
+				{"type": "category", "name": "beta", "metrics": {"delivered": 2}}
+			]},
+			{"date": "2021-06-02", "stats": []}
+		]`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	stats, requestErr := c.ListStatsBreakdown(
+		sendgrid.StatsByCategory, []string{"newsletter", "beta"}, "2021-06-01", "2021-06-02", "day",
+	)
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	// the dates without activity have nothing to break down.
+	if len(stats) != 2 {
+		t.Fatalf("expected the stats of 2 categories, got: %+v", stats)
+	}
+
+	if beta := stats[1]; beta.Name != "beta" || beta.Type != "category" || beta.Metrics.Delivered != 2 {
+		t.Errorf("unexpected stats: %+v", beta)
+	}
+}
+
+func TestListStatsBreakdownValidation(t *testing.T) {
+	c := sendgrid.NewClient("key", "http://localhost", "")
+
+	if _, requestErr := c.ListStatsBreakdown(sendgrid.StatsByCategory, nil, "2021-06-01", "", ""); !errors.Is(
+		requestErr.Err, sendgrid.ErrStatsCategoriesRequired,
+	) {
+		t.Errorf("expected the categories to be required, got: %v", requestErr.Err)
+	}
+
+	if _, requestErr := c.ListStatsBreakdown("device", nil, "2021-06-01", "", ""); !errors.Is(
+		requestErr.Err, sendgrid.ErrStatsBreakdownInvalid,
+	) {
+		t.Errorf("expected an invalid breakdown, got: %v", requestErr.Err)
+	}
+}
@@ -6,8 +6,13 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
 )
 
+// subUsersPageSize is the number of subusers retrieved per call when listing the subusers.
+const subUsersPageSize = 100
+
 type creditAllocation struct {
 	Type string `json:"credit_allocation,omitempty"`
 }
@@ -20,12 +25,18 @@ type SubUser struct {
 	Password           string           `json:"password,omitempty"`
 	Email              string           `json:"email,omitempty"`
 	IPs                []string         `json:"ips,omitempty"`
+	Region             string           `json:"region,omitempty"`
 	Disabled           bool             `json:"disabled,omitempty"`
 	SignupSessionToken string           `json:"signup_session_token,omitempty"`
 	AuthorizationToken string           `json:"authorization_token,omitempty"`
 	CreditAllocation   creditAllocation `json:"credit_allocation,omitempty"`
 }
 
+// subUserStatus enables or disables a subuser, disabled is always sent to be able to enable it.
+type subUserStatus struct {
+	Disabled bool `json:"disabled"`
+}
+
 func parseSubUser(respBody string) (*SubUser, RequestError) {
 	var body SubUser
 	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
@@ -54,8 +65,26 @@ func parseSubUsers(respBody string) ([]SubUser, RequestError) {
 	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
-// CreateSubuser creates a subuser and returns it.
-func (c *Client) CreateSubuser(username, email, password string, ips []string) (*SubUser, RequestError) {
+// subUserAlreadyExists tells if the errors returned by Sendgrid report the username as already taken.
+func subUserAlreadyExists(respBody string) bool {
+	var body apiErrors
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return false
+	}
+
+	for _, e := range body.Errors {
+		if e.Field == "username" && strings.Contains(e.Message, "exists") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// CreateSubuser creates a subuser and returns it, its data is kept in the region if set, e.g. eu.
+// Without IPs, the subuser sends from the shared IPs of Sendgrid, on the plans without dedicated IPs.
+// If the username is already taken, the returned error wraps ErrSubUserAlreadyExists.
+func (c *Client) CreateSubuser(username, email, password string, ips []string, region string) (*SubUser, RequestError) {
 	if username == "" {
 		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
@@ -68,15 +97,12 @@ func (c *Client) CreateSubuser(username, email, password string, ips []string) (
 		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrPasswordRequired}
 	}
 
-	if len(ips) < 1 {
-		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrIPRequired}
-	}
-
 	respBody, statusCode, err := c.Post("POST", "/subusers", SubUser{
 		UserName: username,
 		Email:    email,
 		Password: password,
 		IPs:      ips,
+		Region:   region,
 	})
 	if err != nil {
 		return nil, RequestError{
@@ -85,10 +111,19 @@ func (c *Client) CreateSubuser(username, email, password string, ips []string) (
 		}
 	}
 
+	if statusCode == http.StatusBadRequest && subUserAlreadyExists(respBody) {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrSubUserAlreadyExists, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	if statusCode >= http.StatusMultipleChoices {
 		return nil, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSubUser, statusCode, respBody),
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSubUser, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
 		}
 	}
 
@@ -111,16 +146,60 @@ func (c *Client) ReadSubUser(username string) ([]SubUser, RequestError) {
 		}
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSubUser, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	return parseSubUsers(respBody)
 }
 
+// ListSubusers retrieves all the subusers of the account, page by page.
+func (c *Client) ListSubusers() ([]SubUser, RequestError) {
+	var all []SubUser
+
+	for offset := 0; ; offset += subUsersPageSize {
+		endpoint := "/subusers?limit=" + strconv.Itoa(subUsersPageSize) + "&offset=" + strconv.Itoa(offset)
+
+		respBody, statusCode, err := c.Get("GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing subUsers: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingSubUsers, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		page, requestErr := parseSubUsers(respBody)
+		if requestErr.Err != nil {
+			return nil, requestErr
+		}
+
+		all = append(all, page...)
+
+		if len(page) < subUsersPageSize {
+			return all, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
 // UpdateSubuser enables/disables a subuser.
 func (c *Client) UpdateSubuser(username string, disabled bool) (bool, RequestError) {
 	if username == "" {
 		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
 	}
 
-	respBody, statusCode, err := c.Post("PATCH", "/subusers/"+username, SubUser{
+	respBody, statusCode, err := c.Post("PATCH", "/subusers/"+username, subUserStatus{
 		Disabled: disabled,
 	})
 	if err != nil {
@@ -130,7 +209,20 @@ func (c *Client) UpdateSubuser(username string, disabled bool) (bool, RequestErr
 		}
 	}
 
-	var body subUserErrors
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSubUser, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	// Sendgrid answers with no content when the subuser is updated.
+	if respBody == "" {
+		return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	var body apiErrors
 	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
 		return false, RequestError{
 			StatusCode: http.StatusInternalServerError,
@@ -141,6 +233,36 @@ func (c *Client) UpdateSubuser(username string, disabled bool) (bool, RequestErr
 	return len(body.Errors) == 0, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
 
+// UpdateSubuserEmail changes the email of a subuser. The subusers endpoints can't change it,
+// it's changed as the email of the user, on behalf of the subuser.
+func (c *Client) UpdateSubuserEmail(username, email string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if email == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEmailRequired}
+	}
+
+	respBody, statusCode, err := c.WithOnBehalfOf(username).Post("PUT", "/user/email", userEmail{Email: email})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating subUser email: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSubUserEmail, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
 // DeleteSubuser deletes a subuser.
 func (c *Client) DeleteSubuser(username string) (bool, RequestError) {
 	if username == "" {
@@ -157,10 +279,114 @@ func (c *Client) DeleteSubuser(username string) (bool, RequestError) {
 
 	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
 		return false, RequestError{
-			StatusCode: statusCode,
-			Err:        fmt.Errorf("%w: statusCode: %d, respBody: %s", err, statusCode, respBody),
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSubUser, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
 		}
 	}
 
 	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
 }
+
+// SubUserCredits is the credit allocation of a subuser: the number of emails it can send.
+// Type is one of unlimited, recurring (reset every ResetFrequency) or nonrecurring.
+type SubUserCredits struct {
+	Type           string `json:"type,omitempty"`
+	ResetFrequency string `json:"reset_frequency,omitempty"`
+	Total          int    `json:"total,omitempty"`
+	Remaining      int    `json:"remaining,omitempty"`
+	Used           int    `json:"used,omitempty"`
+}
+
+func parseSubUserCredits(respBody string) (*SubUserCredits, RequestError) {
+	var body SubUserCredits
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		log.Printf("[DEBUG] [parseSubUserCredits] failed parsing subUser credits, response body: %s", respBody)
+
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        err,
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func validateSubUserCredits(credits SubUserCredits) error {
+	switch credits.Type {
+	case "unlimited":
+		if credits.Total != 0 || credits.ResetFrequency != "" {
+			return ErrUnlimitedCreditsWithLimit
+		}
+	case "recurring":
+		if credits.ResetFrequency == "" {
+			return ErrCreditsResetFrequencyRequired
+		}
+	case "nonrecurring":
+		if credits.ResetFrequency != "" {
+			return ErrCreditsResetFrequencyNotAllowed
+		}
+	default:
+		return ErrInvalidCreditsType
+	}
+
+	return nil
+}
+
+// ReadSubUserCredits retrieves the credit allocation of a subuser and returns it.
+func (c *Client) ReadSubUserCredits(username string) (*SubUserCredits, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/subusers/"+username+"/credits")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading subUser credits: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSubUserCredits, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSubUserCredits(respBody)
+}
+
+// UpdateSubUserCredits edits the credit allocation of a subuser and returns it.
+func (c *Client) UpdateSubUserCredits(username string, credits SubUserCredits) (*SubUserCredits, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if err := validateSubUserCredits(credits); err != nil {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: err}
+	}
+
+	respBody, statusCode, err := c.Post("PUT", "/subusers/"+username+"/credits", SubUserCredits{
+		Type:           credits.Type,
+		ResetFrequency: credits.ResetFrequency,
+		Total:          credits.Total,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating subUser credits: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingSubUserCredits, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSubUserCredits(respBody)
+}
@@ -0,0 +1,117 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/sendgrid/rest"
+)
+
+// SubUserMonitor is the monitor of a Sendgrid subuser:
+// a sample of the emails sent by the subuser is copied to the monitor email.
+type SubUserMonitor struct {
+	Email     string `json:"email,omitempty"`
+	Frequency int    `json:"frequency,omitempty"`
+}
+
+func parseSubUserMonitor(respBody string) (*SubUserMonitor, RequestError) {
+	var body SubUserMonitor
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing subUser monitor: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func (c *Client) writeSubUserMonitor(
+	method rest.Method, username string, monitor SubUserMonitor, sentinel error) (*SubUserMonitor, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if monitor.Email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post(method, "/subusers/"+username+"/monitor", monitor)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("%w: %s", sentinel, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", sentinel, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSubUserMonitor(respBody)
+}
+
+// CreateSubUserMonitor creates the monitor of a subuser and returns it.
+func (c *Client) CreateSubUserMonitor(username string, monitor SubUserMonitor) (*SubUserMonitor, RequestError) {
+	return c.writeSubUserMonitor("POST", username, monitor, ErrFailedCreatingSubUserMonitor)
+}
+
+// ReadSubUserMonitor retrieves the monitor of a subuser and returns it.
+func (c *Client) ReadSubUserMonitor(username string) (*SubUserMonitor, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/subusers/"+username+"/monitor")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading subUser monitor: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSubUserMonitor, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSubUserMonitor(respBody)
+}
+
+// UpdateSubUserMonitor edits the monitor of a subuser and returns it.
+func (c *Client) UpdateSubUserMonitor(username string, monitor SubUserMonitor) (*SubUserMonitor, RequestError) {
+	return c.writeSubUserMonitor("PUT", username, monitor, ErrFailedUpdatingSubUserMonitor)
+}
+
+// DeleteSubUserMonitor deletes the monitor of a subuser.
+func (c *Client) DeleteSubUserMonitor(username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/subusers/"+username+"/monitor")
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting subUser monitor: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSubUserMonitor, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
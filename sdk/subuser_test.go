@@ -0,0 +1,150 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestCreateSubuserAlreadyExists(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		//nolint:errcheck
+		w.Write([]byte(`{"errors":[{"field":"username","message":"username exists"}]}`))
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	_, requestErr := c.CreateSubuser("subuser", "subuser@example.org", "Passw0rd!", []string{"127.0.0.1"}, "")
+	if !errors.Is(requestErr, sendgrid.ErrSubUserAlreadyExists) {
+		t.Fatalf("expected ErrSubUserAlreadyExists, got %v", requestErr.Err)
+	}
+}
+
+func TestCreateSubuserWithoutIPs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		// the subuser sends from the shared IPs.
+		if string(body) != `{"username":"subuser","password":"Passw0rd!","email":"subuser@example.org",`+
+			`"credit_allocation":{}}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, `{"username": "subuser", "user_id": 1, "email": "subuser@example.org"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	subUser, requestErr := c.CreateSubuser("subuser", "subuser@example.org", "Passw0rd!", nil, "")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if subUser.UserName != "subuser" {
+		t.Fatalf("unexpected subuser: %+v", subUser)
+	}
+}
+
+func TestUpdateProfileOnBehalfOfSubuser(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PATCH" || r.URL.Path != "/user/profile" || r.Header.Get("On-Behalf-Of") != "subuser" {
+			t.Errorf("unexpected request: %s %s on behalf of %q", r.Method, r.URL, r.Header.Get("On-Behalf-Of"))
+		}
+
+		// only the fields which are set are changed.
+		if string(body) != `{"company":"Example","website":"https://example.org"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		fmt.Fprint(w, `{"company": "Example", "website": "https://example.org", "city": "Paris"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	profile, requestErr := c.WithOnBehalfOf("subuser").UpdateProfile(sendgrid.Profile{
+		Company: "Example",
+		Website: "https://example.org",
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if profile.City != "Paris" {
+		t.Fatalf("unexpected profile: %+v", profile)
+	}
+}
+
+func TestUpdateSubuserEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "PUT" || r.URL.Path != "/user/email" || r.Header.Get("On-Behalf-Of") != "subuser" {
+			t.Errorf("unexpected request: %s %s on behalf of %q", r.Method, r.URL, r.Header.Get("On-Behalf-Of"))
+		}
+
+		if string(body) != `{"email":"new@example.org"}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		fmt.Fprint(w, `{"email": "new@example.org"}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, requestErr := c.UpdateSubuserEmail("subuser", "new@example.org"); requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	// the email is changed on behalf of the subuser, not of the client.
+	if c.OnBehalfOf != "" {
+		t.Fatalf("the client was modified: %q", c.OnBehalfOf)
+	}
+}
+
+func TestListSubusersPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" || r.URL.Path != "/subusers" || r.URL.Query().Get("limit") != "100" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// a full first page, then a partial one.
+		count := 100
+		if r.URL.Query().Get("offset") == "100" {
+			count = 1
+		}
+
+		users := make([]string, 0, count)
+		for i := 0; i < count; i++ {
+			users = append(users, `{"id": `+strconv.Itoa(i)+`, "username": "subuser`+strconv.Itoa(i)+`"}`)
+		}
+
+		fmt.Fprint(w, "["+strings.Join(users, ",")+"]")
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	subUsers, requestErr := c.ListSubusers()
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if len(subUsers) != 101 {
+		t.Fatalf("expected 101 subusers, got %d", len(subUsers))
+	}
+}
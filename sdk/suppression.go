@@ -0,0 +1,302 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+const (
+	// SuppressionBounces is the kind of suppression of the addresses which bounced.
+	SuppressionBounces = "bounces"
+
+	// SuppressionBlocks is the kind of suppression of the addresses blocked by their receiving server.
+	SuppressionBlocks = "blocks"
+
+	// SuppressionSpamReports is the kind of suppression of the addresses which reported emails as spam.
+	SuppressionSpamReports = "spam_reports"
+
+	// SuppressionInvalidEmails is the kind of suppression of the invalid addresses.
+	SuppressionInvalidEmails = "invalid_emails"
+
+	// SuppressionGlobal is the kind of suppression of the addresses globally unsubscribed.
+	SuppressionGlobal = "global"
+)
+
+// Suppression is an address which Sendgrid doesn't deliver to anymore.
+type Suppression struct {
+	Kind    string `json:"-"`
+	Email   string `json:"email"`
+	Created int64  `json:"created,omitempty"`
+	Reason  string `json:"reason,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+type globalSuppression struct {
+	RecipientEmail string `json:"recipient_email,omitempty"`
+}
+
+type recipientEmails struct {
+	RecipientEmails []string `json:"recipient_emails"`
+}
+
+// SuppressionKinds returns the kinds of suppression managed by Sendgrid.
+func SuppressionKinds() []string {
+	return []string{
+		SuppressionBounces,
+		SuppressionBlocks,
+		SuppressionSpamReports,
+		SuppressionInvalidEmails,
+		SuppressionGlobal,
+	}
+}
+
+func suppressionEndpoint(kind string) (string, error) {
+	switch kind {
+	case SuppressionBounces, SuppressionBlocks, SuppressionSpamReports, SuppressionInvalidEmails:
+		return "/suppression/" + kind, nil
+	case SuppressionGlobal:
+		return "/asm/suppressions/global", nil
+	default:
+		return "", fmt.Errorf("%w: %s", ErrInvalidSuppressionKind, kind)
+	}
+}
+
+func validateSuppression(kind, email string) (string, RequestError) {
+	if email == "" {
+		return "", RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionEmailRequired}
+	}
+
+	endpoint, err := suppressionEndpoint(kind)
+	if err != nil {
+		return "", RequestError{StatusCode: http.StatusNotAcceptable, Err: err}
+	}
+
+	return endpoint + "/" + url.PathEscape(email), RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func parseSuppression(kind, respBody string) (*Suppression, RequestError) {
+	if kind == SuppressionGlobal {
+		var body globalSuppression
+		if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing suppression: %w", err),
+			}
+		}
+
+		if body.RecipientEmail == "" {
+			return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrSuppressionNotFound}
+		}
+
+		return &Suppression{Kind: kind, Email: body.RecipientEmail}, RequestError{StatusCode: http.StatusOK, Err: nil}
+	}
+
+	var body []Suppression
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing suppression: %w", err),
+		}
+	}
+
+	if len(body) == 0 {
+		return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrSuppressionNotFound}
+	}
+
+	body[0].Kind = kind
+
+	return &body[0], RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// CreateGlobalSuppression adds an address to the global unsubscribe list.
+// The other kinds of suppression are only filled by Sendgrid itself.
+func (c *Client) CreateGlobalSuppression(email string) (bool, RequestError) {
+	if email == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/asm/suppressions/global", recipientEmails{
+		RecipientEmails: []string{email},
+	})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating suppression: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedCreatingSuppression, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadSuppression retrieves an address from a suppression list. If the address isn't
+// in the list, the returned error has the status http.StatusNotFound.
+func (c *Client) ReadSuppression(kind, email string) (*Suppression, RequestError) {
+	endpoint, requestErr := validateSuppression(kind, email)
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	respBody, statusCode, err := c.Get("GET", endpoint)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading suppression: %w", err),
+		}
+	}
+
+	if statusCode == http.StatusNotFound {
+		return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrSuppressionNotFound}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingSuppression, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseSuppression(kind, respBody)
+}
+
+// DeleteSuppression removes an address from a suppression list.
+func (c *Client) DeleteSuppression(kind, email string) (bool, RequestError) {
+	endpoint, requestErr := validateSuppression(kind, email)
+	if requestErr.Err != nil {
+		return false, requestErr
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", endpoint)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting suppression: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingSuppression, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// AddGroupSuppressions adds addresses to a suppression group in a single request, and returns the addresses
+// Sendgrid accepted, which can be fewer than the given ones, e.g. when some of them are invalid.
+func (c *Client) AddGroupSuppressions(groupID string, emails []string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionGroupIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/asm/groups/"+groupID+"/suppressions", recipientEmails{
+		RecipientEmails: emails,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed adding group suppressions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedAddingGroupSuppressions, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body recipientEmails
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing group suppressions: %w", err),
+		}
+	}
+
+	return body.RecipientEmails, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// SearchGroupSuppressions returns the addresses, among the given ones, which are in a suppression group.
+func (c *Client) SearchGroupSuppressions(groupID string, emails []string) ([]string, RequestError) {
+	if groupID == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionGroupIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/asm/groups/"+groupID+"/suppressions/search", recipientEmails{
+		RecipientEmails: emails,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed searching group suppressions: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedSearchingGroupSuppressions, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body []string
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing group suppressions: %w", err),
+		}
+	}
+
+	return body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// DeleteGroupSuppression removes an address from a suppression group.
+func (c *Client) DeleteGroupSuppression(groupID, email string) (bool, RequestError) {
+	if groupID == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionGroupIDRequired}
+	}
+
+	if email == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrSuppressionEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/asm/groups/"+groupID+"/suppressions/"+url.PathEscape(email))
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting group suppression: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDeletingGroupSuppression, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
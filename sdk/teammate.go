@@ -0,0 +1,359 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// teammatesPageSize is the number of teammates retrieved per call when listing the teammates.
+const teammatesPageSize = 500
+
+// Teammate is a user of the account, who accepted its invite.
+type Teammate struct {
+	Username  string   `json:"username,omitempty"`
+	Email     string   `json:"email,omitempty"`
+	FirstName string   `json:"first_name,omitempty"`
+	LastName  string   `json:"last_name,omitempty"`
+	UserType  string   `json:"user_type,omitempty"`
+	IsAdmin   bool     `json:"is_admin"`
+	Scopes    []string `json:"scopes,omitempty"`
+}
+
+// PendingTeammate is the invite of a teammate who didn't accept it yet.
+type PendingTeammate struct {
+	Email          string   `json:"email"`
+	Scopes         []string `json:"scopes"`
+	IsAdmin        bool     `json:"is_admin"`
+	Token          string   `json:"token,omitempty"`
+	ExpirationDate int64    `json:"expiration_date,omitempty"`
+}
+
+// Expired tells whether the invite can't be accepted anymore.
+func (p PendingTeammate) Expired(now time.Time) bool {
+	return p.ExpirationDate != 0 && now.Unix() >= p.ExpirationDate
+}
+
+type teammates struct {
+	Result []Teammate `json:"result"`
+}
+
+type pendingTeammates struct {
+	Result []PendingTeammate `json:"result"`
+}
+
+type teammateUpdate struct {
+	Scopes  []string `json:"scopes"`
+	IsAdmin bool     `json:"is_admin"`
+}
+
+type teammateInviteResend struct{}
+
+func parseTeammate(respBody string) (*Teammate, RequestError) {
+	var body Teammate
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing teammate: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func parsePendingTeammate(respBody string) (*PendingTeammate, RequestError) {
+	var body PendingTeammate
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing pending teammate: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// InviteTeammate sends an invite to the email address, the teammate is created once it's accepted.
+func (c *Client) InviteTeammate(email string, scopes []string, isAdmin bool) (*PendingTeammate, RequestError) {
+	if email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateEmailRequired}
+	}
+
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/teammates", PendingTeammate{
+		Email:   email,
+		Scopes:  scopes,
+		IsAdmin: isAdmin,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed inviting teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedInvitingTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parsePendingTeammate(respBody)
+}
+
+// ListPendingTeammates retrieves the invites which weren't accepted yet, the expired ones included.
+func (c *Client) ListPendingTeammates() ([]PendingTeammate, RequestError) {
+	respBody, statusCode, err := c.Get("GET", "/teammates/pending")
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed listing pending teammates: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedListingPendingTeammates, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body pendingTeammates
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing pending teammates: %w", err),
+		}
+	}
+
+	return body.Result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadPendingTeammate retrieves the pending invite sent to the email address, whatever its case. If there is none,
+// the returned error has the status http.StatusNotFound.
+func (c *Client) ReadPendingTeammate(email string) (*PendingTeammate, RequestError) {
+	if email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateEmailRequired}
+	}
+
+	pending, requestErr := c.ListPendingTeammates()
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	for i := range pending {
+		if strings.EqualFold(pending[i].Email, email) {
+			return &pending[i], RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrTeammateNotFound}
+}
+
+// ResendTeammateInvite sends a pending invite again, which also postpones its expiration.
+func (c *Client) ResendTeammateInvite(token string) (*PendingTeammate, RequestError) {
+	if token == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateInviteTokenRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/teammates/pending/"+token+"/resend", teammateInviteResend{})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed resending teammate invite: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedResendingTeammateInvite, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parsePendingTeammate(respBody)
+}
+
+// DeleteTeammateInvite deletes a pending invite.
+func (c *Client) DeleteTeammateInvite(token string) (bool, RequestError) {
+	if token == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateInviteTokenRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/teammates/pending/"+token)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting teammate invite: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDeletingTeammateInvite, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ListTeammates retrieves the teammates who accepted their invite, page by page.
+func (c *Client) ListTeammates() ([]Teammate, RequestError) {
+	var all []Teammate
+
+	for offset := 0; ; offset += teammatesPageSize {
+		endpoint := "/teammates?limit=" + strconv.Itoa(teammatesPageSize) + "&offset=" + strconv.Itoa(offset)
+
+		respBody, statusCode, err := c.Get("GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing teammates: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedListingTeammates, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		var body teammates
+		if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing teammates: %w", err),
+			}
+		}
+
+		all = append(all, body.Result...)
+
+		if len(body.Result) < teammatesPageSize {
+			return all, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+}
+
+// ReadTeammate retrieves a teammate, with its scopes, and returns it.
+func (c *Client) ReadTeammate(username string) (*Teammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/teammates/"+username)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// ReadTeammateByEmail retrieves the teammate who accepted the invite sent to the email address, whatever its case.
+// If there is none, the returned error has the status http.StatusNotFound.
+func (c *Client) ReadTeammateByEmail(email string) (*Teammate, RequestError) {
+	if email == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrTeammateEmailRequired}
+	}
+
+	all, requestErr := c.ListTeammates()
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	for _, teammate := range all {
+		if strings.EqualFold(teammate.Email, email) {
+			// the list doesn't include the scopes of the teammates.
+			return c.ReadTeammate(teammate.Username)
+		}
+	}
+
+	return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrTeammateNotFound}
+}
+
+// UpdateTeammate edits the permissions of a teammate and returns it.
+func (c *Client) UpdateTeammate(username string, scopes []string, isAdmin bool) (*Teammate, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if scopes == nil {
+		scopes = []string{}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/teammates/"+username, teammateUpdate{
+		Scopes:  scopes,
+		IsAdmin: isAdmin,
+	})
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedUpdatingTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseTeammate(respBody)
+}
+
+// DeleteTeammate deletes a teammate.
+func (c *Client) DeleteTeammate(username string) (bool, RequestError) {
+	if username == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/teammates/"+username)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting teammate: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedDeletingTeammate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,124 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// teammateSubuserAccessPageSize is the number of subusers retrieved per call
+// when reading the subuser access of a teammate.
+const teammateSubuserAccessPageSize = 100
+
+// SubuserAccess is the access of a teammate to a subuser.
+type SubuserAccess struct {
+	ID             int      `json:"id"`
+	Username       string   `json:"username,omitempty"`
+	Email          string   `json:"email,omitempty"`
+	Disabled       bool     `json:"disabled,omitempty"`
+	PermissionType string   `json:"permission_type"`
+	Scopes         []string `json:"scopes"`
+}
+
+// TeammateSubuserAccess is the list of subusers a teammate is restricted to.
+type TeammateSubuserAccess struct {
+	HasRestrictedSubuserAccess bool            `json:"has_restricted_subuser_access"`
+	SubuserAccess              []SubuserAccess `json:"subuser_access"`
+}
+
+func parseTeammateSubuserAccess(respBody string) (*TeammateSubuserAccess, RequestError) {
+	var body TeammateSubuserAccess
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing teammate subuser access: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadTeammateSubuserAccess retrieves the subusers a teammate has access to, page by page.
+func (c *Client) ReadTeammateSubuserAccess(username string) (*TeammateSubuserAccess, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	access := &TeammateSubuserAccess{}
+	endpoint := "/teammates/" + username + "/subuser_access?limit=" + strconv.Itoa(teammateSubuserAccessPageSize)
+
+	for afterID := 0; ; {
+		pageEndpoint := endpoint
+		if afterID != 0 {
+			pageEndpoint += "&after_subuser_id=" + strconv.Itoa(afterID)
+		}
+
+		respBody, statusCode, err := c.Get("GET", pageEndpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed reading teammate subuser access: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err: fmt.Errorf(
+					"%w, status: %d, response: %s", ErrFailedReadingTeammateSubuserAccess, statusCode, respBody,
+				),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		page, requestErr := parseTeammateSubuserAccess(respBody)
+		if requestErr.Err != nil {
+			return nil, requestErr
+		}
+
+		access.HasRestrictedSubuserAccess = page.HasRestrictedSubuserAccess
+		access.SubuserAccess = append(access.SubuserAccess, page.SubuserAccess...)
+
+		if len(page.SubuserAccess) < teammateSubuserAccessPageSize {
+			return access, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+
+		afterID = page.SubuserAccess[len(page.SubuserAccess)-1].ID
+	}
+}
+
+// UpdateTeammateSubuserAccess restricts a teammate to the given subusers,
+// or lifts the restriction if HasRestrictedSubuserAccess is false.
+func (c *Client) UpdateTeammateSubuserAccess(
+	username string,
+	access TeammateSubuserAccess,
+) (*TeammateSubuserAccess, RequestError) {
+	if username == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrUsernameRequired}
+	}
+
+	if access.SubuserAccess == nil {
+		access.SubuserAccess = []SubuserAccess{}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/teammates/"+username+"/subuser_access", access)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating teammate subuser access: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedUpdatingTeammateSubuserAccess, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseTeammateSubuserAccess(respBody)
+}
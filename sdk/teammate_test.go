@@ -0,0 +1,123 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestReadPendingTeammate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/teammates/pending" {
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+
+		fmt.Fprint(w, `{"result": [
+			{"email": "expired@example.org", "scopes": ["stats.read"], "token": "a", "expiration_date": 1},
+			{"email": "pending@example.org", "scopes": ["mail.send"], "token": "b", "expiration_date": 4102444800}
+		]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	expired, requestErr := c.ReadPendingTeammate("expired@example.org")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if !expired.Expired(time.Now()) {
+		t.Fatalf("expected the invite to be expired: %+v", expired)
+	}
+
+	pending, requestErr := c.ReadPendingTeammate("pending@example.org")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if pending.Expired(time.Now()) || pending.Token != "b" {
+		t.Fatalf("unexpected pending invite: %+v", pending)
+	}
+
+	// an accepted invite isn't pending anymore.
+	if _, requestErr = c.ReadPendingTeammate("accepted@example.org"); !errors.Is(requestErr, sendgrid.ErrNotFound) {
+		t.Fatalf("expected a not found error, got: %v", requestErr.Err)
+	}
+}
+
+func TestReadTeammateByEmail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/teammates":
+			fmt.Fprint(w, `{"result": [{"username": "teammate", "email": "teammate@example.org", "is_admin": false}]}`)
+		case "/teammates/teammate":
+			fmt.Fprint(w, `{"username": "teammate", "email": "teammate@example.org",
+				"scopes": ["mail.send", "2fa_required"]}`)
+		default:
+			t.Errorf("unexpected request: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	teammate, requestErr := c.ReadTeammateByEmail("teammate@example.org")
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if teammate.Username != "teammate" || len(teammate.Scopes) != 2 {
+		t.Fatalf("unexpected teammate: %+v", teammate)
+	}
+
+	if _, requestErr = c.ReadTeammateByEmail("unknown@example.org"); !errors.Is(requestErr, sendgrid.ErrNotFound) {
+		t.Fatalf("expected a not found error, got: %v", requestErr.Err)
+	}
+}
+
+func TestCreateSSOTeammate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+
+		if r.Method != "POST" || r.URL.Path != "/sso/teammates" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		// an SSO teammate has no password.
+		if string(body) != `{"email":"sso@example.org","first_name":"Jane","last_name":"Doe","is_admin":false,`+
+			`"scopes":["mail.send"]}` {
+			t.Errorf("unexpected body: %s", body)
+		}
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprint(w, string(body))
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	teammate, requestErr := c.CreateSSOTeammate(sendgrid.SSOTeammate{
+		Email:     "sso@example.org",
+		FirstName: "Jane",
+		LastName:  "Doe",
+		Scopes:    []string{"mail.send"},
+	})
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if teammate.Email != "sso@example.org" {
+		t.Fatalf("unexpected SSO teammate: %+v", teammate)
+	}
+
+	_, requestErr = c.CreateSSOTeammate(sendgrid.SSOTeammate{Email: "sso@example.org"})
+	if !errors.Is(requestErr.Err, sendgrid.ErrTeammateNameRequired) {
+		t.Fatalf("expected ErrTeammateNameRequired, got: %v", requestErr.Err)
+	}
+}
@@ -3,8 +3,18 @@ package sendgrid
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
+// templatesPageSize is the number of templates retrieved per call when listing templates, the maximum allowed.
+const templatesPageSize = 200
+
+// templateGenerations are the generations of templates listed when none is given.
+var templateGenerations = []string{"legacy", "dynamic"}
+
 // Template is a Sendgrid transactional template.
 type Template struct {
 	ID         string            `json:"id,omitempty"`
@@ -15,6 +25,11 @@ type Template struct {
 	Warnings   []string          `json:"warnings,omitempty"`
 }
 
+type templates struct {
+	Result   []Template   `json:"result"`
+	Metadata pageMetadata `json:"_metadata"`
+}
+
 func parseTemplate(respBody string) (*Template, error) {
 	var body Template
 
@@ -53,11 +68,19 @@ func (c *Client) ReadTemplate(id string) (*Template, error) {
 		return nil, ErrTemplateIDRequired
 	}
 
-	respBody, _, err := c.Get("GET", "/templates/"+id)
+	respBody, statusCode, err := c.Get("GET", "/templates/"+id)
 	if err != nil {
 		return nil, fmt.Errorf("failed reading template: %w", err)
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingTemplate, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	return parseTemplate(respBody)
 }
 
@@ -95,3 +118,37 @@ func (c *Client) DeleteTemplate(id string) (bool, error) {
 
 	return true, nil
 }
+
+// ListTemplates retrieves the transactional templates of the given generations, of all the generations if none
+// is given, following the cursors of the pages of templates, and returns them.
+func (c *Client) ListTemplates(generations ...string) ([]Template, RequestError) {
+	if len(generations) == 0 {
+		generations = templateGenerations
+	}
+
+	query := url.Values{}
+	query.Set("generations", strings.Join(generations, ","))
+	query.Set("page_size", strconv.Itoa(templatesPageSize))
+
+	var result []Template
+
+	requestErr := c.followCursor("GET", "/templates?"+query.Encode(), nil, "listing templates", ErrFailedListingTemplates,
+		func(respBody string) (int, pageMetadata, RequestError) {
+			var page templates
+			if err := json.Unmarshal([]byte(respBody), &page); err != nil {
+				return 0, pageMetadata{}, RequestError{
+					StatusCode: http.StatusInternalServerError,
+					Err:        fmt.Errorf("failed parsing templates: %w", err),
+				}
+			}
+
+			result = append(result, page.Result...)
+
+			return len(page.Result), page.Metadata, RequestError{StatusCode: http.StatusOK, Err: nil}
+		})
+	if requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return result, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
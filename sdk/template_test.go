@@ -0,0 +1,62 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestListTemplatesFollowsNextPage(t *testing.T) {
+	var server *httptest.Server
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// both generations are listed, Sendgrid only lists the legacy templates otherwise.
+		if r.URL.Query().Get("generations") != "legacy,dynamic" {
+			t.Errorf("unexpected generations: %s", r.URL)
+		}
+
+		switch r.URL.Query().Get("page_token") {
+		case "":
+			fmt.Fprintf(w, `{"result": [{"id": "d-1", "name": "first", "generation": "dynamic"}],
+				"_metadata": {"next": "%s/v3/templates?generations=legacy%%2Cdynamic&page_token=second"}}`, server.URL)
+		case "second":
+			fmt.Fprint(w, `{"result": [{"id": "2", "name": "second", "generation": "legacy"}], "_metadata": {}}`)
+		default:
+			t.Errorf("unexpected page: %s", r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	templates, requestErr := c.ListTemplates()
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %s", requestErr.Err)
+	}
+
+	if len(templates) != 2 || templates[1].ID != "2" || templates[1].Generation != "legacy" {
+		t.Fatalf("unexpected templates: %+v", templates)
+	}
+}
+
+func TestReadTemplateNotFound(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors": [{"field": null, "message": "resource not found"}]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	if _, err := c.ReadTemplate("d-1"); !errors.Is(err, sendgrid.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+
+	if _, err := c.ReadTemplateVersion("d-1", "1"); !errors.Is(err, sendgrid.ErrNotFound) {
+		t.Fatalf("expected ErrNotFound, got %v", err)
+	}
+}
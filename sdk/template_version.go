@@ -3,6 +3,7 @@ package sendgrid
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 )
 
 // TemplateVersion is a Sendgrid transactional template version.
@@ -16,7 +17,7 @@ type TemplateVersion struct {
 	Name                 string   `json:"name,omitempty"`
 	HTMLContent          string   `json:"html_content,omitempty"`
 	PlainContent         string   `json:"plain_content,omitempty"`
-	GeneratePlainContent bool     `json:"generate_plain_content,omitempty"`
+	GeneratePlainContent bool     `json:"generate_plain_content"`
 	Subject              string   `json:"subject,omitempty"`
 	Editor               string   `json:"editor,omitempty"`
 	TestData             string   `json:"test_data,omitempty"`
@@ -65,11 +66,19 @@ func (c *Client) ReadTemplateVersion(templateID, id string) (*TemplateVersion, e
 		return nil, ErrTemplateIDRequired
 	}
 
-	respBody, _, err := c.Get("GET", "/templates/"+templateID+"/versions/"+id)
+	respBody, statusCode, err := c.Get("GET", "/templates/"+templateID+"/versions/"+id)
 	if err != nil {
 		return nil, fmt.Errorf("failed reading template version: %w", err)
 	}
 
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode:  statusCode,
+			Err:         fmt.Errorf("%w, status: %d, response: %s", ErrFailedReadingTemplateVersion, statusCode, respBody),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
 	return parseTemplateVersion(respBody)
 }
 
@@ -0,0 +1,92 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+// TrackingSettingClick is the click tracking setting, whose links are rewritten to count the clicks.
+// The fields which are nil aren't changed by an update, e.g. a subuser keeps the value of the parent account.
+type TrackingSettingClick struct {
+	Enabled    *bool `json:"enabled,omitempty"`
+	EnableText *bool `json:"enable_text,omitempty"`
+}
+
+func (c *Client) readTrackingSetting(name string, setting interface{}) RequestError {
+	respBody, statusCode, err := c.Get("GET", "/tracking_settings/"+name)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading tracking setting %s: %w", name, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w %s, status: %d, response: %s", ErrFailedReadingTrackingSetting, name, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err = json.Unmarshal([]byte(respBody), setting); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing tracking setting %s: %w", name, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+func (c *Client) updateTrackingSetting(name string, setting interface{}) RequestError {
+	respBody, statusCode, err := c.Post("PATCH", "/tracking_settings/"+name, setting)
+	if err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating tracking setting %s: %w", name, err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w %s, status: %d, response: %s", ErrFailedUpdatingTrackingSetting, name, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	if err = json.Unmarshal([]byte(respBody), setting); err != nil {
+		return RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing tracking setting %s: %w", name, err),
+		}
+	}
+
+	return RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ReadTrackingSettingClick retrieves the click tracking setting.
+func (c *Client) ReadTrackingSettingClick() (*TrackingSettingClick, RequestError) {
+	var setting TrackingSettingClick
+
+	if requestErr := c.readTrackingSetting("click", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// UpdateTrackingSettingClick edits the fields of the click tracking setting which aren't nil.
+func (c *Client) UpdateTrackingSettingClick(setting TrackingSettingClick) (*TrackingSettingClick, RequestError) {
+	if requestErr := c.updateTrackingSetting("click", &setting); requestErr.Err != nil {
+		return nil, requestErr
+	}
+
+	return &setting, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
@@ -0,0 +1,276 @@
+package sendgrid
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// verifiedSendersPageSize is the number of verified senders retrieved per call when listing them.
+const verifiedSendersPageSize = 100
+
+// VerifiedSender is a single sender identity, verified by an email sent to its address.
+type VerifiedSender struct {
+	ID          int64  `json:"id,omitempty"`
+	Nickname    string `json:"nickname"`
+	FromEmail   string `json:"from_email"`
+	FromName    string `json:"from_name,omitempty"`
+	ReplyTo     string `json:"reply_to"`
+	ReplyToName string `json:"reply_to_name,omitempty"`
+	Address     string `json:"address"`
+	Address2    string `json:"address2,omitempty"`
+	State       string `json:"state,omitempty"`
+	City        string `json:"city"`
+	Zip         string `json:"zip,omitempty"`
+	Country     string `json:"country"`
+	Verified    bool   `json:"verified,omitempty"`
+	Locked      bool   `json:"locked,omitempty"`
+}
+
+type verifiedSenders struct {
+	Results []VerifiedSender `json:"results"`
+}
+
+type verifiedSenderResend struct{}
+
+func parseVerifiedSender(respBody string) (*VerifiedSender, RequestError) {
+	var body VerifiedSender
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing verified sender: %w", err),
+		}
+	}
+
+	return &body, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// verifiedSenderRejection returns the known reason Sendgrid rejected a sender for, or nil.
+func verifiedSenderRejection(respBody string) error {
+	var body apiErrors
+	if err := json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil
+	}
+
+	for _, e := range body.Errors {
+		message := strings.ToLower(e.Message)
+
+		switch {
+		case strings.Contains(message, "domain") && strings.Contains(message, "authenticat"):
+			return ErrVerifiedSenderDomainAuthenticated
+		case e.Field == "from_email" && strings.Contains(message, "exists"):
+			return ErrVerifiedSenderAlreadyExists
+		}
+	}
+
+	return nil
+}
+
+// CreateVerifiedSender creates a sender identity, Sendgrid sends a verification email to its address.
+// If a sender with the same from email exists, the returned error wraps ErrVerifiedSenderAlreadyExists,
+// if the domain of the from email is authenticated, it wraps ErrVerifiedSenderDomainAuthenticated.
+func (c *Client) CreateVerifiedSender(sender VerifiedSender) (*VerifiedSender, RequestError) {
+	if sender.FromEmail == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrVerifiedSenderFromEmailRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/verified_senders", sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed creating verified sender: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusBadRequest && statusCode < http.StatusInternalServerError {
+		if rejection := verifiedSenderRejection(respBody); rejection != nil {
+			return nil, RequestError{
+				StatusCode:  statusCode,
+				Err:         fmt.Errorf("%w, status: %d, response: %s", rejection, statusCode, respBody),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedCreatingVerifiedSender, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseVerifiedSender(respBody)
+}
+
+// ListVerifiedSenders retrieves the sender identities, page by page.
+func (c *Client) ListVerifiedSenders() ([]VerifiedSender, RequestError) {
+	var all []VerifiedSender
+
+	endpoint := "/verified_senders?limit=" + strconv.Itoa(verifiedSendersPageSize)
+
+	for {
+		respBody, statusCode, err := c.Get("GET", endpoint)
+		if err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed listing verified senders: %w", err),
+			}
+		}
+
+		if statusCode >= http.StatusMultipleChoices {
+			return nil, RequestError{
+				StatusCode: statusCode,
+				Err: fmt.Errorf(
+					"%w, status: %d, response: %s", ErrFailedListingVerifiedSenders, statusCode, respBody,
+				),
+				FieldErrors: parseFieldErrors(respBody),
+			}
+		}
+
+		var body verifiedSenders
+		if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+			return nil, RequestError{
+				StatusCode: http.StatusInternalServerError,
+				Err:        fmt.Errorf("failed parsing verified senders: %w", err),
+			}
+		}
+
+		all = append(all, body.Results...)
+
+		if len(body.Results) < verifiedSendersPageSize {
+			return all, RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+
+		// the next page starts after the last sender seen.
+		endpoint = "/verified_senders?limit=" + strconv.Itoa(verifiedSendersPageSize) +
+			"&lastSeenID=" + strconv.FormatInt(body.Results[len(body.Results)-1].ID, 10)
+	}
+}
+
+// ReadVerifiedSender retrieves a sender identity and returns it. If it doesn't exist,
+// the returned error has the status http.StatusNotFound.
+func (c *Client) ReadVerifiedSender(id string) (*VerifiedSender, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrVerifiedSenderIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("GET", "/verified_senders?id="+id)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed reading verified sender: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedReadingVerifiedSender, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	var body verifiedSenders
+	if err = json.Unmarshal([]byte(respBody), &body); err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed parsing verified senders: %w", err),
+		}
+	}
+
+	for i := range body.Results {
+		if strconv.FormatInt(body.Results[i].ID, 10) == id {
+			return &body.Results[i], RequestError{StatusCode: http.StatusOK, Err: nil}
+		}
+	}
+
+	return nil, RequestError{StatusCode: http.StatusNotFound, Err: ErrVerifiedSenderNotFound}
+}
+
+// UpdateVerifiedSender edits a sender identity and returns it.
+func (c *Client) UpdateVerifiedSender(id string, sender VerifiedSender) (*VerifiedSender, RequestError) {
+	if id == "" {
+		return nil, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrVerifiedSenderIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("PATCH", "/verified_senders/"+id, sender)
+	if err != nil {
+		return nil, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed updating verified sender: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return nil, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedUpdatingVerifiedSender, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return parseVerifiedSender(respBody)
+}
+
+// DeleteVerifiedSender deletes a sender identity.
+func (c *Client) DeleteVerifiedSender(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrVerifiedSenderIDRequired}
+	}
+
+	respBody, statusCode, err := c.Get("DELETE", "/verified_senders/"+id)
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed deleting verified sender: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices && statusCode != http.StatusNotFound { // ignore not found
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedDeletingVerifiedSender, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
+
+// ResendVerifiedSenderVerification sends the verification email of a sender identity again.
+func (c *Client) ResendVerifiedSenderVerification(id string) (bool, RequestError) {
+	if id == "" {
+		return false, RequestError{StatusCode: http.StatusNotAcceptable, Err: ErrVerifiedSenderIDRequired}
+	}
+
+	respBody, statusCode, err := c.Post("POST", "/verified_senders/resend/"+id, verifiedSenderResend{})
+	if err != nil {
+		return false, RequestError{
+			StatusCode: http.StatusInternalServerError,
+			Err:        fmt.Errorf("failed resending verified sender verification: %w", err),
+		}
+	}
+
+	if statusCode >= http.StatusMultipleChoices {
+		return false, RequestError{
+			StatusCode: statusCode,
+			Err: fmt.Errorf(
+				"%w, status: %d, response: %s", ErrFailedResendingVerifiedSenderVerification, statusCode, respBody,
+			),
+			FieldErrors: parseFieldErrors(respBody),
+		}
+	}
+
+	return true, RequestError{StatusCode: http.StatusOK, Err: nil}
+}
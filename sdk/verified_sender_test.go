@@ -0,0 +1,71 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestCreateVerifiedSenderRejections(t *testing.T) {
+	for respBody, expected := range map[string]error{
+		`{"errors": [{"field": "from_email", "message": "already exists"}]}`: sendgrid.ErrVerifiedSenderAlreadyExists,
+		`{"errors": [{"field": null, "message": "You've already authenticated this domain, ` +
+			`use domain authentication instead"}]}`: sendgrid.ErrVerifiedSenderDomainAuthenticated,
+		`{"errors": [{"field": "city", "message": "is required"}]}`: sendgrid.ErrFailedCreatingVerifiedSender,
+	} {
+		respBody := respBody
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, respBody)
+		}))
+
+		c := sendgrid.NewClient("key", server.URL, "")
+
+		_, requestErr := c.CreateVerifiedSender(sendgrid.VerifiedSender{FromEmail: "sender@example.org"})
+		if !errors.Is(requestErr.Err, expected) {
+			t.Errorf("%s: expected %v, got %v", respBody, expected, requestErr.Err)
+		}
+
+		server.Close()
+	}
+}
+
+func TestListVerifiedSendersPaginates(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("lastSeenID") == "" {
+			fmt.Fprint(w, `{"results": [`)
+			for i := 1; i <= 100; i++ {
+				if i > 1 {
+					fmt.Fprint(w, ",")
+				}
+				fmt.Fprintf(w, `{"id": %d, "from_email": "sender%d@example.org"}`, i, i)
+			}
+			fmt.Fprint(w, `]}`)
+
+			return
+		}
+
+		if r.URL.Query().Get("lastSeenID") != "100" {
+			t.Errorf("unexpected page: %s", r.URL)
+		}
+
+		fmt.Fprint(w, `{"results": [{"id": 101, "from_email": "sender101@example.org"}]}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	senders, requestErr := c.ListVerifiedSenders()
+	if requestErr.Err != nil {
+		t.Fatalf("unexpected error: %v", requestErr.Err)
+	}
+
+	if len(senders) != 101 || senders[100].FromEmail != "sender101@example.org" {
+		t.Errorf("expected 101 senders, got %d", len(senders))
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Use this data source to retrieve the account the API key belongs to,
+e.g. to refuse to apply a production configuration against a trial account.
+Example Usage
+```hcl
+data "sendgrid_account" "current" {}
+
+resource "sendgrid_subuser" "production" {
+	username = "production"
+	email    = "production@example.org"
+	password = "Passw0rd!"
+	ips      = ["127.0.0.1"]
+
+	lifecycle {
+		precondition {
+			condition     = data.sendgrid_account.current.type == "paid"
+			error_message = "The production subuser can only be created on a paid account."
+		}
+	}
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridAccount() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridAccountRead,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the account, e.g. free or paid.",
+				Computed:    true,
+			},
+			"reputation": {
+				Type:        schema.TypeFloat,
+				Description: "The sender reputation of the account, from 0 to 100.",
+				Computed:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the user of the account.",
+				Computed:    true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Description: "The first name of the user of the account.",
+				Computed:    true,
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Description: "The last name of the user of the account.",
+				Computed:    true,
+			},
+			"company": {
+				Type:        schema.TypeString,
+				Description: "The company of the user of the account.",
+				Computed:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The address of the company.",
+				Computed:    true,
+			},
+			"address2": {
+				Type:        schema.TypeString,
+				Description: "The second line of the address of the company.",
+				Computed:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the company.",
+				Computed:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The state of the company.",
+				Computed:    true,
+			},
+			"zip": {
+				Type:        schema.TypeString,
+				Description: "The zip code of the company.",
+				Computed:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the company.",
+				Computed:    true,
+			},
+			"phone": {
+				Type:        schema.TypeString,
+				Description: "The phone number of the user of the account.",
+				Computed:    true,
+			},
+			"website": {
+				Type:        schema.TypeString,
+				Description: "The website of the company.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSendgridAccountRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	account, requestErr := c.ReadAccount()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading account", requestErr)}
+	}
+
+	profile, requestErr := c.ReadProfile()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading profile", requestErr)}
+	}
+
+	email, requestErr := c.ReadUserEmail()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading user email", requestErr)}
+	}
+
+	d.SetId(email)
+	//nolint:errcheck
+	d.Set("type", account.Type)
+	//nolint:errcheck
+	d.Set("reputation", account.Reputation)
+	//nolint:errcheck
+	d.Set("email", email)
+	//nolint:errcheck
+	d.Set("first_name", profile.FirstName)
+	//nolint:errcheck
+	d.Set("last_name", profile.LastName)
+	//nolint:errcheck
+	d.Set("company", profile.Company)
+	//nolint:errcheck
+	d.Set("address", profile.Address)
+	//nolint:errcheck
+	d.Set("address2", profile.Address2)
+	//nolint:errcheck
+	d.Set("city", profile.City)
+	//nolint:errcheck
+	d.Set("state", profile.State)
+	//nolint:errcheck
+	d.Set("zip", profile.Zip)
+	//nolint:errcheck
+	d.Set("country", profile.Country)
+	//nolint:errcheck
+	d.Set("phone", profile.Phone)
+	//nolint:errcheck
+	d.Set("website", profile.Website)
+
+	return nil
+}
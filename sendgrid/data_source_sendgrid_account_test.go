@@ -0,0 +1,24 @@
+package sendgrid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridAccountBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `data "sendgrid_account" "current" {}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_account.current", "type"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_account.current", "reputation"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_account.current", "email"),
+				),
+			},
+		},
+	})
+}
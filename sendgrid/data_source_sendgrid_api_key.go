@@ -0,0 +1,63 @@
+/*
+Use this data source to retrieve the scopes of an existing API key.
+Sendgrid doesn't expose when an API key was created nor last used.
+Example Usage
+```hcl
+data "sendgrid_api_key" "api_key" {
+	api_key_id = "my-api-key-id"
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridAPIKey() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridAPIKeyRead,
+
+		Schema: map[string]*schema.Schema{
+			"api_key_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the API key.",
+				Required:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the API key.",
+				Computed:    true,
+			},
+			"scopes": {
+				Type:        schema.TypeSet,
+				Description: "The individual permissions given to this API key.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSendgridAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	apiKeyID := d.Get("api_key_id").(string)
+
+	apiKey, err := c.ReadAPIKey(apiKeyID)
+	if err.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading API key", err)}
+	}
+
+	d.SetId(apiKeyID)
+	//nolint:errcheck
+	d.Set("name", apiKey.Name)
+	//nolint:errcheck
+	d.Set("scopes", apiKey.Scopes)
+
+	return nil
+}
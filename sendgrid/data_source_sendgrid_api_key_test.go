@@ -0,0 +1,44 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridAPIKeyBasic(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSendgridAPIKeyConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.sendgrid_api_key.api_key", "name",
+						"sendgrid_api_key.api_key", "name",
+					),
+					resource.TestCheckResourceAttr("data.sendgrid_api_key.api_key", "scopes.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSendgridAPIKeyConfigBasic(name string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_api_key" "api_key" {
+		name   = %q
+		scopes = ["mail.send"]
+	}
+
+	data "sendgrid_api_key" "api_key" {
+		api_key_id = sendgrid_api_key.api_key.id
+	}
+	`, name)
+}
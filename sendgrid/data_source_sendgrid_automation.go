@@ -0,0 +1,75 @@
+/*
+Use this data source to retrieve a marketing automation by name,
+e.g. to reference its ID while the automation is managed in the Sendgrid UI.
+Example Usage
+```hcl
+data "sendgrid_automation" "welcome" {
+	name = "Welcome series"
+}
+
+output "welcome_automation_id" {
+	value = data.sendgrid_automation.welcome.id
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridAutomation() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridAutomationRead,
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the automation.",
+				Required:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the automation, e.g. draft or live.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSendgridAutomationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	name := d.Get("name").(string)
+
+	automations, requestErr := c.ListAutomations()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing automations", requestErr)}
+	}
+
+	var matches []sendgrid.Automation
+
+	for _, automation := range automations {
+		if automation.Name == name {
+			matches = append(matches, automation)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return diag.FromErr(automationNotFound(name))
+	case 1:
+	default:
+		return diag.FromErr(automationAmbiguous(name))
+	}
+
+	d.SetId(matches[0].ID)
+	//nolint:errcheck
+	d.Set("status", matches[0].Status)
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridAutomationBasic(t *testing.T) {
+	name := os.Getenv("SENDGRID_TEST_AUTOMATION")
+	if name == "" {
+		t.Skip("SENDGRID_TEST_AUTOMATION must be set to the name of a marketing automation of the account")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "sendgrid_automation" "automation" {
+					name = %q
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_automation.automation", "id"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_automation.automation", "status"),
+				),
+			},
+		},
+	})
+}
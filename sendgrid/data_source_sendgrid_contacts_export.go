@@ -0,0 +1,143 @@
+/*
+Use this data source to export the marketing contacts, e.g. to snapshot them before risky changes.
+The export is asynchronous: it's polled until its files are ready, up to the `timeout`.
+As a data source, a new export is started every time it's read, i.e. on every plan.
+Example Usage
+```hcl
+data "sendgrid_contacts_export" "backup" {
+	list_ids  = ["ca7a3796-e8a8-4029-9ccb-df8937940562"]
+	file_type = "json"
+	timeout   = "15m"
+}
+
+output "contacts_export_urls" {
+	value     = data.sendgrid_contacts_export.backup.urls
+	sensitive = true
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// defaultContactsExportTimeout is the time given to Sendgrid to export the contacts.
+const defaultContactsExportTimeout = 5 * time.Minute
+
+func dataSourceSendgridContactsExport() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridContactsExportRead,
+
+		Schema: map[string]*schema.Schema{
+			"list_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the lists to export, all the contacts are exported if no list nor segment is set.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"segment_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the segments to export.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"file_type": {
+				Type:         schema.TypeString,
+				Description:  "The format of the exported files: csv (default) or json.",
+				Optional:     true,
+				Default:      "csv",
+				ValidateFunc: validation.StringInSlice([]string{"csv", "json"}, false),
+			},
+			"timeout": {
+				Type:         schema.TypeString,
+				Description:  "How long to wait for the export to be ready, e.g. 15m.",
+				Optional:     true,
+				Default:      defaultContactsExportTimeout.String(),
+				ValidateFunc: validateDuration,
+			},
+			"urls": {
+				Type:        schema.TypeList,
+				Description: "The URLs to download the exported files from.",
+				Computed:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"contact_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of exported contacts.",
+				Computed:    true,
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the URLs expire at.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceSendgridContactsExportRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	timeout, _ := time.ParseDuration(d.Get("timeout").(string))
+
+	started, err := c.Retry(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateContactsExport(sendgrid.ContactsExportRequest{
+			ListIDs:    stringSetToSlice(d.Get("list_ids").(*schema.Set)),
+			SegmentIDs: stringSetToSlice(d.Get("segment_ids").(*schema.Set)),
+			FileType:   d.Get("file_type").(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed creating contacts export", err)
+	}
+
+	id := started.(*sendgrid.ContactsExport).ID
+
+	var export *sendgrid.ContactsExport
+
+	err = resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		current, readErr := c.Retry(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return c.ReadContactsExport(id)
+		})
+		if readErr != nil {
+			return resource.NonRetryableError(readErr)
+		}
+
+		export = current.(*sendgrid.ContactsExport)
+
+		switch export.Status {
+		case sendgrid.ContactsExportStatusReady:
+			return nil
+		case sendgrid.ContactsExportStatusFailure:
+			return resource.NonRetryableError(contactsExportFailed(id, export.Message))
+		default:
+			return resource.RetryableError(ErrContactsExportPending)
+		}
+	})
+	if err != nil {
+		return errorToDiags("failed exporting contacts", err)
+	}
+
+	d.SetId(id)
+	//nolint:errcheck
+	d.Set("urls", export.URLs)
+	//nolint:errcheck
+	d.Set("contact_count", export.ContactCount)
+	//nolint:errcheck
+	d.Set("expires_at", export.ExpiresAt)
+
+	return nil
+}
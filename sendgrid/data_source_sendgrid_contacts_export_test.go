@@ -0,0 +1,27 @@
+package sendgrid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridContactsExportBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "sendgrid_contacts_export" "all" {
+					file_type = "json"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_contacts_export.all", "urls.#"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_contacts_export.all", "contact_count"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,230 @@
+/*
+Use this data source to retrieve an authenticated domain, by domain or by ID,
+e.g. to create its DNS records with Terraform while the domain was authenticated manually.
+Example Usage
+```hcl
+data "sendgrid_domain_authentication" "example" {
+	domain = "example.org"
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(data.sendgrid_domain_authentication.example.dns)
+	zone_id = var.zone_id
+	type    = upper(data.sendgrid_domain_authentication.example.dns[count.index].type)
+	name    = data.sendgrid_domain_authentication.example.dns[count.index].host
+	records = [data.sendgrid_domain_authentication.example.dns[count.index].data]
+	ttl     = 300
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridDomainAuthentication() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridDomainAuthenticationRead,
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:         schema.TypeString,
+				Description:  "The ID of the authenticated domain.",
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"domain_id", "domain"},
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The authenticated domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain of the authenticated domain, to choose between several authentications of the domain.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the DNS records of the domain were validated.",
+				Computed:    true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether the domain is the default authenticated domain.",
+				Computed:    true,
+			},
+			"automatic_security": {
+				Type:        schema.TypeBool,
+				Description: "Whether Sendgrid manages the SPF and DKIM records of the domain.",
+				Computed:    true,
+			},
+			"dns": dnsRecordsSchema("The DNS records to create to authenticate the domain"),
+		},
+	}
+}
+
+// dnsRecordsSchema is the schema of the DNS records to create to validate a domain authentication,
+// a link branding or a reverse DNS, so that they can be fed to any DNS provider the same way.
+func dnsRecordsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description + ", sorted by host then type.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:        schema.TypeString,
+					Description: "The type of the DNS record, e.g. cname.",
+					Computed:    true,
+				},
+				"host": {
+					Type:        schema.TypeString,
+					Description: "The host of the DNS record.",
+					Computed:    true,
+				},
+				"data": {
+					Type:        schema.TypeString,
+					Description: "The value of the DNS record.",
+					Computed:    true,
+				},
+				"valid": {
+					Type:        schema.TypeBool,
+					Description: "Whether the DNS record was validated.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// flattenDNSRecords returns the DNS records sorted by host, then type and value,
+// so that references to them don't shift between applies.
+func flattenDNSRecords(records []sendgrid.DNSRecord) []interface{} {
+	sorted := make([]sendgrid.DNSRecord, len(records))
+	copy(sorted, records)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Host != sorted[j].Host {
+			return sorted[i].Host < sorted[j].Host
+		}
+
+		if sorted[i].Type != sorted[j].Type {
+			return sorted[i].Type < sorted[j].Type
+		}
+
+		return sorted[i].Data < sorted[j].Data
+	})
+
+	flattened := make([]interface{}, 0, len(sorted))
+	for _, record := range sorted {
+		flattened = append(flattened, map[string]interface{}{
+			"type":  record.Type,
+			"host":  record.Host,
+			"data":  record.Data,
+			"valid": record.Valid,
+		})
+	}
+
+	return flattened
+}
+
+// dnsRecordsValues returns the DNS records Sendgrid keys by their role, e.g. dkim1, in no particular order.
+func dnsRecordsValues(dns map[string]sendgrid.DNSRecord) []sendgrid.DNSRecord {
+	records := make([]sendgrid.DNSRecord, 0, len(dns))
+	for _, record := range dns {
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// matchDomainAuthentications returns the authentications of the domain, restricted to the subdomain if it's set.
+func matchDomainAuthentications(
+	domains []sendgrid.DomainAuthentication,
+	domain, subdomain string,
+) []sendgrid.DomainAuthentication {
+	var matches []sendgrid.DomainAuthentication
+
+	for _, authentication := range domains {
+		if authentication.Domain == domain && (subdomain == "" || authentication.Subdomain == subdomain) {
+			matches = append(matches, authentication)
+		}
+	}
+
+	return matches
+}
+
+func findDomainAuthentication(
+	c *sendgrid.Client,
+	domain, subdomain string,
+) (*sendgrid.DomainAuthentication, diag.Diagnostics) {
+	domains, requestErr := c.ListDomainAuthentications(domain)
+	if requestErr.Err != nil {
+		return nil, diag.Diagnostics{requestErrorToDiag("failed listing domain authentications", requestErr)}
+	}
+
+	matches := matchDomainAuthentications(domains, domain, subdomain)
+
+	switch len(matches) {
+	case 0:
+		return nil, diag.FromErr(domainAuthenticationNotFound(domain))
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, diag.FromErr(domainAuthenticationAmbiguous(domain))
+	}
+}
+
+func dataSourceSendgridDomainAuthenticationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	var domain *sendgrid.DomainAuthentication
+
+	if id, ok := d.GetOk("domain_id"); ok {
+		var requestErr sendgrid.RequestError
+
+		domain, requestErr = c.ReadDomainAuthentication(id.(string))
+		if requestErr.Err != nil {
+			return diag.Diagnostics{requestErrorToDiag("failed reading domain authentication", requestErr)}
+		}
+	} else {
+		var diags diag.Diagnostics
+
+		domain, diags = findDomainAuthentication(c, d.Get("domain").(string), d.Get("subdomain").(string))
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	d.SetId(strconv.FormatInt(domain.ID, 10))
+	//nolint:errcheck
+	d.Set("domain_id", d.Id())
+	//nolint:errcheck
+	d.Set("domain", domain.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", domain.Subdomain)
+	//nolint:errcheck
+	d.Set("valid", domain.Valid)
+	//nolint:errcheck
+	d.Set("default", domain.Default)
+	//nolint:errcheck
+	d.Set("automatic_security", domain.AutomaticSecurity)
+	//nolint:errcheck
+	d.Set("dns", flattenDNSRecords(dnsRecordsValues(domain.DNS)))
+
+	return nil
+}
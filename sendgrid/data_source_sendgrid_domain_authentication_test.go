@@ -0,0 +1,42 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridDomainAuthenticationBasic(t *testing.T) {
+	domain := os.Getenv("SENDGRID_TEST_DOMAIN")
+	if domain == "" {
+		t.Skip("SENDGRID_TEST_DOMAIN must be set to a domain authenticated on the account")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "sendgrid_domain_authentication" "by_domain" {
+					domain = %q
+				}
+
+				data "sendgrid_domain_authentication" "by_id" {
+					domain_id = data.sendgrid_domain_authentication.by_domain.domain_id
+				}
+				`, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_domain_authentication.by_domain", "domain", domain),
+					resource.TestCheckResourceAttrSet("data.sendgrid_domain_authentication.by_domain", "dns.#"),
+					resource.TestCheckResourceAttrPair(
+						"data.sendgrid_domain_authentication.by_id", "dns.#",
+						"data.sendgrid_domain_authentication.by_domain", "dns.#",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,134 @@
+/*
+Use this data source to list the IP addresses of the account,
+e.g. to find the IP addresses which aren't assigned to any subuser yet.
+Example Usage
+```hcl
+data "sendgrid_ips" "unassigned" {
+	assignment = "unassigned"
+}
+
+resource "sendgrid_subuser" "subuser" {
+	username = "my-subuser"
+	email    = "subuser@example.org"
+	password = "Passw0rd!"
+	ips      = [data.sendgrid_ips.unassigned.addresses[0]]
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridIPs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridIPsRead,
+
+		Schema: map[string]*schema.Schema{
+			"assignment": {
+				Type: schema.TypeString,
+				Description: "Filter the IP addresses by assignment to subusers, allowed values: " +
+					"all (default), assigned (to at least one subuser), unassigned (to no subuser).",
+				Optional:     true,
+				Default:      "all",
+				ValidateFunc: validation.StringInSlice([]string{"all", "assigned", "unassigned"}, false),
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Description: "The IP addresses matching the filter.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Description: "The details of the IP addresses matching the filter.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"ip": {
+							Type:        schema.TypeString,
+							Description: "The IP address.",
+							Computed:    true,
+						},
+						"subusers": {
+							Type:        schema.TypeList,
+							Description: "The subusers the IP address is assigned to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"pools": {
+							Type:        schema.TypeList,
+							Description: "The IP pools the IP address belongs to.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"warmup": {
+							Type:        schema.TypeBool,
+							Description: "Whether the IP address is being warmed up.",
+							Computed:    true,
+						},
+						"assigned_at": {
+							Type:        schema.TypeInt,
+							Description: "The date (unix timestamp) the IP address was assigned to the account.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ipMatchesAssignment(ip sendgrid.IP, assignment string) bool {
+	switch assignment {
+	case "assigned":
+		return len(ip.Subusers) > 0
+	case "unassigned":
+		return len(ip.Subusers) == 0
+	default:
+		return true
+	}
+}
+
+func dataSourceSendgridIPsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	assignment := d.Get("assignment").(string)
+
+	ips, requestErr := c.ListIPs()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing IPs", requestErr)}
+	}
+
+	addresses := make([]string, 0, len(ips))
+	details := make([]interface{}, 0, len(ips))
+
+	for _, ip := range ips {
+		if !ipMatchesAssignment(ip, assignment) {
+			continue
+		}
+
+		addresses = append(addresses, ip.IP)
+		details = append(details, map[string]interface{}{
+			"ip":          ip.IP,
+			"subusers":    ip.Subusers,
+			"pools":       ip.Pools,
+			"warmup":      ip.Warmup,
+			"assigned_at": ip.AssignedAt,
+		})
+	}
+
+	d.SetId(assignment)
+	//nolint:errcheck
+	d.Set("addresses", addresses)
+	//nolint:errcheck
+	d.Set("ips", details)
+
+	return nil
+}
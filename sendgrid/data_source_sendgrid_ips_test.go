@@ -0,0 +1,29 @@
+package sendgrid_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridIPsBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				data "sendgrid_ips" "all" {}
+
+				data "sendgrid_ips" "unassigned" {
+					assignment = "unassigned"
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.sendgrid_ips.all", "addresses.#"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_ips.unassigned", "addresses.#"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,53 @@
+/*
+Use this data source to list the scopes of the API key of the provider,
+e.g. to grant an API key only the scopes which are available.
+The scopes are read once per provider run.
+Example Usage
+```hcl
+data "sendgrid_scopes" "current" {}
+
+resource "sendgrid_api_key" "stats" {
+	name   = "stats"
+	scopes = setintersection(data.sendgrid_scopes.current.scopes, ["stats.read", "stats.global.read"])
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridScopes() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridScopesRead,
+
+		Schema: map[string]*schema.Schema{
+			"scopes": {
+				Type:        schema.TypeSet,
+				Description: "The scopes of the API key.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceSendgridScopesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	scopes, requestErr := c.ListScopes()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing scopes", requestErr)}
+	}
+
+	d.SetId("scopes")
+	//nolint:errcheck
+	d.Set("scopes", scopes)
+
+	return nil
+}
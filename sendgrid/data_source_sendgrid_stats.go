@@ -0,0 +1,290 @@
+/*
+Use this data source to retrieve the email activity of the account, or of a subuser, between two dates,
+e.g. to check the deliverability before a deployment.
+Example Usage
+```hcl
+data "sendgrid_stats" "last_week" {
+	start_date    = "2021-06-01"
+	end_date      = "2021-06-07"
+	aggregated_by = "week"
+}
+
+output "bounces" {
+	value = data.sendgrid_stats.last_week.stats[0].bounces
+}
+```
+The activity can be broken down by category, mailbox provider or browser, by setting `categories`,
+`mailbox_providers` or `browsers`: `stats` then has the stats of each name for each period, the name in `name`.
+Set `breakdown` alone to break the activity down by every mailbox provider or browser, the categories must be listed.
+The mailbox providers and browsers only report some of the metrics, the others are 0, e.g.
+```hcl
+data "sendgrid_stats" "newsletter" {
+	start_date = "2021-06-01"
+	categories = ["newsletter", "newsletter-beta"]
+}
+
+locals {
+	beta_bounces = sum([for s in data.sendgrid_stats.newsletter.stats : s.bounces if s.name == "newsletter-beta"])
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// maxStatsCategories is the maximum number of categories the stats can be broken down by.
+const maxStatsCategories = 10
+
+// statsBreakdownFilters are the attributes filtering the stats broken down, by breakdown.
+var statsBreakdownFilters = map[string]string{
+	sendgrid.StatsByCategory:        "categories",
+	sendgrid.StatsByMailboxProvider: "mailbox_providers",
+	sendgrid.StatsByBrowser:         "browsers",
+}
+
+func dataSourceSendgridStats() *schema.Resource {
+	dateFormat := validation.StringMatch(regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`), "expected a YYYY-MM-DD date")
+
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridStatsRead,
+
+		Schema: map[string]*schema.Schema{
+			"start_date": {
+				Type:         schema.TypeString,
+				Description:  "The first date (YYYY-MM-DD) of the stats.",
+				Required:     true,
+				ValidateFunc: dateFormat,
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Description:  "The last date (YYYY-MM-DD) of the stats, today if not set.",
+				Optional:     true,
+				ValidateFunc: dateFormat,
+			},
+			"aggregated_by": {
+				Type:         schema.TypeString,
+				Description:  "The period the stats are aggregated by: day (default), week or month.",
+				Optional:     true,
+				Default:      "day",
+				ValidateFunc: validation.StringInSlice([]string{"day", "week", "month"}, false),
+			},
+			"subuser": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username, to retrieve its stats instead of the account ones.",
+				Optional:    true,
+			},
+			"breakdown": {
+				Type:        schema.TypeString,
+				Description: "The dimension the stats are broken down by: category, mailbox_provider or browser.",
+				Optional:    true,
+				Computed:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sendgrid.StatsByCategory, sendgrid.StatsByMailboxProvider, sendgrid.StatsByBrowser,
+				}, false),
+			},
+			"categories": {
+				Type:          schema.TypeList,
+				Description:   "The categories to break the stats down by, up to 10.",
+				Optional:      true,
+				MaxItems:      maxStatsCategories,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"mailbox_providers", "browsers"},
+			},
+			"mailbox_providers": {
+				Type:          schema.TypeList,
+				Description:   "The mailbox providers to break the stats down by, e.g. Gmail.",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"categories", "browsers"},
+			},
+			"browsers": {
+				Type:          schema.TypeList,
+				Description:   "The browsers to break the stats down by, e.g. Chrome.",
+				Optional:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"categories", "mailbox_providers"},
+			},
+			"stats": {
+				Type:        schema.TypeList,
+				Description: "The stats of each period, ordered by date, then by name when they're broken down.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"date": {
+							Type:        schema.TypeString,
+							Description: "The first date of the period.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The category, mailbox provider or browser of the stats, when they're broken down.",
+							Computed:    true,
+						},
+						"requests": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails requested to be sent.",
+							Computed:    true,
+						},
+						"processed": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails processed by Sendgrid.",
+							Computed:    true,
+						},
+						"delivered": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails delivered.",
+							Computed:    true,
+						},
+						"deferred": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails temporarily rejected by the receiving server.",
+							Computed:    true,
+						},
+						"bounces": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails which bounced.",
+							Computed:    true,
+						},
+						"blocks": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails blocked by the receiving server.",
+							Computed:    true,
+						},
+						"invalid_emails": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails sent to invalid addresses.",
+							Computed:    true,
+						},
+						"opens": {
+							Type:        schema.TypeInt,
+							Description: "The number of times the emails were opened.",
+							Computed:    true,
+						},
+						"unique_opens": {
+							Type:        schema.TypeInt,
+							Description: "The number of recipients who opened the emails.",
+							Computed:    true,
+						},
+						"clicks": {
+							Type:        schema.TypeInt,
+							Description: "The number of clicks on the links of the emails.",
+							Computed:    true,
+						},
+						"unique_clicks": {
+							Type:        schema.TypeInt,
+							Description: "The number of recipients who clicked on a link of the emails.",
+							Computed:    true,
+						},
+						"spam_reports": {
+							Type:        schema.TypeInt,
+							Description: "The number of emails reported as spam.",
+							Computed:    true,
+						},
+						"unsubscribes": {
+							Type:        schema.TypeInt,
+							Description: "The number of recipients who unsubscribed.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func flattenStatsMetrics(s sendgrid.Stats) map[string]interface{} {
+	return map[string]interface{}{
+		"date":           s.Date,
+		"name":           s.Name,
+		"requests":       s.Metrics.Requests,
+		"processed":      s.Metrics.Processed,
+		"delivered":      s.Metrics.Delivered,
+		"deferred":       s.Metrics.Deferred,
+		"bounces":        s.Metrics.Bounces,
+		"blocks":         s.Metrics.Blocks,
+		"invalid_emails": s.Metrics.InvalidEmails,
+		"opens":          s.Metrics.Opens,
+		"unique_opens":   s.Metrics.UniqueOpens,
+		"clicks":         s.Metrics.Clicks,
+		"unique_clicks":  s.Metrics.UniqueClicks,
+		"spam_reports":   s.Metrics.SpamReports,
+		"unsubscribes":   s.Metrics.Unsubscribes,
+	}
+}
+
+// statsBreakdown returns the dimension the stats are broken down by, if any, and the names filtering them:
+// the filter set implies the breakdown.
+func statsBreakdown(d *schema.ResourceData) (string, []string, error) {
+	breakdown := d.Get("breakdown").(string)
+
+	for b, key := range statsBreakdownFilters {
+		filter := d.Get(key).([]interface{})
+		if len(filter) == 0 {
+			continue
+		}
+
+		if breakdown != "" && breakdown != b {
+			return "", nil, statsBreakdownMismatch(breakdown, key)
+		}
+
+		names := make([]string, 0, len(filter))
+		for _, name := range filter {
+			names = append(names, name.(string))
+		}
+
+		return b, names, nil
+	}
+
+	return breakdown, nil, nil
+}
+
+func dataSourceSendgridStatsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	subUser := d.Get("subuser").(string)
+	c := m.(*sendgrid.Client).WithContext(ctx).WithOnBehalfOf(subUser)
+
+	startDate := d.Get("start_date").(string)
+	endDate := d.Get("end_date").(string)
+	aggregatedBy := d.Get("aggregated_by").(string)
+
+	breakdown, names, err := statsBreakdown(d)
+	if err != nil {
+		return errorToDiags("invalid stats breakdown", err)
+	}
+
+	var (
+		stats      []sendgrid.Stats
+		requestErr sendgrid.RequestError
+	)
+
+	if breakdown == "" {
+		stats, requestErr = c.ListStats(startDate, endDate, aggregatedBy)
+	} else {
+		stats, requestErr = c.ListStatsBreakdown(breakdown, names, startDate, endDate, aggregatedBy)
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing stats", requestErr)}
+	}
+
+	flattened := make([]interface{}, 0, len(stats))
+	for _, s := range stats {
+		flattened = append(flattened, flattenStatsMetrics(s))
+	}
+
+	d.SetId(strings.Join([]string{subUser, startDate, endDate, aggregatedBy, breakdown, strings.Join(names, ",")}, "/"))
+	//nolint:errcheck
+	d.Set("breakdown", breakdown)
+	//nolint:errcheck
+	d.Set("stats", flattened)
+
+	return nil
+}
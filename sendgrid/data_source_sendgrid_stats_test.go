@@ -0,0 +1,86 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccDataSourceSendgridStatsBasic(t *testing.T) {
+	endDate := time.Now().UTC()
+	startDate := endDate.AddDate(0, 0, -6)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "sendgrid_stats" "last_week" {
+					start_date = %q
+					end_date   = %q
+				}
+				`, startDate.Format("2006-01-02"), endDate.Format("2006-01-02")),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_stats.last_week", "stats.#", "7"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSendgridStatsBreakdownImpliedByFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/mailbox_providers/stats" || r.URL.Query().Get("mailbox_providers") != "Gmail" {
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+
+		fmt.Fprint(w, `[{"date": "2021-06-01", "stats": [
+			{"type": "mailbox_provider", "name": "Gmail", "metrics": {"delivered": 42, "bounces": 1}}
+		]}]`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().DataSourcesMap["sendgrid_stats"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"start_date":        "2021-06-01",
+		"mailbox_providers": []interface{}{"Gmail"},
+	})
+
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed reading stats: %v", diags)
+	}
+
+	if breakdown := d.Get("breakdown").(string); breakdown != sendgrid.StatsByMailboxProvider {
+		t.Errorf("expected the stats broken down by mailbox provider, got: %q", breakdown)
+	}
+
+	if d.Get("stats.0.name").(string) != "Gmail" || d.Get("stats.0.delivered").(int) != 42 {
+		t.Errorf("unexpected stats: %v", d.Get("stats"))
+	}
+}
+
+func TestDataSourceSendgridStatsBreakdownMismatch(t *testing.T) {
+	r := provider.Provider().DataSourcesMap["sendgrid_stats"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"start_date": "2021-06-01",
+		"breakdown":  sendgrid.StatsByBrowser,
+		"categories": []interface{}{"newsletter"},
+	})
+
+	c := sendgrid.NewClient("key", "http://localhost", "")
+
+	if diags := r.ReadContext(context.Background(), d, c); !diags.HasError() {
+		t.Fatal("expected the categories to conflict with the browser breakdown")
+	}
+}
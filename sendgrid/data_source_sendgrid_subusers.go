@@ -0,0 +1,111 @@
+/*
+Use this data source to list the subusers of the account, e.g. to audit them or to iterate over them
+without importing each of them.
+Example Usage
+```hcl
+data "sendgrid_subusers" "marketing" {
+	username_prefix = "marketing-"
+}
+
+resource "sendgrid_mail_settings_footer" "marketing" {
+	for_each = toset(data.sendgrid_subusers.marketing.usernames)
+
+	sub_user_on_behalf_of = each.value
+	enabled               = true
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceSendgridSubusers() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridSubusersRead,
+
+		Schema: map[string]*schema.Schema{
+			"username_prefix": {
+				Type:        schema.TypeString,
+				Description: "Only list the subusers whose username starts with the prefix.",
+				Optional:    true,
+			},
+			"usernames": {
+				Type:        schema.TypeList,
+				Description: "The usernames of the subusers matching the filter.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"subusers": {
+				Type:        schema.TypeList,
+				Description: "The details of the subusers matching the filter.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the subuser.",
+							Computed:    true,
+						},
+						"username": {
+							Type:        schema.TypeString,
+							Description: "The username of the subuser.",
+							Computed:    true,
+						},
+						"email": {
+							Type:        schema.TypeString,
+							Description: "The email of the subuser.",
+							Computed:    true,
+						},
+						"disabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the subuser is disabled.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSendgridSubusersRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	prefix := d.Get("username_prefix").(string)
+
+	subUsers, requestErr := c.ListSubusers()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing subusers", requestErr)}
+	}
+
+	usernames := make([]string, 0, len(subUsers))
+	details := make([]interface{}, 0, len(subUsers))
+
+	for _, subUser := range subUsers {
+		if !strings.HasPrefix(subUser.UserName, prefix) {
+			continue
+		}
+
+		usernames = append(usernames, subUser.UserName)
+		details = append(details, map[string]interface{}{
+			"id":       subUser.ID,
+			"username": subUser.UserName,
+			"email":    subUser.Email,
+			"disabled": subUser.Disabled,
+		})
+	}
+
+	d.SetId("subusers/" + prefix)
+	//nolint:errcheck
+	d.Set("usernames", usernames)
+	//nolint:errcheck
+	d.Set("subusers", details)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridSubusersBasic(t *testing.T) {
+	prefix := "terraform-subusers-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_subuser" "subuser" {
+					username = "%s-1"
+					password = "Passw0rd!%s"
+					email    = "%s@example.org"
+					ips      = ["127.0.0.1"]
+				}
+
+				data "sendgrid_subusers" "filtered" {
+					username_prefix = %q
+					depends_on      = [sendgrid_subuser.subuser]
+				}
+				`, prefix, acctest.RandString(10), prefix, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.filtered", "usernames.#", "1"),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.filtered", "subusers.0.username", prefix+"-1"),
+					resource.TestCheckResourceAttr(
+						"data.sendgrid_subusers.filtered", "subusers.0.email", prefix+"@example.org",
+					),
+					resource.TestCheckResourceAttr("data.sendgrid_subusers.filtered", "subusers.0.disabled", "false"),
+				),
+			},
+		},
+	})
+}
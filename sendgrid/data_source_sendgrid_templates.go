@@ -0,0 +1,114 @@
+/*
+Use this data source to list the transactional templates, optionally filtered by generation and name prefix,
+e.g. to find the ID of a template by its name instead of hardcoding it.
+All the pages of templates are retrieved, the templates are sorted by name.
+Example Usage
+```hcl
+data "sendgrid_templates" "welcome" {
+	generation  = "dynamic"
+	name_prefix = "welcome-"
+}
+
+output "welcome_template_ids" {
+	value = { for template in data.sendgrid_templates.welcome.templates : template.name => template.id }
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridTemplates() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridTemplatesRead,
+
+		Schema: map[string]*schema.Schema{
+			"generation": {
+				Type:         schema.TypeString,
+				Description:  "The generation of the templates to list, legacy or dynamic, all of them if not set.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"legacy", "dynamic"}, false),
+			},
+			"name_prefix": {
+				Type:        schema.TypeString,
+				Description: "The prefix of the names of the templates to list.",
+				Optional:    true,
+			},
+			"templates": {
+				Type:        schema.TypeList,
+				Description: "The templates, sorted by name.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "The ID of the template.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name of the template.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceSendgridTemplatesRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	generation := d.Get("generation").(string)
+	namePrefix := d.Get("name_prefix").(string)
+
+	var generations []string
+	if generation != "" {
+		generations = []string{generation}
+	}
+
+	templates, requestErr := c.ListTemplates(generations...)
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing templates", requestErr)}
+	}
+
+	var matches []sendgrid.Template
+
+	for _, template := range templates {
+		if strings.HasPrefix(template.Name, namePrefix) {
+			matches = append(matches, template)
+		}
+	}
+
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].Name != matches[j].Name {
+			return matches[i].Name < matches[j].Name
+		}
+
+		return matches[i].ID < matches[j].ID
+	})
+
+	flattened := make([]interface{}, 0, len(matches))
+	for _, template := range matches {
+		flattened = append(flattened, map[string]interface{}{
+			"id":   template.ID,
+			"name": template.Name,
+		})
+	}
+
+	d.SetId("templates")
+	//nolint:errcheck
+	d.Set("templates", flattened)
+
+	return nil
+}
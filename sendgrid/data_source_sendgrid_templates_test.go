@@ -0,0 +1,47 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccDataSourceSendgridTemplatesNamePrefix(t *testing.T) {
+	prefix := "terraform-" + acctest.RandString(10) + "-"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_template" "second" {
+					name       = "%[1]ssecond"
+					generation = "dynamic"
+				}
+
+				resource "sendgrid_template" "first" {
+					name       = "%[1]sfirst"
+					generation = "dynamic"
+				}
+
+				data "sendgrid_templates" "templates" {
+					generation  = "dynamic"
+					name_prefix = %[1]q
+
+					depends_on = [sendgrid_template.first, sendgrid_template.second]
+				}
+				`, prefix),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sendgrid_templates.templates", "templates.#", "2"),
+					resource.TestCheckResourceAttr("data.sendgrid_templates.templates", "templates.0.name", prefix+"first"),
+					resource.TestCheckResourceAttrPair(
+						"data.sendgrid_templates.templates", "templates.0.id", "sendgrid_template.first", "id",
+					),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,165 @@
+/*
+Use this data source to read all the webhooks of the account, or of a subuser: the event webhook
+and the inbound parse webhooks, e.g. to audit where Sendgrid posts the events without managing the webhooks.
+Example Usage
+```hcl
+data "sendgrid_webhooks" "subuser" {
+	sub_user_on_behalf_of = "subUserName"
+}
+
+output "event_webhook_url" {
+	value = data.sendgrid_webhooks.subuser.event_webhook[0].url
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func dataSourceSendgridWebhooks() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceSendgridWebhooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Reads the webhooks of the subuser instead of the account.",
+				Optional:    true,
+			},
+			"parent_account": parentAccountSchema(false),
+			"event_webhook": {
+				Type:        schema.TypeList,
+				Description: "The event webhook.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Description: "Whether the events are posted to the URL.",
+							Computed:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "The URL the events are posted to.",
+							Computed:    true,
+						},
+						"events": {
+							Type:        schema.TypeSet,
+							Description: "The kinds of events posted, named as the attributes of sendgrid_event_webhook.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"oauth_client_id": {
+							Type:        schema.TypeString,
+							Description: "The OAuth client ID the events are posted with, if any.",
+							Computed:    true,
+						},
+						"oauth_token_url": {
+							Type:        schema.TypeString,
+							Description: "The URL the OAuth tokens are requested from, if any.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"parse_webhooks": {
+				Type:        schema.TypeList,
+				Description: "The inbound parse webhooks, one per hostname.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hostname": {
+							Type:        schema.TypeString,
+							Description: "The hostname receiving the emails.",
+							Computed:    true,
+						},
+						"url": {
+							Type:        schema.TypeString,
+							Description: "The URL the parsed emails are posted to.",
+							Computed:    true,
+						},
+						"spam_check": {
+							Type:        schema.TypeBool,
+							Description: "Whether the emails are checked for spam.",
+							Computed:    true,
+						},
+						"send_raw": {
+							Type:        schema.TypeBool,
+							Description: "Whether the raw emails are posted instead of the parsed ones.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// eventWebhookEvents returns the kinds of events the event webhook posts.
+func eventWebhookEvents(webhook *sendgrid.EventWebhook) []string {
+	var events []string
+
+	for event, enabled := range map[string]bool{
+		"group_resubscribe": webhook.GroupResubscribe,
+		"delivered":         webhook.Delivered,
+		"group_unsubscribe": webhook.GroupUnsubscribe,
+		"spam_report":       webhook.SpamReport,
+		"bounce":            webhook.Bounce,
+		"deferred":          webhook.Deferred,
+		"unsubscribe":       webhook.Unsubscribe,
+		"processed":         webhook.Processed,
+		"open":              webhook.Open,
+		"click":             webhook.Click,
+		"dropped":           webhook.Dropped,
+	} {
+		if enabled {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+func dataSourceSendgridWebhooksRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := eventWebhookClient(d, m).WithContext(ctx)
+
+	eventWebhook, requestErr := c.ReadEventWebhook()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading event webhook", requestErr)}
+	}
+
+	parseWebhooks, requestErr := c.ListParseWebhooks()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing parse webhooks", requestErr)}
+	}
+
+	parse := make([]interface{}, 0, len(parseWebhooks))
+	for _, webhook := range parseWebhooks {
+		parse = append(parse, map[string]interface{}{
+			"hostname":   webhook.Hostname,
+			"url":        webhook.URL,
+			"spam_check": webhook.SpamCheck,
+			"send_raw":   webhook.SendRaw,
+		})
+	}
+
+	d.SetId("webhooks/" + c.OnBehalfOf)
+	//nolint:errcheck
+	d.Set("event_webhook", []interface{}{map[string]interface{}{
+		"enabled":         eventWebhook.Enabled,
+		"url":             eventWebhook.URL,
+		"events":          eventWebhookEvents(eventWebhook),
+		"oauth_client_id": eventWebhook.OAuthClientID,
+		"oauth_token_url": eventWebhook.OAuthTokenURL,
+	}})
+	//nolint:errcheck
+	d.Set("parse_webhooks", parse)
+
+	return nil
+}
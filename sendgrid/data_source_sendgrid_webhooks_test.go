@@ -0,0 +1,87 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccDataSourceSendgridWebhooksBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "sendgrid_event_webhook" "webhook" {
+					url       = "https://example.org/events"
+					delivered = true
+					bounce    = true
+				}
+
+				data "sendgrid_webhooks" "webhooks" {
+					depends_on = [sendgrid_event_webhook.webhook]
+				}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"data.sendgrid_webhooks.webhooks", "event_webhook.0.url", "https://example.org/events",
+					),
+					resource.TestCheckResourceAttr("data.sendgrid_webhooks.webhooks", "event_webhook.0.events.#", "2"),
+					resource.TestCheckResourceAttrSet("data.sendgrid_webhooks.webhooks", "parse_webhooks.#"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceSendgridWebhooksOnBehalfOf(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("On-Behalf-Of") != "subuser" {
+			t.Errorf("expected the webhooks of the subuser to be read, got: %q", r.Header.Get("On-Behalf-Of"))
+		}
+
+		switch r.URL.Path {
+		case "/user/webhooks/event/settings":
+			fmt.Fprint(w, `{"enabled": true, "url": "https://example.org/events", "delivered": true, "open": true}`)
+		case "/user/webhooks/parse/settings":
+			fmt.Fprint(w, `{"result": [
+				{"hostname": "parse.example.org", "url": "https://example.org/parse", "spam_check": true}
+			]}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().DataSourcesMap["sendgrid_webhooks"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"sub_user_on_behalf_of": "subuser",
+	})
+
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed reading webhooks: %v", diags)
+	}
+
+	if d.Get("event_webhook.0.url").(string) != "https://example.org/events" {
+		t.Errorf("unexpected event webhook: %v", d.Get("event_webhook"))
+	}
+
+	if events := d.Get("event_webhook.0.events").(*schema.Set); events.Len() != 2 || !events.Contains("open") {
+		t.Errorf("unexpected events: %v", events.List())
+	}
+
+	if d.Get("parse_webhooks.#").(int) != 1 || d.Get("parse_webhooks.0.hostname").(string) != "parse.example.org" {
+		t.Errorf("unexpected parse webhooks: %v", d.Get("parse_webhooks"))
+	}
+}
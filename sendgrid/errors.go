@@ -3,6 +3,11 @@ package sendgrid
 import (
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
 )
 
 var (
@@ -13,10 +18,317 @@ var (
 	// doesn't have the good format.
 	ErrInvalidImportFormat = errors.New("invalid import. Supported import format: {{templateID}}/{{templateVersionID}}")
 
+	// ErrPlainContentGenerated error displayed when the plain content of a template version is read from a file
+	// while Sendgrid generates it from the HTML content.
+	ErrPlainContentGenerated = errors.New("plain_content_file can only be set when generate_plain_content is false")
+
+	// ErrTemplateVersionSubjectRequired error displayed when a template version has no subject,
+	// nor a design to copy it from.
+	ErrTemplateVersionSubjectRequired = errors.New("subject is required unless from_design_id is set")
+
+	// ErrVerifiedSenderToAdoptNotFound error displayed when the existing verified sender to adopt can't be found.
+	ErrVerifiedSenderToAdoptNotFound = errors.New("the existing verified sender to adopt wasn't found")
+
+	// ErrMalformedHTML warning displayed when an HTML content doesn't look well-formed.
+	ErrMalformedHTML = errors.New("possibly malformed HTML")
+
+	// ErrInvalidSuppressionImportFormat error displayed when the string passed to import a suppression
+	// doesn't have the good format.
+	ErrInvalidSuppressionImportFormat = errors.New("invalid import. Supported import format: {{kind}}/{{email}}")
+
+	// ErrSuppressionNotCreatable error displayed when trying to add an address to a suppression
+	// list which is only filled by Sendgrid, and the address isn't already in it.
+	ErrSuppressionNotCreatable = errors.New(
+		"only global suppressions can be added, the other kinds can only be managed once Sendgrid filled them",
+	)
+
+	// ErrInvalidSuppressionGroupMemberImportFormat error displayed when the string passed to import
+	// an address of a suppression group doesn't have the good format.
+	ErrInvalidSuppressionGroupMemberImportFormat = errors.New(
+		"invalid import. Supported import format: {{groupID}}/{{email}}",
+	)
+
+	// ErrInvalidIPPoolMembershipImportFormat error displayed when the string passed to import
+	// the membership of an IP address in an IP pool doesn't have the good format.
+	ErrInvalidIPPoolMembershipImportFormat = errors.New(
+		"invalid import. Supported import format: {{poolName}}/{{ip}}",
+	)
+
+	// ErrInvalidEventWebhookImportFormat error displayed when the string passed to import
+	// an event webhook doesn't have the good format.
+	ErrInvalidEventWebhookImportFormat = errors.New(
+		"invalid import. Supported import formats: event_webhook, {{username}}/event_webhook",
+	)
+
+	// ErrSuppressionGroupMemberRejected error displayed when Sendgrid didn't add an address to a suppression group,
+	// e.g. because it's invalid.
+	ErrSuppressionGroupMemberRejected = errors.New("the address wasn't added to the suppression group")
+
 	// ErrSubUserNotFound error displayed when the subUser can not be found.
 	ErrSubUserNotFound = errors.New("subUser wasn't found")
+
+	// ErrSubUserConflict error displayed when an existing subUser can't be adopted
+	// because it doesn't match the configuration.
+	ErrSubUserConflict = errors.New("subUser already exists with a different configuration")
+
+	// ErrSubUserInvalidIPs error displayed when IP addresses can't be assigned to a subuser,
+	// as they aren't IP addresses of the account, or of the region of the subuser.
+	ErrSubUserInvalidIPs = errors.New("IP addresses can't be assigned to the subUser")
+
+	// ErrSubUserHasDependents error displayed when a subuser can't be deleted
+	// because other resources still depend on it.
+	ErrSubUserHasDependents = errors.New("subUser still has dependent resources")
+
+	// ErrInvalidDomainAssociationImportFormat error displayed when the string passed to import
+	// an authenticated domain association doesn't have the good format.
+	ErrInvalidDomainAssociationImportFormat = errors.New(
+		"invalid import. Supported import format: {{domainID}}/{{username}}",
+	)
+
+	// ErrDomainAuthenticationNotFound error displayed when the authenticated domain can not be found.
+	ErrDomainAuthenticationNotFound = errors.New("domain authentication wasn't found")
+
+	// ErrDomainAuthenticationAmbiguous error displayed when several authentications match a domain.
+	ErrDomainAuthenticationAmbiguous = errors.New("several domain authentications match, set the subdomain")
+
+	// ErrDomainAuthenticationNotValidated error displayed when the DNS records of an authenticated domain aren't valid.
+	ErrDomainAuthenticationNotValidated = errors.New("domain authentication isn't validated")
+
+	// ErrReverseDNSNotValidated error displayed when the A record of a reverse DNS isn't valid.
+	ErrReverseDNSNotValidated = errors.New("reverse DNS isn't validated")
+
+	// ErrAutomationNotFound error displayed when no marketing automation has the given name.
+	ErrAutomationNotFound = errors.New("automation wasn't found")
+
+	// ErrAutomationAmbiguous error displayed when several marketing automations have the given name.
+	ErrAutomationAmbiguous = errors.New("several automations have the name")
+
+	// ErrAPIKeyAmbiguous error displayed when several API keys have the name of the API key to adopt.
+	ErrAPIKeyAmbiguous = errors.New("several API keys have the name, it can't be adopted")
+
+	// ErrAPIKeyScopesNotGranted error displayed when an API key is given scopes the API key of the provider lacks.
+	ErrAPIKeyScopesNotGranted = errors.New("the API key of the provider can't grant scopes it doesn't have")
+
+	// ErrContactsExportFailed error displayed when Sendgrid failed to export the contacts.
+	ErrContactsExportFailed = errors.New("contacts export failed")
+
+	// ErrContactsExportPending error displayed when a contacts export isn't ready yet.
+	ErrContactsExportPending = errors.New("contacts export isn't ready yet")
+
+	// ErrContactsImportFailed error displayed when Sendgrid failed to upsert some of the contacts of a batch.
+	ErrContactsImportFailed = errors.New("contacts import failed")
+
+	// ErrContactsImportPending error displayed when the job upserting a batch of contacts isn't done yet.
+	ErrContactsImportPending = errors.New("contacts import isn't done yet")
+
+	// ErrValidCertResetNotAcknowledged error displayed when destroying the enforced TLS setting would stop
+	// requiring valid certificates, without the operator acknowledging it.
+	ErrValidCertResetNotAcknowledged = errors.New(
+		"resetting require_valid_cert on destroy must be acknowledged with acknowledge_valid_cert_reset, " +
+			"or avoided with reset_on_destroy = false",
+	)
+
+	// ErrSegmentDryRunFailed error displayed when Sendgrid refuses the query of a segment searched at plan.
+	ErrSegmentDryRunFailed = errors.New("the dry run of query_dsl failed")
+
+	// ErrInvalidParentListID error displayed when the ID of the parent list of a segment can't be quoted in a query.
+	ErrInvalidParentListID = errors.New("parent_list_id can't contain quotes nor backslashes")
+
+	// ErrContactCustomFieldNotFound error displayed when a custom field of a contact isn't defined.
+	ErrContactCustomFieldNotFound = errors.New("custom field isn't defined")
+
+	// ErrContactPending error displayed when the upsert of a contact isn't done yet.
+	ErrContactPending = errors.New("contact isn't upserted yet")
+
+	// ErrMarketingListDeletionPending error displayed when a marketing list and its contacts aren't deleted yet.
+	ErrMarketingListDeletionPending = errors.New("marketing list isn't deleted yet")
+
+	// ErrNotReadableYet error displayed when a resource Sendgrid just created still can't be read.
+	ErrNotReadableYet = errors.New("the created resource can't be read yet")
+
+	// ErrAccessLockout error displayed when the allowed IP addresses don't include the one the provider calls
+	// Sendgrid from, so that applying them would lock it out.
+	ErrAccessLockout = errors.New("the allowed IP addresses don't include the IP address of the provider")
+
+	// ErrSingleSendAlreadySent error displayed when trying to modify a single send which was already sent.
+	ErrSingleSendAlreadySent = errors.New("the single send was already sent and can't be modified anymore")
+
+	// ErrInvalidSendAt error displayed when the date a single send is scheduled at isn't an RFC3339 date
+	// with an explicit offset, nor "now".
+	ErrInvalidSendAt = errors.New("invalid send_at, expected an RFC3339 date with an offset, e.g. Z or +02:00, or now")
+
+	// ErrSSOIntegrationNotCompleted error displayed when an SSO integration still isn't complete
+	// once its certificate is added.
+	ErrSSOIntegrationNotCompleted = errors.New("SSO integration isn't complete")
+
+	// ErrStatsBreakdownMismatch error displayed when the stats are filtered by names of another dimension
+	// than the one they're broken down by.
+	ErrStatsBreakdownMismatch = errors.New("the stats are broken down by another dimension than their filter")
 )
 
 func subUserNotFound(name string) error {
 	return fmt.Errorf("%w: %s", ErrSubUserNotFound, name)
 }
+
+func domainAuthenticationNotFound(domain string) error {
+	return fmt.Errorf("%w: %s", ErrDomainAuthenticationNotFound, domain)
+}
+
+func domainAuthenticationAmbiguous(domain string) error {
+	return fmt.Errorf("%w: %s", ErrDomainAuthenticationAmbiguous, domain)
+}
+
+func domainAuthenticationNotValidated(id string, failures []string) error {
+	return fmt.Errorf("%w: %s: %s", ErrDomainAuthenticationNotValidated, id, strings.Join(failures, "; "))
+}
+
+func reverseDNSNotValidated(id, failure string) error {
+	return fmt.Errorf("%w: %s: %s", ErrReverseDNSNotValidated, id, failure)
+}
+
+func automationNotFound(name string) error {
+	return fmt.Errorf("%w: %s", ErrAutomationNotFound, name)
+}
+
+func automationAmbiguous(name string) error {
+	return fmt.Errorf("%w: %s", ErrAutomationAmbiguous, name)
+}
+
+func apiKeyAmbiguous(name string) error {
+	return fmt.Errorf("%w: %s", ErrAPIKeyAmbiguous, name)
+}
+
+func apiKeyScopesNotGranted(missing []string) error {
+	return fmt.Errorf(
+		"%w: %s, set skip_scopes_check if it's expected", ErrAPIKeyScopesNotGranted, strings.Join(missing, ", "),
+	)
+}
+
+func verifiedSenderToAdoptNotFound(fromEmail string) error {
+	return fmt.Errorf("%w: %s", ErrVerifiedSenderToAdoptNotFound, fromEmail)
+}
+
+func malformedHTML(key string, err error) error {
+	return fmt.Errorf("%w in %s: %s", ErrMalformedHTML, key, err.Error())
+}
+
+func invalidSendAt(key, value, reason string) error {
+	return fmt.Errorf("%w: %s is %q, %s", ErrInvalidSendAt, key, value, reason)
+}
+
+func invalidParentListID(parentListID string) error {
+	return fmt.Errorf("%w: %q", ErrInvalidParentListID, parentListID)
+}
+
+func segmentDryRunFailed(err error) error {
+	return fmt.Errorf("%w: %s", ErrSegmentDryRunFailed, err)
+}
+
+func contactsExportFailed(id, message string) error {
+	return fmt.Errorf("%w: %s: %s", ErrContactsExportFailed, id, message)
+}
+
+func contactsImportFailed(id string, job *sendgrid.ContactsImport) error {
+	return fmt.Errorf("%w: %s: %s, %d of %d contacts errored, see %s", ErrContactsImportFailed, id,
+		job.Status, job.Results.ErroredCount, job.Results.RequestedCount, job.Results.ErrorsURL)
+}
+
+func contactCustomFieldNotFound(name string) error {
+	return fmt.Errorf("%w: %s, create it before setting it on a contact", ErrContactCustomFieldNotFound, name)
+}
+
+func accessLockout(ip string) error {
+	return fmt.Errorf("%w: %s, set skip_lockout_check if it's expected", ErrAccessLockout, ip)
+}
+
+func subUserHasDomainAuthentication(name, domain string) error {
+	return fmt.Errorf(
+		"%w: %s is associated with the authenticated domain %s, remove the association or set force_destroy",
+		ErrSubUserHasDependents, name, domain,
+	)
+}
+
+func subUserInvalidIPs(name, region string, unknown, otherRegion []string) error {
+	var reasons []string
+
+	if len(unknown) > 0 {
+		reasons = append(reasons, "not IP addresses of the account: "+strings.Join(unknown, ", "))
+	}
+
+	if len(otherRegion) > 0 {
+		reasons = append(reasons, "not in the region "+region+": "+strings.Join(otherRegion, ", "))
+	}
+
+	return fmt.Errorf("%w: %s: %s", ErrSubUserInvalidIPs, name, strings.Join(reasons, "; "))
+}
+
+func subUserIPsRequired(name string) error {
+	return fmt.Errorf("%w: %s, the account has dedicated IP addresses, set ips", sendgrid.ErrIPRequired, name)
+}
+
+func subUserConflict(name, email string) error {
+	return fmt.Errorf("%w: %s has the email %s", ErrSubUserConflict, name, email)
+}
+
+// requestErrorToDiag converts a RequestError into a diagnostic: the summary exposes the
+// HTTP status code returned by Sendgrid, the detail contains the error and the response body.
+func requestErrorToDiag(summary string, requestErr sendgrid.RequestError) diag.Diagnostic {
+	return diag.Diagnostic{
+		Severity: diag.Error,
+		Summary: fmt.Sprintf(
+			"%s (status: %d %s)", summary, requestErr.StatusCode, http.StatusText(requestErr.StatusCode),
+		),
+		Detail: requestErrorDetail(requestErr),
+	}
+}
+
+// requestErrorDetail lists the errors returned by Sendgrid one per line, e.g. "- field: message",
+// under the error they caused, instead of the raw response. Without them, it's the message of the error.
+func requestErrorDetail(requestErr sendgrid.RequestError) string {
+	if len(requestErr.FieldErrors) == 0 {
+		return requestErr.Error()
+	}
+
+	lines := make([]string, 0, len(requestErr.FieldErrors)+1)
+
+	// the error wraps its cause, and the response which is replaced by the field errors.
+	if cause := errors.Unwrap(requestErr.Err); cause != nil {
+		lines = append(lines, cause.Error()+":")
+	}
+
+	for _, fieldErr := range requestErr.FieldErrors {
+		lines = append(lines, "- "+fieldErr.String())
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// errorToDiags converts an error into diagnostics. If the error wraps a RequestError,
+// the HTTP status code is kept, otherwise the error is only split between summary and detail.
+func errorToDiags(summary string, err error) diag.Diagnostics {
+	var requestErr sendgrid.RequestError
+	if errors.As(err, &requestErr) {
+		return diag.Diagnostics{requestErrorToDiag(summary, requestErr)}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  summary,
+		Detail:   err.Error(),
+	}}
+}
+
+// isNotFound tells whether the request failed because the resource doesn't exist, e.g. it was deleted
+// outside of Terraform: its Read then removes it from the state, so that it's created again, instead of failing.
+// The error can be a RequestError, or wrap one.
+func isNotFound(err error) bool {
+	return errors.Is(err, sendgrid.ErrNotFound)
+}
+
+func ssoIntegrationNotCompleted(id string) error {
+	return fmt.Errorf("%w: %s, check its URLs and its certificate", ErrSSOIntegrationNotCompleted, id)
+}
+
+func statsBreakdownMismatch(breakdown, filter string) error {
+	return fmt.Errorf("%w: broken down by %s, filtered by %s", ErrStatsBreakdownMismatch, breakdown, filter)
+}
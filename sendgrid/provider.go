@@ -1,26 +1,116 @@
 /*
 Resources List
 
+Data Sources
+  sendgrid_account
+  sendgrid_api_key
+  sendgrid_automation
+  sendgrid_contacts_export
+  sendgrid_domain_authentication
+  sendgrid_ips
+  sendgrid_scopes
+  sendgrid_stats
+  sendgrid_subusers
+  sendgrid_templates
+  sendgrid_webhooks
+
+Alert Resource
+  sendgrid_alert
+
 API key Resource
   sendgrid_api_key
 
+Domain Authentication Resources
+  sendgrid_authenticated_domain_association
+  sendgrid_domain_authentication
+  sendgrid_domain_authentication_validation
+
+Webhook Resources
+  sendgrid_event_webhook
+  sendgrid_event_webhook_signing
+  sendgrid_event_webhook_test_event
+  sendgrid_webhook_parse
+
+IP Resources
+  sendgrid_ip_access_management
+  sendgrid_ip_pool
+  sendgrid_ip_pool_membership
+  sendgrid_ip_warmup
+  sendgrid_reverse_dns
+  sendgrid_reverse_dns_validation
+
+Link Branding Resources
+  sendgrid_link_branding
+
+Mail Send Resources
+  sendgrid_batch_id
+  sendgrid_cancel_scheduled_send
+  sendgrid_test_send
+
+Mail Settings Resources
+  sendgrid_mail_settings_address_whitelist
+  sendgrid_mail_settings_bcc
+  sendgrid_mail_settings_bounce_purge
+  sendgrid_mail_settings_footer
+  sendgrid_mail_settings_forward_spam
+  sendgrid_mail_settings_spam_check
+
+Enforced TLS Resource
+  sendgrid_enforced_tls
+
+Marketing Resources
+  sendgrid_contact
+  sendgrid_design
+  sendgrid_marketing_contacts
+  sendgrid_marketing_list
+  sendgrid_segment
+  sendgrid_sender_identity
+  sendgrid_single_send
+
 Subuser resource
   sendgrid_subuser
+  sendgrid_subuser_monitor
+
+Suppression Resources
+  sendgrid_suppression
+  sendgrid_suppression_group_import
+  sendgrid_suppression_group_member
+
+Teammate Resources
+  sendgrid_sso_certificate
+  sendgrid_sso_integration
+  sendgrid_sso_teammate
+  sendgrid_teammate
+  sendgrid_teammate_subuser_access
 
 Template Resources
   sendgrid_template
   sendgrid_template_version
+
+Tracking Settings Resources
+  sendgrid_tracking_settings_click
+
+Verified Sender Resources
+  sendgrid_verified_sender
 */
 package sendgrid
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
 )
 
+// validateAPIKeyTimeout is the time allowed to validate the API key, retries included.
+const validateAPIKeyTimeout = time.Minute
+
 // Provider terraform.ResourceProvider.
 func Provider() *schema.Provider {
 	return &schema.Provider{
@@ -32,29 +122,134 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_API_KEY", nil),
 			},
 			"host": {
-				Type:        schema.TypeString,
+				Type: schema.TypeString,
+				Description: "The base URL of the Sendgrid API, " + sendgrid.GlobalHost + " by default. " +
+					"The API keys of the EU region only work with " + sendgrid.EUHost + ".",
 				Optional:    true,
 				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_HOST", nil),
 			},
+			"default_on_behalf_of": {
+				Type: schema.TypeString,
+				Description: "The subuser the calls are made on behalf of, unless a resource sets sub_user_on_behalf_of, " +
+					"or parent_account to make its calls as the parent account. " +
+					"The resources managing what only the parent account has, e.g. subusers, teammates or IPs, ignore it.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("SENDGRID_DEFAULT_ON_BEHALF_OF", nil),
+				ConflictsWith: []string{"subuser"},
+			},
 			"subuser": {
-				Type:        schema.TypeString,
+				Type:          schema.TypeString,
+				Description:   "The subuser the calls are made on behalf of, unless a resource sets sub_user_on_behalf_of.",
+				Optional:      true,
+				DefaultFunc:   schema.EnvDefaultFunc("SENDGRID_SUBUSER", nil),
+				Deprecated:    "use default_on_behalf_of instead",
+				ConflictsWith: []string{"default_on_behalf_of"},
+			},
+			"retry_base_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_RETRY_BASE_DELAY", sendgrid.DefaultRetryBaseDelay.String()),
+				ValidateFunc: validateDuration,
+			},
+			"retry_max_delay": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_RETRY_MAX_DELAY", sendgrid.DefaultRetryMaxDelay.String()),
+				ValidateFunc: validateDuration,
+			},
+			"max_retries": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_MAX_RETRIES", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"parallelism": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("SENDGRID_PARALLELISM", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"validate_api_key": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_SUBUSER", nil),
+				DefaultFunc: schema.EnvDefaultFunc("SENDGRID_VALIDATE_API_KEY", true),
+			},
+			"required_scopes": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
 		},
 
+		DataSourcesMap: map[string]*schema.Resource{
+			"sendgrid_account":               dataSourceSendgridAccount(),
+			"sendgrid_api_key":               dataSourceSendgridAPIKey(),
+			"sendgrid_automation":            dataSourceSendgridAutomation(),
+			"sendgrid_contacts_export":       dataSourceSendgridContactsExport(),
+			"sendgrid_domain_authentication": dataSourceSendgridDomainAuthentication(),
+			"sendgrid_ips":                   dataSourceSendgridIPs(),
+			"sendgrid_scopes":                dataSourceSendgridScopes(),
+			"sendgrid_stats":                 dataSourceSendgridStats(),
+			"sendgrid_subusers":              dataSourceSendgridSubusers(),
+			"sendgrid_templates":             dataSourceSendgridTemplates(),
+			"sendgrid_webhooks":              dataSourceSendgridWebhooks(),
+		},
+
 		ResourcesMap: map[string]*schema.Resource{
-			"sendgrid_api_key":          resourceSendgridAPIKey(),
-			"sendgrid_subuser":          resourceSendgridSubuser(),
-			"sendgrid_template":         resourceSendgridTemplate(),
-			"sendgrid_template_version": resourceSendgridTemplateVersion(),
+			"sendgrid_alert":   resourceSendgridAlert(),
+			"sendgrid_api_key": resourceSendgridAPIKey(),
+			"sendgrid_authenticated_domain_association": resourceSendgridAuthenticatedDomainAssociation(),
+			"sendgrid_batch_id":                         resourceSendgridBatchID(),
+			"sendgrid_cancel_scheduled_send":            resourceSendgridCancelScheduledSend(),
+			"sendgrid_contact":                          resourceSendgridContact(),
+			"sendgrid_design":                           resourceSendgridDesign(),
+			"sendgrid_domain_authentication":            resourceSendgridDomainAuthentication(),
+			"sendgrid_domain_authentication_validation": resourceSendgridDomainAuthenticationValidation(),
+			"sendgrid_enforced_tls":                     resourceSendgridEnforcedTLS(),
+			"sendgrid_event_webhook":                    resourceSendgridEventWebhook(),
+			"sendgrid_event_webhook_signing":            resourceSendgridEventWebhookSigning(),
+			"sendgrid_event_webhook_test_event":         resourceSendgridEventWebhookTestEvent(),
+			"sendgrid_ip_access_management":             resourceSendgridIPAccessManagement(),
+			"sendgrid_ip_pool":                          resourceSendgridIPPool(),
+			"sendgrid_ip_pool_membership":               resourceSendgridIPPoolMembership(),
+			"sendgrid_ip_warmup":                        resourceSendgridIPWarmup(),
+			"sendgrid_link_branding":                    resourceSendgridLinkBranding(),
+			"sendgrid_mail_settings_address_whitelist":  resourceSendgridMailSettingsAddressWhitelist(),
+			"sendgrid_mail_settings_bcc":                resourceSendgridMailSettingsBCC(),
+			"sendgrid_mail_settings_bounce_purge":       resourceSendgridMailSettingsBouncePurge(),
+			"sendgrid_mail_settings_footer":             resourceSendgridMailSettingsFooter(),
+			"sendgrid_mail_settings_forward_spam":       resourceSendgridMailSettingsForwardSpam(),
+			"sendgrid_mail_settings_spam_check":         resourceSendgridMailSettingsSpamCheck(),
+			"sendgrid_marketing_contacts":               resourceSendgridMarketingContacts(),
+			"sendgrid_marketing_list":                   resourceSendgridMarketingList(),
+			"sendgrid_reverse_dns":                      resourceSendgridReverseDNS(),
+			"sendgrid_reverse_dns_validation":           resourceSendgridReverseDNSValidation(),
+			"sendgrid_segment":                          resourceSendgridSegment(),
+			"sendgrid_sender_identity":                  resourceSendgridSenderIdentity(),
+			"sendgrid_single_send":                      resourceSendgridSingleSend(),
+			"sendgrid_sso_certificate":                  resourceSendgridSSOCertificate(),
+			"sendgrid_sso_integration":                  resourceSendgridSSOIntegration(),
+			"sendgrid_sso_teammate":                     resourceSendgridSSOTeammate(),
+			"sendgrid_subuser":                          resourceSendgridSubuser(),
+			"sendgrid_subuser_monitor":                  resourceSendgridSubuserMonitor(),
+			"sendgrid_suppression":                      resourceSendgridSuppression(),
+			"sendgrid_suppression_group_import":         resourceSendgridSuppressionGroupImport(),
+			"sendgrid_suppression_group_member":         resourceSendgridSuppressionGroupMember(),
+			"sendgrid_teammate":                         resourceSendgridTeammate(),
+			"sendgrid_teammate_subuser_access":          resourceSendgridTeammateSubuserAccess(),
+			"sendgrid_template":                         resourceSendgridTemplate(),
+			"sendgrid_template_version":                 resourceSendgridTemplateVersion(),
+			"sendgrid_test_send":                        resourceSendgridTestSend(),
+			"sendgrid_tracking_settings_click":          resourceSendgridTrackingSettingsClick(),
+			"sendgrid_verified_sender":                  resourceSendgridVerifiedSender(),
+			"sendgrid_webhook_parse":                    resourceSendgridWebhookParse(),
 		},
 
 		ConfigureContextFunc: providerConfigure,
 	}
 }
 
-func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+func providerConfigure(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	apiKey, ok := d.Get("api_key").(string)
@@ -71,7 +266,171 @@ func providerConfigure(_ context.Context, d *schema.ResourceData) (interface{},
 	}
 
 	host := d.Get("host").(string)
-	subuser := d.Get("subuser").(string)
+	onBehalfOf := d.Get("default_on_behalf_of").(string)
+	if onBehalfOf == "" {
+		onBehalfOf = d.Get("subuser").(string)
+	}
+
+	c := sendgrid.NewClient(apiKey, host, onBehalfOf)
+
+	// the durations were already checked by validateDuration.
+	c.Backoff.BaseDelay, _ = time.ParseDuration(d.Get("retry_base_delay").(string))
+	c.Backoff.MaxDelay, _ = time.ParseDuration(d.Get("retry_max_delay").(string))
+	c.Backoff.MaxRetries = d.Get("max_retries").(int)
+	c.SetParallelism(d.Get("parallelism").(int))
+
+	// enabled by default, the validation also checks that the host can be reached,
+	// serves the Sendgrid API, and is the host of the region of the API key. Only the validation
+	// is bound to the context of the configuration, the client of the provider outlives it.
+	if d.Get("validate_api_key").(bool) {
+		diags = append(diags, validateAPIKey(ctx, c.WithContext(ctx), d.Get("required_scopes").(*schema.Set))...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return c, diags
+}
+
+// validateAPIKey checks that the API key works, and has the required scopes,
+// so that an invalid key fails with a single error instead of one per resource.
+// The scopes are listed once per provider run, sendgrid_scopes reuses them.
+// When Sendgrid can't be reached, or doesn't tell whether the key is valid, it only warns:
+// the resources fail by themselves if the key is actually invalid.
+func validateAPIKey(ctx context.Context, c *sendgrid.Client, requiredScopes *schema.Set) diag.Diagnostics {
+	scopesStruct, err := c.Retry(ctx, validateAPIKeyTimeout, func() (interface{}, sendgrid.RequestError) {
+		return c.ListScopes()
+	})
+	if err != nil {
+		if errors.Is(err, sendgrid.ErrUnauthorized) {
+			if diags := checkAPIKeyRegion(c); diags != nil {
+				return diags
+			}
+
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Sendgrid API key is invalid",
+				Detail:   "The API key was rejected by Sendgrid, check that it exists and wasn't revoked.",
+			}}
+		}
+
+		if isNotFound(err) {
+			return diag.Diagnostics{{
+				Severity: diag.Error,
+				Summary:  "Sendgrid host is invalid",
+				Detail: fmt.Sprintf("The host %s doesn't serve the Sendgrid API, "+
+					"it must be the base URL of the API, e.g. %s or %s.", c.Host(), sendgrid.GlobalHost, sendgrid.EUHost),
+			}}
+		}
+
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "Sendgrid API key couldn't be validated",
+			Detail:   err.Error(),
+		}}
+	}
+
+	scopes := scopesStruct.([]string)
+
+	var missing []string
+
+	for _, scope := range requiredScopes.List() {
+		if !sendgrid.ScopeIn(scopes, scope.(string)) {
+			missing = append(missing, scope.(string))
+		}
+	}
+
+	if len(missing) > 0 {
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Sendgrid API key lacks required scopes",
+			Detail:   "The API key doesn't have the scopes: " + strings.Join(missing, ", "),
+		}}
+	}
+
+	return nil
+}
+
+// checkAPIKeyRegion tells whether an API key rejected by the host of one region is accepted by the other one,
+// since the keys of each region only work with its host. It returns nil if the key isn't accepted either.
+func checkAPIKeyRegion(c *sendgrid.Client) diag.Diagnostics {
+	var other string
+
+	switch strings.TrimSuffix(c.Host(), "/") {
+	case sendgrid.GlobalHost:
+		other = sendgrid.EUHost
+	case sendgrid.EUHost:
+		other = sendgrid.GlobalHost
+	default:
+		return nil
+	}
+
+	if _, requestErr := c.WithHost(other).ReadScopes(); requestErr.Err != nil {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Error,
+		Summary:  "Sendgrid API key belongs to another region",
+		Detail: fmt.Sprintf("The API key was rejected by %s but is accepted by %s, "+
+			"set the host of the provider to %s.", c.Host(), other, other),
+	}}
+}
+
+func validateDuration(v interface{}, k string) ([]string, []error) {
+	if _, err := time.ParseDuration(v.(string)); err != nil {
+		return nil, []error{fmt.Errorf("%q must be a duration, e.g. 500ms or 30s: %w", k, err)}
+	}
+
+	return nil, nil
+}
+
+// parentAccountSchema returns the schema of parent_account, for the resources supporting sub_user_on_behalf_of:
+// an unset sub_user_on_behalf_of and an empty one can't be told apart, so making the calls as the parent account
+// despite the default_on_behalf_of of the provider takes its own attribute.
+func parentAccountSchema(forceNew bool) *schema.Schema {
+	return &schema.Schema{
+		Type: schema.TypeBool,
+		Description: "Make the calls as the parent account, instead of on behalf of the default_on_behalf_of " +
+			"subuser of the provider.",
+		Optional:      true,
+		Default:       false,
+		ForceNew:      forceNew,
+		ConflictsWith: []string{"sub_user_on_behalf_of"},
+	}
+}
+
+// resourceGetter is implemented by schema.ResourceData and schema.ResourceDiff.
+type resourceGetter interface {
+	Get(key string) interface{}
+}
+
+// onBehalfOfClient returns the client making the calls of a resource supporting sub_user_on_behalf_of:
+// on behalf of its sub_user_on_behalf_of if set, as the parent account if parent_account is set,
+// otherwise on behalf of the default_on_behalf_of subuser of the provider, if any.
+func onBehalfOfClient(d resourceGetter, m interface{}) *sendgrid.Client {
+	c := m.(*sendgrid.Client)
+
+	if d.Get("parent_account").(bool) {
+		return c.WithoutOnBehalfOf()
+	}
+
+	return c.WithOnBehalfOf(d.Get("sub_user_on_behalf_of").(string))
+}
+
+// parentClient returns the client of the parent account, for the resources managing what only the parent account
+// has, e.g. its subusers, teammates, IPs or SSO, whose endpoints reject the On-Behalf-Of header:
+// their calls are never made on behalf of the subuser of the provider.
+func parentClient(m interface{}) *sendgrid.Client {
+	return m.(*sendgrid.Client).WithoutOnBehalfOf()
+}
 
-	return sendgrid.NewClient(apiKey, host, subuser), diags
+// setImportedOnBehalfOf sets the subuser a resource is imported from, given by the username prefixing its ID.
+// An empty username, e.g. /ID, imports the resource of the parent account despite the default_on_behalf_of
+// subuser of the provider.
+func setImportedOnBehalfOf(d *schema.ResourceData, subUser string) {
+	//nolint:errcheck
+	d.Set("sub_user_on_behalf_of", subUser)
+	//nolint:errcheck
+	d.Set("parent_account", subUser == "")
 }
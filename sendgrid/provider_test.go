@@ -1,10 +1,17 @@
 package sendgrid_test
 
 import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"sync/atomic"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sdk "github.com/trois-six/terraform-provider-sendgrid/sdk"
 	"github.com/trois-six/terraform-provider-sendgrid/sendgrid"
 )
 
@@ -29,6 +36,257 @@ func TestProvider_impl(t *testing.T) {
 	var _ *schema.Provider = sendgrid.Provider()
 }
 
+func TestProviderConfigureInvalidHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	diags := sendgrid.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key": "key",
+		"host":    server.URL,
+	}))
+	if !diags.HasError() || diags[0].Summary != "Sendgrid host is invalid" {
+		t.Fatalf("expected an invalid host error, got: %v", diags)
+	}
+}
+
+func TestProviderConfigureUnreachableHost(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	server.Close()
+
+	diags := sendgrid.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key": "key",
+		"host":    server.URL,
+	}))
+	if diags.HasError() || len(diags) != 1 || diags[0].Summary != "Sendgrid API key couldn't be validated" {
+		t.Fatalf("expected a connectivity warning, got: %v", diags)
+	}
+}
+
+func TestProviderConfigureRejectsInvalidAPIKeyByDefault(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	diags := sendgrid.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key": "key",
+		"host":    server.URL,
+	}))
+	if !diags.HasError() || diags[0].Summary != "Sendgrid API key is invalid" {
+		t.Fatalf("expected an invalid API key error, got: %v", diags)
+	}
+}
+
+func TestProviderConfigureValidationWarnsWhenScopesUnreadable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	diags := sendgrid.Provider().Configure(context.Background(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"api_key":          "key",
+		"host":             server.URL,
+		"validate_api_key": true,
+	}))
+	if diags.HasError() || len(diags) != 1 || diags[0].Summary != "Sendgrid API key couldn't be validated" {
+		t.Fatalf("expected a validation warning, got: %v", diags)
+	}
+}
+
+func TestProviderConfigureDefaultOnBehalfOf(t *testing.T) {
+	tests := map[string]struct {
+		raw        map[string]interface{}
+		onBehalfOf string
+	}{
+		"default_on_behalf_of": {raw: map[string]interface{}{"default_on_behalf_of": "marketing"}, onBehalfOf: "marketing"},
+		"deprecated subuser":   {raw: map[string]interface{}{"subuser": "sales"}, onBehalfOf: "sales"},
+		"none":                 {raw: map[string]interface{}{}, onBehalfOf: ""},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			test.raw["api_key"] = "key"
+			test.raw["validate_api_key"] = false
+
+			p := sendgrid.Provider()
+			if diags := p.Configure(context.Background(), terraform.NewResourceConfigRaw(test.raw)); diags.HasError() {
+				t.Fatalf("failed configuring the provider: %v", diags)
+			}
+
+			if onBehalfOf := p.Meta().(*sdk.Client).OnBehalfOf; onBehalfOf != test.onBehalfOf {
+				t.Errorf("expected the calls on behalf of %q, got: %q", test.onBehalfOf, onBehalfOf)
+			}
+		})
+	}
+}
+
+// TestOnBehalfOfPrecedence checks that sub_user_on_behalf_of overrides the default subuser of the provider,
+// and that parent_account makes the calls as the parent account despite it.
+func TestOnBehalfOfPrecedence(t *testing.T) {
+	tests := map[string]struct {
+		raw        map[string]interface{}
+		onBehalfOf string
+	}{
+		"default":         {raw: map[string]interface{}{}, onBehalfOf: "marketing"},
+		"override":        {raw: map[string]interface{}{"sub_user_on_behalf_of": "sales"}, onBehalfOf: "sales"},
+		"explicit parent": {raw: map[string]interface{}{"parent_account": true}, onBehalfOf: ""},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if onBehalfOf := r.Header.Get("On-Behalf-Of"); onBehalfOf != test.onBehalfOf {
+					t.Errorf("expected the call on behalf of %q, got: %q", test.onBehalfOf, onBehalfOf)
+				}
+
+				fmt.Fprint(w, `{"require_tls": true, "require_valid_cert": false}`)
+			}))
+			defer server.Close()
+
+			c := sdk.NewClient("key", server.URL, "marketing")
+
+			r := sendgrid.Provider().ResourcesMap["sendgrid_enforced_tls"]
+			d := schema.TestResourceDataRaw(t, r.Schema, test.raw)
+			d.SetId("enforced_tls")
+
+			if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+				t.Fatalf("failed reading enforced TLS setting: %v", diags)
+			}
+		})
+	}
+}
+
+func TestParentAccountConflictsWithSubUser(t *testing.T) {
+	r := sendgrid.Provider().ResourcesMap["sendgrid_enforced_tls"]
+
+	diags := r.Validate(terraform.NewResourceConfigRaw(map[string]interface{}{
+		"sub_user_on_behalf_of": "sales",
+		"parent_account":        true,
+	}))
+	if !diags.HasError() {
+		t.Fatal("expected parent_account to conflict with sub_user_on_behalf_of")
+	}
+}
+
+// TestParentOnlyResourcesIgnoreProviderSubuser checks that the resources managing what only the parent account has
+// never make their calls on behalf of the subuser of the provider, as Sendgrid rejects them.
+func TestParentOnlyResourcesIgnoreProviderSubuser(t *testing.T) {
+	p := sendgrid.Provider()
+
+	tests := map[string]struct {
+		resource *schema.Resource
+		id       string
+		raw      map[string]interface{}
+	}{
+		"sendgrid_subuser":                 {resource: p.ResourcesMap["sendgrid_subuser"], id: "marketing"},
+		"sendgrid_subuser_monitor":         {resource: p.ResourcesMap["sendgrid_subuser_monitor"], id: "marketing"},
+		"sendgrid_teammate":                {resource: p.ResourcesMap["sendgrid_teammate"], id: "jane@example.org"},
+		"sendgrid_sso_integration":         {resource: p.ResourcesMap["sendgrid_sso_integration"], id: "sso"},
+		"sendgrid_sso_certificate":         {resource: p.ResourcesMap["sendgrid_sso_certificate"], id: "certificate"},
+		"sendgrid_sso_teammate":            {resource: p.ResourcesMap["sendgrid_sso_teammate"], id: "jane@example.org"},
+		"sendgrid_ip_pool":                 {resource: p.ResourcesMap["sendgrid_ip_pool"], id: "transactional"},
+		"sendgrid_ip_warmup":               {resource: p.ResourcesMap["sendgrid_ip_warmup"], id: "127.0.0.1"},
+		"sendgrid_ip_access_management":    {resource: p.ResourcesMap["sendgrid_ip_access_management"], id: "rules"},
+		"sendgrid_teammate_subuser_access": {resource: p.ResourcesMap["sendgrid_teammate_subuser_access"], id: "jane"},
+		"sendgrid_ip_pool_membership": {
+			resource: p.ResourcesMap["sendgrid_ip_pool_membership"],
+			id:       "transactional/127.0.0.1",
+			raw:      map[string]interface{}{"pool_name": "transactional", "ip": "127.0.0.1"},
+		},
+		"sendgrid_authenticated_domain_association": {
+			resource: p.ResourcesMap["sendgrid_authenticated_domain_association"],
+			id:       "marketing/1",
+			raw:      map[string]interface{}{"username": "marketing", "domain_id": "1"},
+		},
+		"data sendgrid_ips":      {resource: p.DataSourcesMap["sendgrid_ips"]},
+		"data sendgrid_subusers": {resource: p.DataSourcesMap["sendgrid_subusers"]},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			var requests int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				atomic.AddInt32(&requests, 1)
+
+				if onBehalfOf := r.Header.Get("On-Behalf-Of"); onBehalfOf != "" {
+					t.Errorf("expected the call %s %s as the parent account, got it on behalf of %q",
+						r.Method, r.URL.Path, onBehalfOf)
+				}
+
+				// whatever the read makes of it, only the headers of its calls matter.
+				w.WriteHeader(http.StatusNotFound)
+			}))
+			defer server.Close()
+
+			c := sdk.NewClient("key", server.URL, "marketing")
+
+			raw := test.raw
+			if raw == nil {
+				raw = map[string]interface{}{}
+			}
+
+			d := schema.TestResourceDataRaw(t, test.resource.Schema, raw)
+			d.SetId(test.id)
+
+			//nolint:errcheck
+			test.resource.ReadContext(context.Background(), d, c)
+
+			if atomic.LoadInt32(&requests) == 0 {
+				t.Fatal("expected the read to call Sendgrid")
+			}
+		})
+	}
+}
+
+func TestImportWithEmptySubUserSetsParentAccount(t *testing.T) {
+	r := sendgrid.Provider().ResourcesMap["sendgrid_event_webhook"]
+	d := r.Data(nil)
+	d.SetId("/event_webhook")
+
+	imported, err := r.Importer.StateContext(context.Background(), d, nil)
+	if err != nil {
+		t.Fatalf("failed importing event webhook: %v", err)
+	}
+
+	if imported[0].Id() != "event_webhook" || !imported[0].Get("parent_account").(bool) {
+		t.Errorf("expected the event webhook of the parent account, got: %s %v",
+			imported[0].Id(), imported[0].Get("parent_account"))
+	}
+}
+
+func TestRequestErrorDiagnosticListsFieldErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"errors": [
+			{"field": "name", "message": "is too long"},
+			{"field": null, "message": "the request is invalid"}
+		]}`)
+	}))
+	defer server.Close()
+
+	r := sendgrid.Provider().ResourcesMap["sendgrid_marketing_list"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "newsletter"})
+
+	diags := r.CreateContext(context.Background(), d, sdk.NewClient("key", server.URL, ""))
+	if !diags.HasError() {
+		t.Fatal("expected an error")
+	}
+
+	expected := "failed creating marketing list:\n- name: is too long\n- the request is invalid"
+	if diags[0].Detail != expected {
+		t.Errorf("expected detail %q, got %q", expected, diags[0].Detail)
+	}
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -36,3 +294,29 @@ func testAccPreCheck(t *testing.T) {
 		t.Fatal("SENDGRID_API_KEY must be set for acceptance tests")
 	}
 }
+
+// testResourceReadNotFound checks that the Read of the resource removes it from the state, without error,
+// when Sendgrid answers that it doesn't exist anymore.
+func testResourceReadNotFound(t *testing.T, name, id string, raw map[string]interface{}) {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"errors": [{"field": null, "message": "resource not found"}]}`)
+	}))
+	defer server.Close()
+
+	c := sdk.NewClient("key", server.URL, "")
+
+	r := sendgrid.Provider().ResourcesMap[name]
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+	d.SetId(id)
+
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("expected the resource not found not to fail, got: %v", diags)
+	}
+
+	if d.Id() != "" {
+		t.Fatalf("expected the resource not found to be removed from the state, got ID %q", d.Id())
+	}
+}
@@ -0,0 +1,187 @@
+/*
+Provide a resource to manage an alert, an email notification about the account.
+A stats_notification alert emails the stats of the account at a `frequency`,
+a usage_limit alert is emailed once a `percentage` of the credits of the account is used.
+The frequency and the percentage are checked against the type at plan time.
+Example Usage
+```hcl
+resource "sendgrid_alert" "stats" {
+	type      = "stats_notification"
+	email_to  = "ops@example.org"
+	frequency = "weekly"
+}
+
+resource "sendgrid_alert" "usage" {
+	type       = "usage_limit"
+	email_to   = "ops@example.org"
+	percentage = 90
+}
+```
+Import
+An alert can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_alert.stats alertID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridAlert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridAlertCreate,
+		ReadContext:   resourceSendgridAlertRead,
+		UpdateContext: resourceSendgridAlertUpdate,
+		DeleteContext: resourceSendgridAlertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceSendgridAlertCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:        schema.TypeString,
+				Description: "The type of the alert: stats_notification or usage_limit.",
+				Required:    true,
+				ForceNew:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sendgrid.AlertStatsNotification,
+					sendgrid.AlertUsageLimit,
+				}, false),
+			},
+			"email_to": {
+				Type:        schema.TypeString,
+				Description: "The email address the alert is sent to.",
+				Required:    true,
+			},
+			"frequency": {
+				Type:         schema.TypeString,
+				Description:  "How often the stats are sent: daily, weekly or monthly, only for stats_notification alerts.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{"daily", "weekly", "monthly"}, false),
+			},
+			"percentage": {
+				Type:         schema.TypeInt,
+				Description:  "The percentage of used credits the alert is sent at, only for usage_limit alerts.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 100),
+			},
+			"created_at": {
+				Type:        schema.TypeInt,
+				Description: "The unix timestamp the alert was created at.",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeInt,
+				Description: "The unix timestamp the alert was last updated at.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func alertFromResourceData(d *schema.ResourceData) sendgrid.Alert {
+	return sendgrid.Alert{
+		Type:       d.Get("type").(string),
+		EmailTo:    d.Get("email_to").(string),
+		Frequency:  d.Get("frequency").(string),
+		Percentage: d.Get("percentage").(int),
+	}
+}
+
+// resourceSendgridAlertCustomizeDiff fails the plan when the frequency or the percentage don't match the type,
+// instead of the error of Sendgrid at apply.
+func resourceSendgridAlertCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// the values known after apply can't be checked yet.
+	for _, key := range []string{"type", "frequency", "percentage"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return sendgrid.ValidateAlert(sendgrid.Alert{
+		Type:       d.Get("type").(string),
+		Frequency:  d.Get("frequency").(string),
+		Percentage: d.Get("percentage").(int),
+	})
+}
+
+func resourceSendgridAlertCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	alert, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateAlert(alertFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed creating alert", err)
+	}
+
+	d.SetId(strconv.FormatInt(alert.(*sendgrid.Alert).ID, 10))
+
+	return resourceSendgridAlertRead(ctx, d, m)
+}
+
+func resourceSendgridAlertRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	alert, requestErr := c.ReadAlert(d.Id())
+	if isNotFound(requestErr) {
+		// the alert was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading alert", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("type", alert.Type)
+	//nolint:errcheck
+	d.Set("email_to", alert.EmailTo)
+	//nolint:errcheck
+	d.Set("frequency", alert.Frequency)
+	//nolint:errcheck
+	d.Set("percentage", alert.Percentage)
+	//nolint:errcheck
+	d.Set("created_at", alert.CreatedAt)
+	//nolint:errcheck
+	d.Set("updated_at", alert.UpdatedAt)
+
+	return nil
+}
+
+func resourceSendgridAlertUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateAlert(d.Id(), alertFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed updating alert", err)
+	}
+
+	return resourceSendgridAlertRead(ctx, d, m)
+}
+
+func resourceSendgridAlertDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteAlert(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting alert", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,99 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridAlertBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAlertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAlertConfigUsageLimit(90),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_alert.alert", "type", "usage_limit"),
+					resource.TestCheckResourceAttr("sendgrid_alert.alert", "percentage", "90"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridAlertConfigUsageLimit(75),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_alert.alert", "percentage", "75"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_alert.alert",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridAlertInvalid(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// a stats notification requires a frequency, and can't have a percentage.
+				Config: `
+				resource "sendgrid_alert" "alert" {
+					type       = "stats_notification"
+					email_to   = "terraform@example.org"
+					percentage = 90
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("requires a frequency"),
+			},
+			{
+				Config: `
+				resource "sendgrid_alert" "alert" {
+					type      = "usage_limit"
+					email_to  = "terraform@example.org"
+					frequency = "daily"
+				}
+				`,
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("percentage between 1 and 100"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridAlertDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_alert" {
+			continue
+		}
+
+		_, requestErr := c.ReadAlert(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("alert %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridAlertConfigUsageLimit(percentage int) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_alert" "alert" {
+		type       = "usage_limit"
+		email_to   = "terraform@example.org"
+		percentage = %d
+	}
+	`, percentage)
+}
@@ -10,17 +10,62 @@ resource "sendgrid_api_key" "api_key" {
 	]
 }
 ```
+Sendgrid adds the `sender_verification_eligible` scope to every API key, and the `2fa_required` or `2fa_exempt`
+scopes on accounts enforcing two-factor authentication. These implied scopes are ignored unless they're declared,
+and kept when the scopes are updated. Set `include_2fa_scopes` to manage the two-factor authentication scopes
+like the other scopes, i.e. to remove them from the API key when they aren't declared.
+When other tools manage scopes of the same API key, set `exclusive` to false: the declared scopes are added
+to the API key, and only the scopes removed from the configuration are removed from it, e.g.
+```hcl
+resource "sendgrid_api_key" "shared_api_key" {
+	name      = "my-shared-api-key"
+	exclusive = false
+	scopes = [
+		"mail.send",
+	]
+}
+```
+Sendgrid allows several API keys with the same name, so an apply interrupted after creating an API key would create
+a duplicate the next time. Set `adopt_existing_by_name` to adopt the existing API key with the same name instead,
+its secret can't be retrieved so `api_key` is then empty. Otherwise a warning is displayed when a duplicate is created,
+or when the API key of the provider isn't allowed to list the API keys to look for one.
+An API key of a subuser can be managed from the parent account, e.g.
+```hcl
+resource "sendgrid_api_key" "subuser_api_key" {
+	name                  = "my-subuser-api-key"
+	sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+	scopes = [
+		"mail.send",
+	]
+}
+```
+Sendgrid returns the scopes of a family, e.g. `templates`, as its `.create`, `.delete`, `.read` and `.update` scopes.
+When a family is declared and Sendgrid returned all these scopes, they're read as the family, so it doesn't show
+up as a diff. The other scopes are read as returned.
+An API key can only be given the scopes of the API key of the provider, so the plan fails when the declared
+scopes include others, listing the missing ones. The check can be disabled with `skip_scopes_check`, it's skipped
+when the scopes of the API key of the provider can't be read.
+Sendgrid doesn't expose when an API key was created nor last used, neither when reading it nor when listing
+the API keys, so these dates can't be read to review the stale API keys.
 Import
 An API key can be imported, e.g.
 ```hcl
 $ terraform import sendgrid_api_key.api_key apiKeyID
 ```
+An API key of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_api_key.subuser_api_key subUserName/apiKeyID
+```
+When the provider sets `default_on_behalf_of`, an API key of the account is imported with an empty username, e.g.
+```hcl
+$ terraform import sendgrid_api_key.api_key /apiKeyID
+```
 */
 package sendgrid
 
 import (
 	"context"
-	"reflect"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -34,8 +79,9 @@ func resourceSendgridAPIKey() *schema.Resource {
 		UpdateContext: resourceSendgridAPIKeyUpdate,
 		DeleteContext: resourceSendgridAPIKeyDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSendgridAPIKeyImport,
 		},
+		CustomizeDiff: resourceSendgridAPIKeyCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -47,7 +93,9 @@ func resourceSendgridAPIKey() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "The subuser's username. Generates the API call as if the subuser account was making the call",
 				Optional:    true,
+				ForceNew:    true,
 			},
+			"parent_account": parentAccountSchema(true),
 			"scopes": {
 				Type:        schema.TypeSet,
 				Description: "The individual permissions that you are giving to this API Key.",
@@ -55,127 +103,340 @@ func resourceSendgridAPIKey() *schema.Resource {
 				Computed:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"include_2fa_scopes": {
+				Type: schema.TypeBool,
+				Description: "Manage the 2fa_required and 2fa_exempt scopes added by Sendgrid on accounts enforcing " +
+					"two-factor authentication, instead of ignoring them when they aren't declared.",
+				Optional: true,
+				Default:  false,
+			},
+			"exclusive": {
+				Type: schema.TypeBool,
+				Description: "Whether the resource owns all the scopes of the API key. When false, the scopes of " +
+					"the API key which aren't declared are neither reported nor removed.",
+				Optional: true,
+				Default:  true,
+			},
+			"adopt_existing_by_name": {
+				Type: schema.TypeBool,
+				Description: "Adopt the API key with the same name if it already exists, e.g. when an apply " +
+					"was interrupted, instead of creating a duplicate. Its secret can't be retrieved.",
+				Optional: true,
+				Default:  false,
+			},
+			"skip_scopes_check": {
+				Type: schema.TypeBool,
+				Description: "Plan the scopes even if the API key of the provider doesn't have them, " +
+					"instead of failing the plan.",
+				Optional: true,
+				Default:  false,
+			},
 			"api_key": {
 				Type:        schema.TypeString,
-				Description: "The API key created by the API.",
+				Description: "The API key created by the API, redacted from the plan and the logs.",
 				Computed:    true,
+				Sensitive:   true,
 			},
 		},
 	}
 }
 
-func scopeInScopes(scopes []string, scope string) bool {
-	for _, v := range scopes {
-		if v == scope {
-			return true
+// impliedScopes returns the scopes Sendgrid adds to every API key, even when they weren't requested.
+func impliedScopes() []string {
+	return []string{"sender_verification_eligible"}
+}
+
+// twoFactorScopes returns the scopes Sendgrid adds to the API keys of the accounts enforcing
+// two-factor authentication, even when they weren't requested.
+func twoFactorScopes() []string {
+	return []string{"2fa_required", "2fa_exempt"}
+}
+
+// isIgnoredScope tells if a scope returned by the API is added by Sendgrid and isn't managed by the resource.
+func isIgnoredScope(scope string, include2FAScopes bool) bool {
+	return sendgrid.ScopeIn(impliedScopes(), scope) || (!include2FAScopes && sendgrid.ScopeIn(twoFactorScopes(), scope))
+}
+
+// filterImpliedScopes removes from the scopes returned by the API the implied scopes
+// which aren't declared, so that they don't show up as a diff. When the resource isn't exclusive,
+// all the scopes which aren't declared are removed.
+func filterImpliedScopes(scopes []string, declared *schema.Set, include2FAScopes, exclusive bool) []string {
+	filtered := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if (!exclusive || isIgnoredScope(scope, include2FAScopes)) && !declared.Contains(scope) {
+			continue
 		}
+
+		filtered = append(filtered, scope)
 	}
 
-	return false
+	return filtered
 }
 
-func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	var scopes []string
+// keptScopes returns the current scopes of an API key which aren't managed by the resource and have to be kept:
+// the implied scopes, or when the resource isn't exclusive, all the scopes which weren't removed
+// from the configuration.
+func keptScopes(d *schema.ResourceData, current []string) []string {
+	o, n := d.GetChange("scopes")
+	removed := o.(*schema.Set).Difference(n.(*schema.Set))
+	include2FAScopes := d.Get("include_2fa_scopes").(bool)
+	exclusive := d.Get("exclusive").(bool)
+
+	var kept []string
+
+	for _, scope := range current {
+		if removed.Contains(scope) {
+			continue
+		}
+
+		if !exclusive || isIgnoredScope(scope, include2FAScopes) {
+			kept = append(kept, scope)
+		}
+	}
+
+	return kept
+}
+
+// reconcileScopes returns the scopes to give to an API key: the declared scopes, the implied scopes,
+// and the kept scopes, so that Sendgrid doesn't add them back and other tools don't lose them.
+func reconcileScopes(declared *schema.Set, kept []string) []string {
+	scopes := stringSetToSlice(declared)
+
+	for _, scope := range append(impliedScopes(), kept...) {
+		if !sendgrid.ScopeIn(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+
+	return scopes
+}
+
+// apiKeyClient returns the client making the calls on behalf of the subuser owning the API key, if any.
+func apiKeyClient(d *schema.ResourceData, m interface{}) *sendgrid.Client {
+	return onBehalfOfClient(d, m)
+}
+
+// apiKeysNamed returns the IDs of the existing API keys with the given name.
+func apiKeysNamed(c *sendgrid.Client, name string) ([]string, diag.Diagnostics) {
+	keys, requestErr := c.ListAPIKeys()
+	if requestErr.Err != nil {
+		return nil, diag.Diagnostics{requestErrorToDiag("failed listing API keys", requestErr)}
+	}
+
+	var ids []string
+
+	for _, key := range keys {
+		if key.Name == name {
+			ids = append(ids, key.ID)
+		}
+	}
+
+	return ids, nil
+}
+
+// resourceSendgridAPIKeyCustomizeDiff fails the plan when the declared scopes include scopes the API key
+// of the provider doesn't have, instead of the 403 of Sendgrid at apply.
+func resourceSendgridAPIKeyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	if d.Get("skip_scopes_check").(bool) || !d.HasChange("scopes") {
+		return nil
+	}
+
+	// the values known after apply can't be checked yet.
+	if !d.NewValueKnown("scopes") || !d.NewValueKnown("sub_user_on_behalf_of") {
+		return nil
+	}
+
+	c := onBehalfOfClient(d, m).WithContext(ctx)
 
-	c := m.(*sendgrid.Client)
+	// the check is best-effort, Sendgrid still refuses the scopes at apply.
+	callerScopes, requestErr := c.ListScopes()
+	if requestErr.Err != nil {
+		return nil
+	}
+
+	var missing []string
+
+	for _, scope := range stringSetToSlice(d.Get("scopes").(*schema.Set)) {
+		if !isIgnoredScope(scope, false) && !sendgrid.ScopeGranted(callerScopes, scope) {
+			missing = append(missing, scope)
+		}
+	}
+
+	if len(missing) > 0 {
+		return apiKeyScopesNotGranted(missing)
+	}
+
+	return nil
+}
+
+func resourceSendgridAPIKeyCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := apiKeyClient(d, m).WithContext(ctx)
 	name := d.Get("name").(string)
-	c.OnBehalfOf = d.Get("sub_user_on_behalf_of").(string)
+	scopes := reconcileScopes(d.Get("scopes").(*schema.Set), nil)
+
+	adopt := d.Get("adopt_existing_by_name").(bool)
+
+	existing, diags := apiKeysNamed(c, name)
+	if diags.HasError() {
+		if adopt {
+			return diags
+		}
 
-	for _, scope := range d.Get("scopes").(*schema.Set).List() {
-		scopes = append(scopes, scope.(string))
+		// without adoption, the API keys are only listed to warn about a duplicate:
+		// an API key allowed to create API keys, but not to list them, can still create them.
+		for i := range diags {
+			diags[i].Severity = diag.Warning
+		}
 	}
 
-	if ok := scopeInScopes(scopes, "sender_verification_eligible"); !ok {
-		scopes = append(scopes, "sender_verification_eligible")
+	if adopt && len(existing) > 0 {
+		if len(existing) > 1 {
+			return diag.FromErr(apiKeyAmbiguous(name))
+		}
+
+		// the scopes of the adopted API key are set to the declared ones.
+		d.SetId(existing[0])
+
+		return resourceSendgridAPIKeyUpdate(ctx, d, m)
 	}
 
-	apiKeyStruct, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	if len(existing) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "An API key with the same name already exists",
+			Detail: "A duplicate API key named " + name + " is created, " +
+				"set adopt_existing_by_name to adopt the existing one instead.",
+		})
+	}
+
+	apiKeyStruct, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
 		return c.CreateAPIKey(name, scopes)
 	})
-
-	apiKey := apiKeyStruct.(*sendgrid.APIKey)
-
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed creating API key", err)
 	}
 
+	apiKey := apiKeyStruct.(*sendgrid.APIKey)
+
 	d.SetId(apiKey.ID)
 	//nolint:errcheck
 	d.Set("api_key", apiKey.APIKey)
 
-	return resourceSendgridAPIKeyRead(ctx, d, m)
-}
+	err = waitUntilReadable(ctx, func() (bool, error) {
+		_, requestErr := c.ReadAPIKey(apiKey.ID)
+		if isNotFound(requestErr) {
+			return false, nil
+		}
 
-func resourceSendgridAPIKeyRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+		if requestErr.Err != nil {
+			return false, requestErr
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return append(diags, errorToDiags("failed waiting for the API key to be readable", err)...)
+	}
+
+	return append(diags, resourceSendgridAPIKeyRead(ctx, d, m)...)
+}
 
-	c.OnBehalfOf = d.Get("sub_user_on_behalf_of").(string)
+func resourceSendgridAPIKeyRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := apiKeyClient(d, m).WithContext(ctx)
 
 	apiKey, err := c.ReadAPIKey(d.Id())
+	if isNotFound(err) {
+		// the API key was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
 	if err.Err != nil {
-		return diag.FromErr(err.Err)
+		return diag.Diagnostics{requestErrorToDiag("failed reading API key", err)}
 	}
 
 	//nolint:errcheck
 	d.Set("name", apiKey.Name)
 	//nolint:errcheck
-	d.Set("scopes", apiKey.Scopes)
-
-	return nil
-}
-
-func hasDiff(o, n interface{}) bool {
-	if eq, ok := o.(schema.Equal); ok {
-		return !eq.Equal(n)
+	d.Set("scopes", filterImpliedScopes(
+		sendgrid.NormalizeScopes(apiKey.Scopes, stringSetToSlice(d.Get("scopes").(*schema.Set))),
+		d.Get("scopes").(*schema.Set), d.Get("include_2fa_scopes").(bool), d.Get("exclusive").(bool),
+	))
+
+	// the secret is only returned when the key is created,
+	// keep the one stored in the state when the API omits it.
+	if apiKey.APIKey != "" {
+		//nolint:errcheck
+		d.Set("api_key", apiKey.APIKey)
 	}
 
-	return !reflect.DeepEqual(o, n)
+	return nil
 }
 
 func resourceSendgridAPIKeyUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
-
-	c.OnBehalfOf = d.Get("sub_user_on_behalf_of").(string)
+	c := apiKeyClient(d, m).WithContext(ctx)
 
 	a := sendgrid.APIKey{
 		ID:   d.Id(),
 		Name: d.Get("name").(string),
 	}
 
-	o, n := d.GetChange("scopes")
-	n.(*schema.Set).Add("sender_verification_eligible")
-	n.(*schema.Set).Add("2fa_required")
-
-	if ok := hasDiff(o, n); ok {
-		var scopes []string
-		for _, scope := range d.Get("scopes").(*schema.Set).List() {
-			scopes = append(scopes, scope.(string))
+	if d.HasChanges("scopes", "include_2fa_scopes", "exclusive") {
+		current, requestErr := c.ReadAPIKey(d.Id())
+		if requestErr.Err != nil {
+			return diag.Diagnostics{requestErrorToDiag("failed reading API key", requestErr)}
 		}
 
-		a.Scopes = scopes
+		a.Scopes = reconcileScopes(d.Get("scopes").(*schema.Set), keptScopes(d, current.Scopes))
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
 		return c.UpdateAPIKey(d.Id(), a.Name, a.Scopes)
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed updating API key", err)
 	}
 
 	return resourceSendgridAPIKeyRead(ctx, d, m)
 }
 
 func resourceSendgridAPIKeyDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
-
-	c.OnBehalfOf = d.Get("sub_user_on_behalf_of").(string)
+	c := apiKeyClient(d, m).WithContext(ctx)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteAPIKey(d.Id())
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed deleting API key", err)
 	}
 
 	return nil
 }
+
+func resourceSendgridAPIKeyImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("include_2fa_scopes", false)
+	//nolint:errcheck
+	d.Set("exclusive", true)
+	//nolint:errcheck
+	d.Set("adopt_existing_by_name", false)
+	//nolint:errcheck
+	d.Set("skip_scopes_check", false)
+	//nolint:errcheck
+	d.Set("parent_account", false)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == ImportSplitParts {
+		setImportedOnBehalfOf(d, parts[0])
+		d.SetId(parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
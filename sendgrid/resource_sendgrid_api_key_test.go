@@ -1,15 +1,79 @@
 package sendgrid_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
 )
 
+func TestSendgridAPIKeySecretSensitive(t *testing.T) {
+	// Terraform redacts the attributes of the schema marked sensitive from the rendering of the plan.
+	attribute, ok := provider.Provider().ResourcesMap["sendgrid_api_key"].CoreConfigSchema().Attributes["api_key"]
+	if !ok {
+		t.Fatal("expected the api_key attribute")
+	}
+
+	if !attribute.Sensitive || !attribute.Computed || attribute.Optional {
+		t.Errorf("expected the api_key attribute to be sensitive and only computed, got: %+v", attribute)
+	}
+}
+
+// TestSendgridAPIKeyCreateWithoutListing checks that an API key which can't list the API keys can still create one,
+// unless an existing one has to be adopted.
+func TestSendgridAPIKeyCreateWithoutListing(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api_keys":
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"errors": [{"field": null, "message": "access forbidden"}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/api_keys":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"api_key_id": "key", "api_key": "SG.secret", "name": "ci", "scopes": ["mail.send"]}`)
+		default:
+			fmt.Fprint(w, `{"api_key_id": "key", "name": "ci", "scopes": ["mail.send"]}`)
+		}
+	}))
+	defer server.Close()
+
+	r := provider.Provider().ResourcesMap["sendgrid_api_key"]
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":   "ci",
+		"scopes": []interface{}{"mail.send"},
+	})
+
+	diags := r.CreateContext(context.Background(), d, sendgrid.NewClient("key", server.URL, ""))
+	if diags.HasError() || len(diags) != 1 || diags[0].Severity != diag.Warning {
+		t.Fatalf("expected the API key to be created with a warning, got: %v", diags)
+	}
+
+	if d.Id() != "key" {
+		t.Errorf("expected the API key key to be created, got: %q", d.Id())
+	}
+
+	d = schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"name":                   "ci",
+		"scopes":                 []interface{}{"mail.send"},
+		"adopt_existing_by_name": true,
+	})
+
+	if diags := r.CreateContext(context.Background(), d, sendgrid.NewClient("key", server.URL, "")); !diags.HasError() {
+		t.Fatal("expected the adoption to fail without listing the API keys")
+	}
+}
+
 func TestAccSendgridAPIKeyBasic(t *testing.T) {
 	name := "terraform-api-key-" + acctest.RandString(10)
 	scopes := []string{"mail.send", "sender_verification_eligible"}
@@ -22,9 +86,232 @@ func TestAccSendgridAPIKeyBasic(t *testing.T) {
 			{
 				Config: testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSendgridAPIKeyExists("sendgrid_api_key.new"),
+					testAccCheckSendgridAPIKeyExists("sendgrid_api_key.api_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyScopesNotGranted(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+	scopes := []string{"mail.send", "terraform.not_granted"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the plan lists the scopes the API key of the provider lacks.
+				Config:      testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("can't grant scopes it doesn't have: terraform.not_granted"),
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeySecretKeptOnRefresh(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+	scopes := []string{"mail.send", "sender_verification_eligible"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.api_key", "api_key"),
+				),
+			},
+			{
+				// the second step refreshes the state before planning.
+				Config: testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_api_key.api_key", "api_key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyInclude2FAScopes(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigInclude2FAScopes(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "include_2fa_scopes", "true"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "scopes.#", "1"),
 				),
 			},
+			{
+				// the implied scopes mustn't show up as a diff.
+				Config:   testAccCheckSendgridAPIKeyConfigInclude2FAScopes(name),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyNotExclusive(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	var apiKeyID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigNotExclusive(name, "mail.send"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "scopes.#", "1"),
+					func(s *terraform.State) error {
+						apiKeyID = s.RootModule().Resources["sendgrid_api_key.api_key"].Primary.ID
+
+						return nil
+					},
+				),
+			},
+			{
+				// simulate another tool adding a scope to the API key.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+
+					_, requestErr := c.UpdateAPIKey(apiKeyID, name, []string{
+						"mail.send", "sender_verification_eligible", "templates.read",
+					})
+					if requestErr.Err != nil {
+						t.Fatalf("failed updating API key: %s", requestErr.Err)
+					}
+				},
+				Config: testAccCheckSendgridAPIKeyConfigNotExclusive(name, "alerts.read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "scopes.#", "1"),
+					func(s *terraform.State) error {
+						c := testAccProvider.Meta().(*sendgrid.Client)
+
+						apiKey, requestErr := c.ReadAPIKey(apiKeyID)
+						if requestErr.Err != nil {
+							return requestErr.Err
+						}
+
+						scopes := strings.Join(apiKey.Scopes, " ")
+						if !strings.Contains(scopes, "templates.read") || !strings.Contains(scopes, "alerts.read") {
+							return fmt.Errorf("expected the API key to keep templates.read and get alerts.read: %s", scopes)
+						}
+
+						if strings.Contains(scopes, "mail.send") {
+							return fmt.Errorf("expected mail.send to be removed from the API key: %s", scopes)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyAdoptExistingByName(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				// simulate an apply interrupted after the API key was created.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+
+					apiKey, requestErr := c.CreateAPIKey(name, []string{"alerts.read"})
+					if requestErr.Err != nil {
+						t.Fatalf("failed creating API key: %s", requestErr.Err)
+					}
+
+					existingID = apiKey.ID
+				},
+				Config: fmt.Sprintf(`
+				resource "sendgrid_api_key" "api_key" {
+					name                   = %q
+					adopt_existing_by_name = true
+					scopes                 = ["mail.send"]
+				}
+				`, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "scopes.#", "1"),
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["sendgrid_api_key.api_key"]
+						if rs.Primary.ID != existingID {
+							return fmt.Errorf("expected API key %s to be adopted, got %s", existingID, rs.Primary.ID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyImport(t *testing.T) {
+	name := "terraform-api-key-" + acctest.RandString(10)
+	scopes := []string{"mail.send", "sender_verification_eligible"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigBasic(name, scopes),
+			},
+			{
+				ResourceName:      "sendgrid_api_key.api_key",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// the secret is only returned by the API when the key is created.
+				ImportStateVerifyIgnore: []string{"api_key"},
+			},
+		},
+	})
+}
+
+func TestAccSendgridAPIKeyOnBehalfOf(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	name := "terraform-api-key-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAPIKeyConfigOnBehalfOf(username, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridAPIKeyExists("sendgrid_api_key.api_key"),
+					resource.TestCheckResourceAttr("sendgrid_api_key.api_key", "sub_user_on_behalf_of", username),
+				),
+			},
+			{
+				// the key is read on behalf of the subuser, so there is no drift.
+				Config:   testAccCheckSendgridAPIKeyConfigOnBehalfOf(username, name),
+				PlanOnly: true,
+			},
 		},
 	})
 }
@@ -39,7 +326,7 @@ func testAccCheckSendgridAPIKeyDestroy(s *terraform.State) error {
 
 		apiKeyID := rs.Primary.ID
 
-		_, err := c.DeleteAPIKey(apiKeyID)
+		_, err := c.WithOnBehalfOf(rs.Primary.Attributes["sub_user_on_behalf_of"]).DeleteAPIKey(apiKeyID)
 		if err.Err != nil {
 			return err.Err
 		}
@@ -51,10 +338,27 @@ func testAccCheckSendgridAPIKeyDestroy(s *terraform.State) error {
 func testAccCheckSendgridAPIKeyConfigBasic(name string, scopes []string) string {
 	return fmt.Sprintf(`
 	resource "sendgrid_api_key" "api_key" {
-		name = %s
-		scopes = %s
+		name = %q
+		scopes = ["%s"]
+	}
+	`, name, strings.Join(scopes, `", "`))
+}
+
+func testAccCheckSendgridAPIKeyConfigOnBehalfOf(username, name string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = "Passw0rd!%s"
+		email    = "%s@example.org"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_api_key" "api_key" {
+		name                  = %q
+		sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+		scopes                = ["mail.send", "sender_verification_eligible"]
 	}
-	`, name, scopes)
+	`, username, username, username, name)
 }
 
 func testAccCheckSendgridAPIKeyExists(n string) resource.TestCheckFunc {
@@ -72,3 +376,23 @@ func testAccCheckSendgridAPIKeyExists(n string) resource.TestCheckFunc {
 		return nil
 	}
 }
+
+func testAccCheckSendgridAPIKeyConfigInclude2FAScopes(name string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_api_key" "api_key" {
+		name               = %q
+		scopes             = ["mail.send"]
+		include_2fa_scopes = true
+	}
+	`, name)
+}
+
+func testAccCheckSendgridAPIKeyConfigNotExclusive(name, scope string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_api_key" "api_key" {
+		name      = %q
+		scopes    = [%q]
+		exclusive = false
+	}
+	`, name, scope)
+}
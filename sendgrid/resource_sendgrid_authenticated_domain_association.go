@@ -0,0 +1,141 @@
+/*
+Provide a resource to associate an authenticated domain of the parent account with a subuser,
+so that the subuser sends emails from it without authenticating its own domain.
+A subuser can be associated with only one authenticated domain.
+Example Usage
+```hcl
+resource "sendgrid_domain_authentication" "example" {
+	domain    = "example.org"
+	subdomain = "mail"
+}
+
+resource "sendgrid_authenticated_domain_association" "example" {
+	domain_id = sendgrid_domain_authentication.example.id
+	username  = sendgrid_subuser.subuser.username
+}
+```
+Import
+An association can be imported using the ID of the authenticated domain and the username of the subuser, e.g.
+```hcl
+$ terraform import sendgrid_authenticated_domain_association.example domainAuthenticationID/username
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridAuthenticatedDomainAssociation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridAuthenticatedDomainAssociationCreate,
+		ReadContext:   resourceSendgridAuthenticatedDomainAssociationRead,
+		DeleteContext: resourceSendgridAuthenticatedDomainAssociationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridAuthenticatedDomainAssociationImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the authenticated domain to associate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the subuser to associate the authenticated domain with.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridAuthenticatedDomainAssociationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	domainID := d.Get("domain_id").(string)
+	username := d.Get("username").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.AssociateDomainAuthentication(domainID, username)
+	})
+	if err != nil {
+		return errorToDiags("failed associating domain authentication", err)
+	}
+
+	d.SetId(domainID + "/" + username)
+
+	return resourceSendgridAuthenticatedDomainAssociationRead(ctx, d, m)
+}
+
+func resourceSendgridAuthenticatedDomainAssociationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	domain, requestErr := c.ReadSubuserDomainAuthentication(d.Get("username").(string))
+	if isNotFound(requestErr) {
+		// the subuser was disassociated outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser domain authentication", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("domain_id", strconv.FormatInt(domain.ID, 10))
+
+	return nil
+}
+
+func resourceSendgridAuthenticatedDomainAssociationDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DisassociateDomainAuthentication(d.Get("username").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed disassociating domain authentication", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridAuthenticatedDomainAssociationImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", ImportSplitParts)
+	if len(parts) != ImportSplitParts || parts[0] == "" || parts[1] == "" {
+		return nil, ErrInvalidDomainAssociationImportFormat
+	}
+
+	//nolint:errcheck
+	d.Set("domain_id", parts[0])
+	//nolint:errcheck
+	d.Set("username", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,83 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridAuthenticatedDomainAssociationBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridAuthenticatedDomainAssociationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridAuthenticatedDomainAssociationConfigBasic(domain, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_authenticated_domain_association.association", "domain_id",
+						"sendgrid_domain_authentication.domain", "id",
+					),
+					resource.TestCheckResourceAttr(
+						"sendgrid_authenticated_domain_association.association", "username", username,
+					),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_authenticated_domain_association.association",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridAuthenticatedDomainAssociationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_authenticated_domain_association" {
+			continue
+		}
+
+		username := rs.Primary.ID[strings.Index(rs.Primary.ID, "/")+1:]
+
+		_, requestErr := c.ReadSubuserDomainAuthentication(username)
+		if requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("subuser %s is still associated with a domain authentication", username)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridAuthenticatedDomainAssociationConfigBasic(domain, username string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_domain_authentication" "domain" {
+		domain    = %q
+		subdomain = "mail"
+	}
+
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = "Passw0rd!%s"
+		email    = "%s@example.org"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_authenticated_domain_association" "association" {
+		domain_id = sendgrid_domain_authentication.domain.id
+		username  = sendgrid_subuser.subuser.username
+	}
+	`, domain, username, acctest.RandString(10), username)
+}
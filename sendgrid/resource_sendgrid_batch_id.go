@@ -0,0 +1,93 @@
+/*
+Provide a resource to generate a batch ID, used to group scheduled sends so they can be canceled or paused.
+Sendgrid generates a new batch ID on each call: this is a resource rather than a data source
+so the batch ID is generated once and kept in the state, instead of being regenerated on every plan.
+The counterpart is that destroying the resource only removes it from the state, as batch IDs can't be deleted.
+Example Usage
+```hcl
+resource "sendgrid_batch_id" "newsletter" {
+}
+```
+Import
+A batch ID can be imported, e.g.
+```hcl
+$ terraform import sendgrid_batch_id.newsletter batchID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridBatchID() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridBatchIDCreate,
+		ReadContext:   resourceSendgridBatchIDRead,
+		DeleteContext: resourceSendgridBatchIDDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"batch_id": {
+				Type: schema.TypeString,
+				Description: "The generated batch ID. It is stored in the state so it isn't regenerated " +
+					"on every plan, which a data source would do; as Sendgrid can't delete a batch ID, " +
+					"destroying the resource only forgets it.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSendgridBatchIDCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	batchID, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateBatchID()
+	})
+	if err != nil {
+		return errorToDiags("failed creating batch ID", err)
+	}
+
+	d.SetId(batchID.(string))
+
+	return resourceSendgridBatchIDRead(ctx, d, m)
+}
+
+func resourceSendgridBatchIDRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	batchID, requestErr := c.ReadBatchID(d.Id())
+	if isNotFound(requestErr) {
+		// the batch was removed outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading batch ID", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("batch_id", batchID)
+
+	return nil
+}
+
+func resourceSendgridBatchIDDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// a batch ID can't be deleted, it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
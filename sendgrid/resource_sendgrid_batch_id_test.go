@@ -0,0 +1,59 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccSendgridBatchIDBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridBatchIDConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridBatchIDExists("sendgrid_batch_id.batch"),
+					resource.TestCheckResourceAttrPair(
+						"sendgrid_batch_id.batch", "id", "sendgrid_batch_id.batch", "batch_id",
+					),
+				),
+			},
+			{
+				// the batch ID must not be regenerated.
+				Config:   testAccCheckSendgridBatchIDConfigBasic(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestSendgridBatchIDReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_batch_id", "batch", map[string]interface{}{})
+}
+
+func testAccCheckSendgridBatchIDConfigBasic() string {
+	return `
+	resource "sendgrid_batch_id" "batch" {
+	}
+	`
+}
+
+func testAccCheckSendgridBatchIDExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No batch ID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Provide a resource to cancel or pause the scheduled sends of a batch.
+Changing the status switches the scheduled sends between canceled and paused in place.
+Destroying the resource removes the cancellation or the pause: the scheduled sends are sent as planned.
+Once the sends of the batch went out, they can't be canceled or paused anymore.
+Example Usage
+```hcl
+resource "sendgrid_batch_id" "newsletter" {
+}
+
+resource "sendgrid_cancel_scheduled_send" "newsletter" {
+	batch_id = sendgrid_batch_id.newsletter.batch_id
+	status   = "pause"
+}
+```
+Import
+A canceled or paused scheduled send can be imported using its batch ID, e.g.
+```hcl
+$ terraform import sendgrid_cancel_scheduled_send.newsletter batchID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridCancelScheduledSend() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridCancelScheduledSendCreate,
+		ReadContext:   resourceSendgridCancelScheduledSendRead,
+		UpdateContext: resourceSendgridCancelScheduledSendUpdate,
+		DeleteContext: resourceSendgridCancelScheduledSendDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridCancelScheduledSendImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"batch_id": {
+				Type:        schema.TypeString,
+				Description: "The batch ID of the scheduled sends.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the scheduled sends: cancel or pause.",
+				Required:    true,
+				ValidateFunc: validation.StringInSlice([]string{
+					sendgrid.ScheduledSendCancel,
+					sendgrid.ScheduledSendPause,
+				}, false),
+			},
+		},
+	}
+}
+
+func resourceSendgridCancelScheduledSendCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	batchID := d.Get("batch_id").(string)
+	status := d.Get("status").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateScheduledSend(batchID, status)
+	})
+	if err != nil {
+		return errorToDiags("failed creating scheduled send", err)
+	}
+
+	d.SetId(batchID)
+
+	return resourceSendgridCancelScheduledSendRead(ctx, d, m)
+}
+
+func resourceSendgridCancelScheduledSendRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	scheduledSends, requestErr := c.ReadScheduledSend(d.Id())
+	if isNotFound(requestErr) || (requestErr.Err == nil && len(scheduledSends) == 0) {
+		// the cancellation was removed outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading scheduled send", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("status", scheduledSends[0].Status)
+
+	return nil
+}
+
+func resourceSendgridCancelScheduledSendUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	status := d.Get("status").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateScheduledSend(d.Id(), status)
+	})
+	if err != nil {
+		if errors.Is(err, sendgrid.ErrScheduledSendAlreadySent) {
+			// keep the previous status in the state, it can't be changed anymore.
+			d.Partial(true)
+		}
+
+		return errorToDiags("failed updating scheduled send", err)
+	}
+
+	return resourceSendgridCancelScheduledSendRead(ctx, d, m)
+}
+
+func resourceSendgridCancelScheduledSendDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteScheduledSend(d.Id())
+	})
+	// once the sends went out, there is nothing left to resume.
+	if err != nil && !errors.Is(err, sendgrid.ErrScheduledSendAlreadySent) {
+		return errorToDiags("failed deleting scheduled send", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridCancelScheduledSendImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("batch_id", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
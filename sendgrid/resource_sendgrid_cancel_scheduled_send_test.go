@@ -0,0 +1,77 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridCancelScheduledSendBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridCancelScheduledSendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridCancelScheduledSendConfigBasic("pause"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_cancel_scheduled_send.cancel", "status", "pause"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridCancelScheduledSendConfigBasic("cancel"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_cancel_scheduled_send.cancel", "status", "cancel"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_cancel_scheduled_send.cancel",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSendgridCancelScheduledSendReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_cancel_scheduled_send", "batch", map[string]interface{}{
+		"batch_id": "batch",
+		"status":   "cancel",
+	})
+}
+
+func testAccCheckSendgridCancelScheduledSendDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_cancel_scheduled_send" {
+			continue
+		}
+
+		scheduledSends, requestErr := c.ReadScheduledSend(rs.Primary.ID)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if len(scheduledSends) != 0 {
+			return fmt.Errorf("scheduled send %s is still %s", rs.Primary.ID, scheduledSends[0].Status)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridCancelScheduledSendConfigBasic(status string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_batch_id" "batch" {
+	}
+
+	resource "sendgrid_cancel_scheduled_send" "cancel" {
+		batch_id = sendgrid_batch_id.batch.batch_id
+		status   = %q
+	}
+	`, status)
+}
@@ -0,0 +1,310 @@
+/*
+Provide a resource to manage a marketing contact.
+The custom fields are keyed by name, their values are converted to the type of the field:
+a Number field takes a number, e.g. "42", and a Date field takes a date, e.g. "2021-03-14" or "2021-03-14T15:09:26Z".
+Only the configured custom fields are managed, removing one from the configuration doesn't clear it.
+Sendgrid upserts the contacts asynchronously, the apply waits until the contact is upserted.
+Example Usage
+```hcl
+resource "sendgrid_contact" "example" {
+	email      = "john.doe@example.org"
+	first_name = "John"
+	last_name  = "Doe"
+	list_ids   = ["ca7a3796-e8a8-4029-9ccb-df8937940562"]
+
+	custom_fields = {
+		age          = "42"
+		signed_up_at = "2021-03-14"
+	}
+}
+```
+Import
+A contact can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_contact.example contactID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridContact() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridContactCreate,
+		ReadContext:   resourceSendgridContactRead,
+		UpdateContext: resourceSendgridContactUpdate,
+		DeleteContext: resourceSendgridContactDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the contact.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Description: "The first name of the contact.",
+				Optional:    true,
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Description: "The last name of the contact.",
+				Optional:    true,
+			},
+			"list_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the lists the contact belongs to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_fields": {
+				Type:        schema.TypeMap,
+				Description: "The values of the custom fields of the contact, keyed by name.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the contact was created at.",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the contact was last updated at.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// contactCustomFields converts the custom fields of the configuration, keyed by name and holding strings,
+// to the custom fields sent to Sendgrid, keyed by ID and typed as defined by their field_type.
+func contactCustomFields(
+	definitions []sendgrid.FieldDefinition,
+	values map[string]interface{},
+) (map[string]interface{}, error) {
+	byName := make(map[string]sendgrid.FieldDefinition, len(definitions))
+	for _, definition := range definitions {
+		byName[definition.Name] = definition
+	}
+
+	customFields := make(map[string]interface{}, len(values))
+
+	for name, value := range values {
+		definition, ok := byName[name]
+		if !ok {
+			return nil, contactCustomFieldNotFound(name)
+		}
+
+		coerced, err := definition.Coerce(value.(string))
+		if err != nil {
+			return nil, err
+		}
+
+		customFields[definition.ID] = coerced
+	}
+
+	return customFields, nil
+}
+
+// flattenContactCustomFields returns the managed custom fields of the contact as strings.
+// The configured value is kept when Sendgrid returns the same value in another format, e.g. 42 for "42.0".
+func flattenContactCustomFields(managed, values map[string]interface{}) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(managed))
+
+	for name, configured := range managed {
+		value, ok := values[name]
+		if !ok || value == nil {
+			continue
+		}
+
+		if sameContactCustomFieldValue(configured.(string), value) {
+			flattened[name] = configured
+		} else {
+			flattened[name] = fmt.Sprint(value)
+		}
+	}
+
+	return flattened
+}
+
+func sameContactCustomFieldValue(configured string, value interface{}) bool {
+	switch v := value.(type) {
+	case float64:
+		number, err := strconv.ParseFloat(configured, 64)
+
+		return err == nil && number == v
+	case string:
+		if configured == v {
+			return true
+		}
+
+		configuredDate, err := sendgrid.ParseFieldDate(configured)
+		if err != nil {
+			return false
+		}
+
+		date, err := sendgrid.ParseFieldDate(v)
+
+		return err == nil && date.Equal(configuredDate)
+	default:
+		return false
+	}
+}
+
+// upsertContact creates or updates the contact, and waits until Sendgrid upserted it,
+// i.e. until it's found with another update date than the given one.
+func upsertContact(
+	ctx context.Context,
+	d *schema.ResourceData,
+	timeoutKey string,
+	c *sendgrid.Client,
+	updatedAt string,
+) diag.Diagnostics {
+	definitions, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.Cached().ListFieldDefinitions()
+	})
+	if err != nil {
+		return errorToDiags("failed listing field definitions", err)
+	}
+
+	customFields, err := contactCustomFields(
+		definitions.([]sendgrid.FieldDefinition),
+		d.Get("custom_fields").(map[string]interface{}),
+	)
+	if err != nil {
+		return errorToDiags("failed converting custom fields", err)
+	}
+
+	email := d.Get("email").(string)
+
+	_, err = c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpsertContacts(stringSetToSlice(d.Get("list_ids").(*schema.Set)), []sendgrid.ContactRequest{{
+			Email:        email,
+			FirstName:    d.Get("first_name").(string),
+			LastName:     d.Get("last_name").(string),
+			CustomFields: customFields,
+		}})
+	})
+	if err != nil {
+		return errorToDiags("failed upserting contact", err)
+	}
+
+	err = resource.RetryContext(ctx, d.Timeout(timeoutKey), func() *resource.RetryError {
+		contact, requestErr := c.ReadContactByEmail(email)
+		if isNotFound(requestErr) {
+			return resource.RetryableError(ErrContactPending)
+		}
+
+		if requestErr.Err != nil {
+			return resource.NonRetryableError(requestErr)
+		}
+
+		if contact.UpdatedAt == updatedAt {
+			return resource.RetryableError(ErrContactPending)
+		}
+
+		d.SetId(contact.ID)
+
+		return nil
+	})
+	if err != nil {
+		return errorToDiags("failed waiting for the contact to be upserted", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridContactCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if diags := upsertContact(ctx, d, schema.TimeoutCreate, c, ""); diags.HasError() {
+		return diags
+	}
+
+	return resourceSendgridContactRead(ctx, d, m)
+}
+
+func resourceSendgridContactRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	contact, requestErr := c.ReadContact(d.Id())
+	if isNotFound(requestErr) {
+		// the contact was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading contact", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("email", contact.Email)
+	//nolint:errcheck
+	d.Set("first_name", contact.FirstName)
+	//nolint:errcheck
+	d.Set("last_name", contact.LastName)
+	//nolint:errcheck
+	d.Set("list_ids", contact.ListIDs)
+	//nolint:errcheck
+	d.Set("custom_fields", flattenContactCustomFields(
+		d.Get("custom_fields").(map[string]interface{}), contact.CustomFields,
+	))
+	//nolint:errcheck
+	d.Set("created_at", contact.CreatedAt)
+	//nolint:errcheck
+	d.Set("updated_at", contact.UpdatedAt)
+
+	return nil
+}
+
+func resourceSendgridContactUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if d.HasChanges("first_name", "last_name", "list_ids", "custom_fields") {
+		if diags := upsertContact(ctx, d, schema.TimeoutUpdate, c, d.Get("updated_at").(string)); diags.HasError() {
+			return diags
+		}
+	}
+
+	// upserting a contact only adds it to lists.
+	oldListIDs, newListIDs := d.GetChange("list_ids")
+	for _, listID := range oldListIDs.(*schema.Set).Difference(newListIDs.(*schema.Set)).List() {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.RemoveContactFromList(listID.(string), d.Id())
+		})
+		if err != nil {
+			return errorToDiags("failed removing contact from list", err)
+		}
+	}
+
+	return resourceSendgridContactRead(ctx, d, m)
+}
+
+func resourceSendgridContactDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteContacts([]string{d.Id()})
+	})
+	if err != nil {
+		return errorToDiags("failed deleting contact", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,90 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridContactBasic(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridContactConfigBasic(email, "John"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_contact.contact", "email", email),
+					resource.TestCheckResourceAttr("sendgrid_contact.contact", "first_name", "John"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridContactConfigBasic(email, "Jane"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_contact.contact", "first_name", "Jane"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridContactUnknownCustomField(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridContactDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_contact" "contact" {
+					email = %q
+
+					custom_fields = {
+						terraform_undefined_field = "42"
+					}
+				}
+				`, email),
+				ExpectError: regexp.MustCompile("custom field isn't defined"),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridContactDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_contact" {
+			continue
+		}
+
+		// the contacts are deleted asynchronously, only the deletions which failed are reported.
+		_, requestErr := c.ReadContact(rs.Primary.ID)
+		if requestErr.Err != nil && requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("failed reading contact %s: %w", rs.Primary.ID, requestErr)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridContactConfigBasic(email, firstName string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_contact" "contact" {
+		email      = %q
+		first_name = %q
+		last_name  = "Doe"
+	}
+	`, email, firstName)
+}
@@ -0,0 +1,246 @@
+/*
+Provide a resource to manage a design of the Design Library, reusable by the single sends.
+A design can be duplicated from a pre-built design of Sendgrid with `from_prebuilt_id`,
+its content is then the one of the pre-built design, overridden by the fields which are set.
+The pre-built design is only duplicated on creation, an imported design isn't duplicated again.
+Example Usage
+```hcl
+resource "sendgrid_design" "newsletter" {
+	name         = "newsletter"
+	subject      = "Our news"
+	html_content = file("${path.module}/newsletter.html")
+	categories   = ["newsletter"]
+}
+
+resource "sendgrid_design" "from_prebuilt" {
+	name             = "announcement"
+	editor           = "design"
+	subject          = "Announcement"
+	from_prebuilt_id = "6ad69134-f165-4b5c-9c4b-8d2ad0ca1a69"
+}
+```
+Import
+A design can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_design.newsletter designID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridDesign() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridDesignCreate,
+		ReadContext:   resourceSendgridDesignRead,
+		UpdateContext: resourceSendgridDesignUpdate,
+		DeleteContext: resourceSendgridDesignDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the design.",
+				Required:    true,
+			},
+			"from_prebuilt_id": {
+				Type:             schema.TypeString,
+				Description:      "The ID of a pre-built design of Sendgrid to duplicate on creation.",
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"from_design_id"},
+				DiffSuppressFunc: suppressImportedDesignOrigin,
+			},
+			"from_design_id": {
+				Type:             schema.TypeString,
+				Description:      "The ID of a pre-built design of Sendgrid to duplicate on creation.",
+				Optional:         true,
+				ForceNew:         true,
+				Deprecated:       "use from_prebuilt_id instead",
+				ConflictsWith:    []string{"from_prebuilt_id"},
+				DiffSuppressFunc: suppressImportedDesignOrigin,
+			},
+			"html_content": {
+				Type:        schema.TypeString,
+				Description: "The HTML content of the design.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"plain_content": {
+				Type:        schema.TypeString,
+				Description: "The plain text content of the design.",
+				Optional:    true,
+				Computed:    true,
+				// Sendgrid derives the plain content from the HTML content when generate_plain_content is true.
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Get("generate_plain_content").(bool)
+				},
+			},
+			"generate_plain_content": {
+				Type:        schema.TypeBool,
+				Description: "Generate the plain text content from the HTML content.",
+				Optional:    true,
+				Default:     true,
+			},
+			"subject": {
+				Type:        schema.TypeString,
+				Description: "The subject of the emails using the design.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"editor": {
+				Type:         schema.TypeString,
+				Description:  "The editor used to build the design: code (default) or design.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "code",
+				ValidateFunc: validation.StringInSlice([]string{"code", "design"}, false),
+			},
+			"categories": {
+				Type:        schema.TypeSet,
+				Description: "The categories of the design, to find it in the Design Library.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"thumbnail_url": {
+				Type:        schema.TypeString,
+				Description: "A thumbnail preview of the design.",
+				Computed:    true,
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Description: "The date and time the design was last updated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func designFromResourceData(d *schema.ResourceData) sendgrid.Design {
+	return sendgrid.Design{
+		ID:                   d.Id(),
+		Name:                 d.Get("name").(string),
+		HTMLContent:          d.Get("html_content").(string),
+		PlainContent:         d.Get("plain_content").(string),
+		GeneratePlainContent: d.Get("generate_plain_content").(bool),
+		Subject:              d.Get("subject").(string),
+		Editor:               d.Get("editor").(string),
+		Categories:           stringSetToSlice(d.Get("categories").(*schema.Set)),
+	}
+}
+
+// suppressImportedDesignOrigin ignores the pre-built design of an imported design, which Sendgrid doesn't return,
+// instead of duplicating it again.
+func suppressImportedDesignOrigin(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
+
+func resourceSendgridDesignCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	prebuiltID := d.Get("from_prebuilt_id").(string)
+	if prebuiltID == "" {
+		prebuiltID = d.Get("from_design_id").(string)
+	}
+
+	if prebuiltID == "" {
+		design, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+			return c.CreateDesign(designFromResourceData(d))
+		})
+		if err != nil {
+			return errorToDiags("failed creating design", err)
+		}
+
+		d.SetId(design.(*sendgrid.Design).ID)
+
+		return resourceSendgridDesignRead(ctx, d, m)
+	}
+
+	design, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.DuplicatePrebuiltDesign(prebuiltID, d.Get("name").(string), d.Get("editor").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed duplicating design", err)
+	}
+
+	d.SetId(design.(*sendgrid.Design).ID)
+
+	// the duplicate has the content of the pre-built design, only the fields set are overridden.
+	return resourceSendgridDesignUpdate(ctx, d, m)
+}
+
+func resourceSendgridDesignRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	design, requestErr := c.ReadDesign(d.Id())
+	if isNotFound(requestErr) {
+		// the design was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading design", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", design.Name)
+	//nolint:errcheck
+	d.Set("html_content", design.HTMLContent)
+	//nolint:errcheck
+	d.Set("plain_content", design.PlainContent)
+	//nolint:errcheck
+	d.Set("generate_plain_content", design.GeneratePlainContent)
+	//nolint:errcheck
+	d.Set("subject", design.Subject)
+	//nolint:errcheck
+	d.Set("editor", design.Editor)
+	//nolint:errcheck
+	d.Set("categories", design.Categories)
+	//nolint:errcheck
+	d.Set("thumbnail_url", design.ThumbnailURL)
+	//nolint:errcheck
+	d.Set("updated_at", design.UpdatedAt)
+
+	return nil
+}
+
+func resourceSendgridDesignUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	design := designFromResourceData(d)
+	// the editor of a design can't be changed once it's created.
+	design.Editor = ""
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateDesign(design)
+	})
+	if err != nil {
+		return errorToDiags("failed updating design", err)
+	}
+
+	return resourceSendgridDesignRead(ctx, d, m)
+}
+
+func resourceSendgridDesignDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteDesign(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting design", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridDesignBasic(t *testing.T) {
+	name := "terraform-design-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDesignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDesignConfigBasic(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_design.design", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_design.design", "subject", "first"),
+					resource.TestCheckResourceAttrSet("sendgrid_design.design", "thumbnail_url"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridDesignConfigBasic(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_design.design", "subject", "second"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_design.design",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridDesignFromPrebuilt(t *testing.T) {
+	prebuiltID := os.Getenv("SENDGRID_TEST_PREBUILT_DESIGN")
+	if prebuiltID == "" {
+		t.Skip("SENDGRID_TEST_PREBUILT_DESIGN must be set to the ID of a pre-built design")
+	}
+
+	name := "terraform-design-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDesignDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDesignConfigFromPrebuilt(name, prebuiltID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_design.design", "name", name),
+					// the subject overrides the one of the pre-built design.
+					resource.TestCheckResourceAttr("sendgrid_design.design", "subject", "from prebuilt"),
+					resource.TestCheckResourceAttrSet("sendgrid_design.design", "html_content"),
+				),
+			},
+			{
+				// the pre-built design isn't duplicated again.
+				Config:   testAccCheckSendgridDesignConfigFromPrebuilt(name, prebuiltID),
+				PlanOnly: true,
+			},
+			{
+				ResourceName:            "sendgrid_design.design",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from_prebuilt_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridDesignDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_design" {
+			continue
+		}
+
+		_, requestErr := c.ReadDesign(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("design %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridDesignConfigBasic(name, subject string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_design" "design" {
+		name         = %q
+		subject      = %q
+		html_content = "<p>Hello</p>"
+		categories   = ["terraform"]
+	}
+	`, name, subject)
+}
+
+func testAccCheckSendgridDesignConfigFromPrebuilt(name, prebuiltID string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_design" "design" {
+		name             = %q
+		subject          = "from prebuilt"
+		from_prebuilt_id = %q
+	}
+	`, name, prebuiltID)
+}
@@ -0,0 +1,260 @@
+/*
+Provide a resource to authenticate a domain, and retrieve the DNS records to create to validate it.
+If the domain is already authenticated with the same subdomain, e.g. because an apply was interrupted,
+the existing authentication is adopted instead of creating a new one with new DNS records.
+Example Usage
+```hcl
+resource "sendgrid_domain_authentication" "example" {
+	domain             = "example.org"
+	subdomain          = "mail"
+	automatic_security = true
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(sendgrid_domain_authentication.example.dns)
+	zone_id = var.zone_id
+	type    = upper(sendgrid_domain_authentication.example.dns[count.index].type)
+	name    = sendgrid_domain_authentication.example.dns[count.index].host
+	records = [sendgrid_domain_authentication.example.dns[count.index].data]
+	ttl     = 300
+}
+```
+Import
+An authenticated domain can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_domain_authentication.example domainAuthenticationID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridDomainAuthentication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridDomainAuthenticationCreate,
+		ReadContext:   resourceSendgridDomainAuthenticationRead,
+		UpdateContext: resourceSendgridDomainAuthenticationUpdate,
+		DeleteContext: resourceSendgridDomainAuthenticationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain to authenticate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain used to send emails, generated by Sendgrid if not set.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"ips": {
+				Type:        schema.TypeSet,
+				Description: "The IP addresses to include in the custom SPF record.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"custom_spf": {
+				Type:        schema.TypeBool,
+				Description: "Whether to use a custom SPF record, requires automatic_security to be false.",
+				Optional:    true,
+				Default:     false,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether the domain is the default authenticated domain.",
+				Optional:    true,
+				Default:     false,
+			},
+			"automatic_security": {
+				Type:        schema.TypeBool,
+				Description: "Whether Sendgrid manages the SPF and DKIM records of the domain.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+			"custom_dkim_selector": {
+				Type:        schema.TypeString,
+				Description: "A custom DKIM selector of 3 characters.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the DNS records of the domain were validated.",
+				Computed:    true,
+			},
+			"dns": dnsRecordsSchema("The DNS records to create to authenticate the domain"),
+		},
+	}
+}
+
+// existingDomainAuthentication returns the authentication of the domain with the same subdomain, if any,
+// so that it's adopted rather than creating a new authentication, with new DNS records, on every apply.
+func existingDomainAuthentication(
+	c *sendgrid.Client,
+	domain, subdomain string,
+) (*sendgrid.DomainAuthentication, diag.Diagnostics) {
+	domains, requestErr := c.ListDomainAuthentications(domain)
+	if requestErr.Err != nil {
+		return nil, diag.Diagnostics{requestErrorToDiag("failed listing domain authentications", requestErr)}
+	}
+
+	matches := matchDomainAuthentications(domains, domain, subdomain)
+
+	switch len(matches) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &matches[0], nil
+	default:
+		return nil, diag.FromErr(domainAuthenticationAmbiguous(domain))
+	}
+}
+
+func resourceSendgridDomainAuthenticationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	domain := d.Get("domain").(string)
+	subdomain := d.Get("subdomain").(string)
+
+	existing, diags := existingDomainAuthentication(c, domain, subdomain)
+	if diags.HasError() {
+		return diags
+	}
+
+	if existing != nil {
+		d.SetId(strconv.FormatInt(existing.ID, 10))
+
+		if existing.Default != d.Get("default").(bool) || existing.CustomSPF != d.Get("custom_spf").(bool) {
+			return resourceSendgridDomainAuthenticationUpdate(ctx, d, m)
+		}
+
+		return resourceSendgridDomainAuthenticationRead(ctx, d, m)
+	}
+
+	authentication, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateDomainAuthentication(sendgrid.DomainAuthenticationRequest{
+			Domain:             domain,
+			Subdomain:          subdomain,
+			IPs:                stringSetToSlice(d.Get("ips").(*schema.Set)),
+			CustomSPF:          d.Get("custom_spf").(bool),
+			Default:            d.Get("default").(bool),
+			AutomaticSecurity:  d.Get("automatic_security").(bool),
+			CustomDKIMSelector: d.Get("custom_dkim_selector").(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed creating domain authentication", err)
+	}
+
+	d.SetId(strconv.FormatInt(authentication.(*sendgrid.DomainAuthentication).ID, 10))
+
+	err = waitUntilReadable(ctx, func() (bool, error) {
+		_, requestErr := c.ReadDomainAuthentication(d.Id())
+		if isNotFound(requestErr) {
+			return false, nil
+		}
+
+		if requestErr.Err != nil {
+			return false, requestErr
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return errorToDiags("failed waiting for the domain authentication to be readable", err)
+	}
+
+	return resourceSendgridDomainAuthenticationRead(ctx, d, m)
+}
+
+func resourceSendgridDomainAuthenticationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	authentication, requestErr := c.ReadDomainAuthentication(d.Id())
+	if isNotFound(requestErr) {
+		// the domain authentication was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading domain authentication", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("domain", authentication.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", authentication.Subdomain)
+	//nolint:errcheck
+	d.Set("ips", authentication.IPs)
+	//nolint:errcheck
+	d.Set("custom_spf", authentication.CustomSPF)
+	//nolint:errcheck
+	d.Set("default", authentication.Default)
+	//nolint:errcheck
+	d.Set("automatic_security", authentication.AutomaticSecurity)
+	//nolint:errcheck
+	d.Set("valid", authentication.Valid)
+	//nolint:errcheck
+	d.Set("dns", flattenDNSRecords(dnsRecordsValues(authentication.DNS)))
+
+	return nil
+}
+
+func resourceSendgridDomainAuthenticationUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateDomainAuthentication(d.Id(), d.Get("default").(bool), d.Get("custom_spf").(bool))
+	})
+	if err != nil {
+		return errorToDiags("failed updating domain authentication", err)
+	}
+
+	return resourceSendgridDomainAuthenticationRead(ctx, d, m)
+}
+
+func resourceSendgridDomainAuthenticationDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteDomainAuthentication(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting domain authentication", err)
+	}
+
+	return nil
+}
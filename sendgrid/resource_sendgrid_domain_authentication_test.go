@@ -0,0 +1,106 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridDomainAuthenticationBasic(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDomainAuthenticationDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridDomainAuthenticationConfigBasic(domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.domain", "domain", domain),
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication.domain", "subdomain", "mail"),
+					resource.TestCheckResourceAttrSet("sendgrid_domain_authentication.domain", "dns.#"),
+				),
+			},
+			{
+				// a second apply must neither regenerate the DNS records nor reorder them.
+				Config:   testAccCheckSendgridDomainAuthenticationConfigBasic(domain),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridDomainAuthenticationAdoptExisting(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridDomainAuthenticationDestroy,
+		Steps: []resource.TestStep{
+			{
+				// simulate an apply interrupted after the domain was authenticated.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+
+					authentication, requestErr := c.CreateDomainAuthentication(sendgrid.DomainAuthenticationRequest{
+						Domain:            domain,
+						Subdomain:         "mail",
+						AutomaticSecurity: true,
+					})
+					if requestErr.Err != nil {
+						t.Fatalf("failed creating domain authentication: %s", requestErr.Err)
+					}
+
+					existingID = strconv.FormatInt(authentication.ID, 10)
+				},
+				Config: testAccCheckSendgridDomainAuthenticationConfigBasic(domain),
+				Check: resource.ComposeTestCheckFunc(
+					func(s *terraform.State) error {
+						rs := s.RootModule().Resources["sendgrid_domain_authentication.domain"]
+						if rs.Primary.ID != existingID {
+							return fmt.Errorf("expected domain authentication %s to be adopted, got %s", existingID, rs.Primary.ID)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridDomainAuthenticationDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_domain_authentication" {
+			continue
+		}
+
+		_, requestErr := c.ReadDomainAuthentication(rs.Primary.ID)
+		if requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("domain authentication %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridDomainAuthenticationConfigBasic(domain string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_domain_authentication" "domain" {
+		domain    = %q
+		subdomain = "mail"
+	}
+	`, domain)
+}
@@ -0,0 +1,167 @@
+/*
+Provide a resource to validate an authenticated domain, once its DNS records are created.
+As the DNS records can take a while to propagate, the validation is retried until the `validation_timeout`,
+after which the records which failed the validation are reported.
+Destroying the resource doesn't invalidate the domain, it is only removed from the state.
+Example Usage
+```hcl
+resource "sendgrid_domain_authentication" "example" {
+	domain    = "example.org"
+	subdomain = "mail"
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(sendgrid_domain_authentication.example.dns)
+	zone_id = var.zone_id
+	type    = upper(sendgrid_domain_authentication.example.dns[count.index].type)
+	name    = sendgrid_domain_authentication.example.dns[count.index].host
+	records = [sendgrid_domain_authentication.example.dns[count.index].data]
+	ttl     = 300
+}
+
+resource "sendgrid_domain_authentication_validation" "example" {
+	domain_id          = sendgrid_domain_authentication.example.id
+	validation_timeout = "15m"
+
+	depends_on = [aws_route53_record.sendgrid]
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// defaultDomainValidationTimeout is the time given to the DNS records of a domain to propagate.
+const defaultDomainValidationTimeout = 10 * time.Minute
+
+func resourceSendgridDomainAuthenticationValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridDomainAuthenticationValidationCreate,
+		ReadContext:   resourceSendgridDomainAuthenticationValidationRead,
+		DeleteContext: resourceSendgridDomainAuthenticationValidationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"domain_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the authenticated domain to validate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"validation_timeout": {
+				Type:         schema.TypeString,
+				Description:  "How long to retry the validation while the DNS records propagate, e.g. 15m.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultDomainValidationTimeout.String(),
+				ValidateFunc: validateDuration,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the DNS records of the domain were validated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// domainValidationFailures returns the reasons why the DNS records of a domain failed the validation.
+func domainValidationFailures(validation *sendgrid.DomainAuthenticationValidation) []string {
+	var failures []string
+
+	for record, result := range validation.ValidationResults {
+		if !result.Valid {
+			failures = append(failures, record+": "+result.Reason)
+		}
+	}
+
+	sort.Strings(failures)
+
+	return failures
+}
+
+func resourceSendgridDomainAuthenticationValidationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	id := d.Get("domain_id").(string)
+	timeout, _ := time.ParseDuration(d.Get("validation_timeout").(string))
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		validation, err := c.Retry(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return c.ValidateDomainAuthentication(id)
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if v := validation.(*sendgrid.DomainAuthenticationValidation); !v.Valid {
+			return resource.RetryableError(domainAuthenticationNotValidated(id, domainValidationFailures(v)))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorToDiags("failed validating domain authentication", err)
+	}
+
+	d.SetId(id)
+
+	return resourceSendgridDomainAuthenticationValidationRead(ctx, d, m)
+}
+
+func resourceSendgridDomainAuthenticationValidationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	authentication, requestErr := c.ReadDomainAuthentication(d.Id())
+	if isNotFound(requestErr) {
+		// the domain authentication was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading domain authentication", requestErr)}
+	}
+
+	if !authentication.Valid {
+		// the domain isn't valid anymore, it has to be validated again.
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("domain_id", d.Id())
+	//nolint:errcheck
+	d.Set("valid", authentication.Valid)
+
+	return nil
+}
+
+func resourceSendgridDomainAuthenticationValidationDelete(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// a validation can't be undone, it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
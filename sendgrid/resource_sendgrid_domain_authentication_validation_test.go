@@ -0,0 +1,38 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridDomainAuthenticationValidationBasic(t *testing.T) {
+	domain := os.Getenv("SENDGRID_TEST_DOMAIN")
+	if domain == "" {
+		t.Skip("SENDGRID_TEST_DOMAIN must be set to a domain authenticated on the account")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				data "sendgrid_domain_authentication" "domain" {
+					domain = %q
+				}
+
+				resource "sendgrid_domain_authentication_validation" "validation" {
+					domain_id          = data.sendgrid_domain_authentication.domain.domain_id
+					validation_timeout = "1m"
+				}
+				`, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_domain_authentication_validation.validation", "valid", "true"),
+				),
+			},
+		},
+	})
+}
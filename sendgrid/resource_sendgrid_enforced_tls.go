@@ -0,0 +1,244 @@
+/*
+Provide a resource to manage the enforced TLS setting: the emails are only delivered to the recipients
+supporting TLS, and presenting a valid certificate when `require_valid_cert` is set.
+Destroying the resource resets the setting to the Sendgrid defaults, which don't enforce TLS, with a warning.
+Set `reset_on_destroy` to false to keep the setting as it is, e.g. when the account is shared with other modules.
+As resetting `require_valid_cert` is the most dangerous, it must also be acknowledged
+with `acknowledge_valid_cert_reset`, otherwise the plan and the destroy fail.
+Example Usage
+```hcl
+resource "sendgrid_enforced_tls" "enforced_tls" {
+	require_tls        = true
+	require_valid_cert = true
+	reset_on_destroy   = false
+}
+```
+Import
+The enforced TLS setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_enforced_tls.enforced_tls enforced_tls
+```
+The enforced TLS setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_enforced_tls.subuser_enforced_tls subUserName/enforced_tls
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridEnforcedTLS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridEnforcedTLSCreate,
+		ReadContext:   resourceSendgridEnforcedTLSRead,
+		UpdateContext: resourceSendgridEnforcedTLSUpdate,
+		DeleteContext: resourceSendgridEnforcedTLSDelete,
+		CustomizeDiff: resourceSendgridEnforcedTLSCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the enforced TLS setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"require_tls": {
+				Type:        schema.TypeBool,
+				Description: "Only deliver the emails to the recipients supporting TLS 1.1 or higher.",
+				Optional:    true,
+				Default:     true,
+			},
+			"require_valid_cert": {
+				Type:        schema.TypeBool,
+				Description: "Only deliver the emails to the recipients presenting a valid certificate.",
+				Optional:    true,
+				Default:     false,
+			},
+			"reset_on_destroy": {
+				Type:        schema.TypeBool,
+				Description: "Reset the setting to the Sendgrid defaults, which don't enforce TLS, when destroyed.",
+				Optional:    true,
+				Default:     true,
+			},
+			"acknowledge_valid_cert_reset": {
+				Type:        schema.TypeBool,
+				Description: "Allow the destroy to stop requiring valid certificates, when reset_on_destroy is set.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// enforcedTLSResetAllowed tells whether destroying the setting may reset it: stopping to require valid certificates
+// must be acknowledged.
+func enforcedTLSResetAllowed(requireValidCert, resetOnDestroy, acknowledged bool) error {
+	if requireValidCert && resetOnDestroy && !acknowledged {
+		return ErrValidCertResetNotAcknowledged
+	}
+
+	return nil
+}
+
+// resourceSendgridEnforcedTLSCustomizeDiff fails the plan when the setting couldn't be destroyed,
+// instead of the destroy failing later.
+func resourceSendgridEnforcedTLSCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// the values known after apply can't be checked yet.
+	for _, key := range []string{"require_valid_cert", "reset_on_destroy", "acknowledge_valid_cert_reset"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	return enforcedTLSResetAllowed(
+		d.Get("require_valid_cert").(bool),
+		d.Get("reset_on_destroy").(bool),
+		d.Get("acknowledge_valid_cert_reset").(bool),
+	)
+}
+
+// enforcedTLSDisabledWarnings warns about each enforcement the new setting disables.
+func enforcedTLSDisabledWarnings(previous, next sendgrid.EnforcedTLS) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if previous.RequireTLS && !next.RequireTLS {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "TLS enforcement disabled",
+			Detail:   "the emails are now delivered to the recipients which don't support TLS",
+		})
+	}
+
+	if previous.RequireValidCert && !next.RequireValidCert {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "valid certificate enforcement disabled",
+			Detail:   "the emails are now delivered to the recipients which don't present a valid certificate",
+		})
+	}
+
+	return diags
+}
+
+func updateEnforcedTLS(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.EnforcedTLS,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEnforcedTLS(setting)
+	})
+
+	return err
+}
+
+func enforcedTLSFromResourceData(d *schema.ResourceData) sendgrid.EnforcedTLS {
+	return sendgrid.EnforcedTLS{
+		RequireTLS:       d.Get("require_tls").(bool),
+		RequireValidCert: d.Get("require_valid_cert").(bool),
+	}
+}
+
+func resourceSendgridEnforcedTLSCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateEnforcedTLS(ctx, c, d, schema.TimeoutCreate, enforcedTLSFromResourceData(d)); err != nil {
+		return errorToDiags("failed creating enforced TLS setting", err)
+	}
+
+	d.SetId("enforced_tls")
+
+	return resourceSendgridEnforcedTLSRead(ctx, d, m)
+}
+
+func resourceSendgridEnforcedTLSRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadEnforcedTLS()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading enforced TLS setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("require_tls", setting.RequireTLS)
+	//nolint:errcheck
+	d.Set("require_valid_cert", setting.RequireValidCert)
+
+	return nil
+}
+
+func resourceSendgridEnforcedTLSUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if !d.HasChanges("require_tls", "require_valid_cert") {
+		// only the behavior on destroy changed.
+		return resourceSendgridEnforcedTLSRead(ctx, d, m)
+	}
+
+	oldRequireTLS, _ := d.GetChange("require_tls")
+	oldRequireValidCert, _ := d.GetChange("require_valid_cert")
+	setting := enforcedTLSFromResourceData(d)
+
+	if err := updateEnforcedTLS(ctx, c, d, schema.TimeoutUpdate, setting); err != nil {
+		return errorToDiags("failed updating enforced TLS setting", err)
+	}
+
+	diags := enforcedTLSDisabledWarnings(sendgrid.EnforcedTLS{
+		RequireTLS:       oldRequireTLS.(bool),
+		RequireValidCert: oldRequireValidCert.(bool),
+	}, setting)
+
+	return append(diags, resourceSendgridEnforcedTLSRead(ctx, d, m)...)
+}
+
+func resourceSendgridEnforcedTLSDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if !d.Get("reset_on_destroy").(bool) {
+		// the setting is kept as it is, it's only removed from the state.
+		return nil
+	}
+
+	// the destroy doesn't plan through the CustomizeDiff, the acknowledgment is checked again.
+	setting := enforcedTLSFromResourceData(d)
+	if err := enforcedTLSResetAllowed(
+		setting.RequireValidCert, true, d.Get("acknowledge_valid_cert_reset").(bool),
+	); err != nil {
+		return errorToDiags("failed deleting enforced TLS setting", err)
+	}
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateEnforcedTLS(ctx, c, d, schema.TimeoutDelete, sendgrid.EnforcedTLS{}); err != nil {
+		return errorToDiags("failed deleting enforced TLS setting", err)
+	}
+
+	return enforcedTLSDisabledWarnings(setting, sendgrid.EnforcedTLS{})
+}
@@ -0,0 +1,134 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridEnforcedTLSBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEnforcedTLSConfig(false, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.enforced_tls", "require_tls", "true"),
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.enforced_tls", "require_valid_cert", "false"),
+				),
+			},
+			{
+				// resetting require_valid_cert on destroy must be acknowledged.
+				Config:      testAccCheckSendgridEnforcedTLSConfig(true, ""),
+				ExpectError: regexp.MustCompile("acknowledge_valid_cert_reset"),
+			},
+			{
+				Config: testAccCheckSendgridEnforcedTLSConfig(true, "acknowledge_valid_cert_reset = true"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_enforced_tls.enforced_tls", "require_valid_cert", "true"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_enforced_tls.enforced_tls",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_on_destroy", "acknowledge_valid_cert_reset"},
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridEnforcedTLSConfig(requireValidCert bool, extra string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_enforced_tls" "enforced_tls" {
+		require_tls        = true
+		require_valid_cert = %t
+		%s
+	}
+	`, requireValidCert, extra)
+}
+
+func TestSendgridEnforcedTLSDelete(t *testing.T) {
+	tests := map[string]struct {
+		raw      map[string]interface{}
+		reset    bool
+		warnings int
+		err      bool
+	}{
+		"reset with a warning": {
+			raw:      map[string]interface{}{"require_tls": true},
+			reset:    true,
+			warnings: 1,
+		},
+		"kept": {
+			raw: map[string]interface{}{"require_tls": true, "require_valid_cert": true, "reset_on_destroy": false},
+		},
+		"valid cert reset not acknowledged": {
+			raw: map[string]interface{}{"require_tls": true, "require_valid_cert": true},
+			err: true,
+		},
+		"valid cert reset acknowledged": {
+			raw: map[string]interface{}{
+				"require_tls": true, "require_valid_cert": true, "acknowledge_valid_cert_reset": true,
+			},
+			reset:    true,
+			warnings: 2,
+		},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			var reset int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch || r.URL.Path != "/user/settings/enforced_tls" {
+					t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+				}
+
+				atomic.StoreInt32(&reset, 1)
+				fmt.Fprint(w, `{"require_tls": false, "require_valid_cert": false}`)
+			}))
+			defer server.Close()
+
+			c := sendgrid.NewClient("key", server.URL, "")
+
+			r := provider.Provider().ResourcesMap["sendgrid_enforced_tls"]
+			d := schema.TestResourceDataRaw(t, r.Schema, test.raw)
+			d.SetId("enforced_tls")
+
+			diags := r.DeleteContext(context.Background(), d, c)
+			if diags.HasError() != test.err {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+
+			if (atomic.LoadInt32(&reset) == 1) != test.reset {
+				t.Fatalf("expected the setting reset to be %t", test.reset)
+			}
+
+			warnings := 0
+
+			for _, d := range diags {
+				if d.Severity == diag.Warning {
+					warnings++
+				}
+			}
+
+			if warnings != test.warnings {
+				t.Errorf("expected %d warnings, got: %v", test.warnings, diags)
+			}
+		})
+	}
+}
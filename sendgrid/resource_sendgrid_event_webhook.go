@@ -0,0 +1,370 @@
+/*
+Provide a resource to manage the event webhook of the account: the URL the events are posted to,
+and the kinds of events posted. The event webhook exists once per account, so it can be imported
+with the fixed ID event_webhook to bring an existing configuration under management.
+The events can be posted with OAuth, by setting `oauth_client_id`, `oauth_client_secret` and `oauth_token_url`,
+removing them disables OAuth. The client secret is write-only: Sendgrid never returns it, it's kept as configured.
+The event webhook of a subuser is managed with `sub_user_on_behalf_of`, it's distinct from the one of the account,
+so both can be managed in the same configuration without overwriting each other.
+Destroying the resource disables the event webhook.
+Example Usage
+```hcl
+resource "sendgrid_event_webhook" "main" {
+	enabled   = true
+	url       = "https://example.org/sendgrid/events"
+	delivered = true
+	bounce    = true
+	dropped   = true
+}
+```
+The event webhook of a subuser can be managed from the parent account, e.g.
+```hcl
+resource "sendgrid_event_webhook" "subuser" {
+	sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+	url                   = "https://example.org/sendgrid/subuser-events"
+	delivered             = true
+}
+```
+Import
+The event webhook can be imported, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.main event_webhook
+```
+The event webhook of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.subuser subUserName/event_webhook
+```
+When the provider sets `default_on_behalf_of`, `event_webhook` imports the event webhook of this subuser,
+and the event webhook of the account is imported with an empty username, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook.main /event_webhook
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// eventWebhookID is the ID of the event webhook, which exists once per account and per subuser.
+const eventWebhookID = "event_webhook"
+
+func resourceSendgridEventWebhook() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridEventWebhookCreate,
+		ReadContext:   resourceSendgridEventWebhookRead,
+		UpdateContext: resourceSendgridEventWebhookUpdate,
+		DeleteContext: resourceSendgridEventWebhookDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridEventWebhookImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the event webhook of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the events are posted to the URL.",
+				Optional:    true,
+				Default:     true,
+			},
+			"url": {
+				Type:             schema.TypeString,
+				Description:      "The URL the events are posted to.",
+				Required:         true,
+				DiffSuppressFunc: suppressEquivalentURL,
+			},
+			"group_resubscribe": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of recipients resubscribing to a suppression group.",
+				Optional:    true,
+				Default:     false,
+			},
+			"delivered": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails delivered.",
+				Optional:    true,
+				Default:     false,
+			},
+			"group_unsubscribe": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of recipients unsubscribing from a suppression group.",
+				Optional:    true,
+				Default:     false,
+			},
+			"spam_report": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of recipients marking an email as spam.",
+				Optional:    true,
+				Default:     false,
+			},
+			"bounce": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails bounced.",
+				Optional:    true,
+				Default:     false,
+			},
+			"deferred": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails deferred by the receiving server.",
+				Optional:    true,
+				Default:     false,
+			},
+			"unsubscribe": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of recipients unsubscribing from all the emails.",
+				Optional:    true,
+				Default:     false,
+			},
+			"processed": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails processed by Sendgrid.",
+				Optional:    true,
+				Default:     false,
+			},
+			"open": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails opened.",
+				Optional:    true,
+				Default:     false,
+			},
+			"click": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of links clicked.",
+				Optional:    true,
+				Default:     false,
+			},
+			"dropped": {
+				Type:        schema.TypeBool,
+				Description: "Whether to post the events of emails dropped by Sendgrid.",
+				Optional:    true,
+				Default:     false,
+			},
+			"oauth_client_id": {
+				Type:         schema.TypeString,
+				Description:  "The OAuth client ID the events are posted with, removing it disables OAuth.",
+				Optional:     true,
+				RequiredWith: []string{"oauth_client_secret", "oauth_token_url"},
+			},
+			"oauth_client_secret": {
+				Type: schema.TypeString,
+				Description: "The OAuth client secret the events are posted with. " +
+					"It isn't returned by Sendgrid, so its changes outside of Terraform aren't detected.",
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"oauth_client_id"},
+			},
+			"oauth_token_url": {
+				Type:         schema.TypeString,
+				Description:  "The URL the OAuth token is retrieved from.",
+				Optional:     true,
+				RequiredWith: []string{"oauth_client_id"},
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public key verifying the signature of the events, empty when they aren't signed.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// suppressEquivalentURL ignores the differences Sendgrid introduces when it normalizes the URLs of the webhooks:
+// the case of the scheme and of the host, and a trailing slash.
+func suppressEquivalentURL(_, old, new string, _ *schema.ResourceData) bool {
+	return normalizeURL(old) == normalizeURL(new)
+}
+
+func normalizeURL(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	u.Host = strings.ToLower(u.Host)
+	u.Path = strings.TrimSuffix(u.Path, "/")
+	u.RawPath = strings.TrimSuffix(u.RawPath, "/")
+
+	return u.String()
+}
+
+// eventWebhookClient returns the client managing the event webhook of the subuser, if any,
+// otherwise the one of the default subuser of the provider, unless parent_account is set.
+func eventWebhookClient(d *schema.ResourceData, m interface{}) *sendgrid.Client {
+	return onBehalfOfClient(d, m)
+}
+
+// eventWebhookResourceID returns the ID of the event webhook, prefixed by the username of its subuser, if any,
+// so that the event webhooks of the account and of its subusers don't share an ID.
+func eventWebhookResourceID(subUser string) string {
+	if subUser == "" {
+		return eventWebhookID
+	}
+
+	return subUser + "/" + eventWebhookID
+}
+
+func eventWebhookFromResourceData(d *schema.ResourceData) sendgrid.EventWebhook {
+	return sendgrid.EventWebhook{
+		Enabled:           d.Get("enabled").(bool),
+		URL:               d.Get("url").(string),
+		GroupResubscribe:  d.Get("group_resubscribe").(bool),
+		Delivered:         d.Get("delivered").(bool),
+		GroupUnsubscribe:  d.Get("group_unsubscribe").(bool),
+		SpamReport:        d.Get("spam_report").(bool),
+		Bounce:            d.Get("bounce").(bool),
+		Deferred:          d.Get("deferred").(bool),
+		Unsubscribe:       d.Get("unsubscribe").(bool),
+		Processed:         d.Get("processed").(bool),
+		Open:              d.Get("open").(bool),
+		Click:             d.Get("click").(bool),
+		Dropped:           d.Get("dropped").(bool),
+		OAuthClientID:     d.Get("oauth_client_id").(string),
+		OAuthClientSecret: d.Get("oauth_client_secret").(string),
+		OAuthTokenURL:     d.Get("oauth_token_url").(string),
+	}
+}
+
+func updateEventWebhook(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	webhook := eventWebhookFromResourceData(d)
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEventWebhook(webhook)
+	})
+	if err != nil {
+		return errorToDiags("failed updating event webhook", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridEventWebhookCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := eventWebhookClient(d, m).WithContext(ctx)
+
+	if diags := updateEventWebhook(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(eventWebhookResourceID(c.OnBehalfOf))
+
+	return resourceSendgridEventWebhookRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := eventWebhookClient(d, m).WithContext(ctx)
+
+	webhook, requestErr := c.ReadEventWebhook()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading event webhook", requestErr)}
+	}
+
+	signing, requestErr := c.ReadEventWebhookSigning()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading event webhook signing", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", webhook.Enabled)
+	//nolint:errcheck
+	d.Set("url", webhook.URL)
+	//nolint:errcheck
+	d.Set("group_resubscribe", webhook.GroupResubscribe)
+	//nolint:errcheck
+	d.Set("delivered", webhook.Delivered)
+	//nolint:errcheck
+	d.Set("group_unsubscribe", webhook.GroupUnsubscribe)
+	//nolint:errcheck
+	d.Set("spam_report", webhook.SpamReport)
+	//nolint:errcheck
+	d.Set("bounce", webhook.Bounce)
+	//nolint:errcheck
+	d.Set("deferred", webhook.Deferred)
+	//nolint:errcheck
+	d.Set("unsubscribe", webhook.Unsubscribe)
+	//nolint:errcheck
+	d.Set("processed", webhook.Processed)
+	//nolint:errcheck
+	d.Set("open", webhook.Open)
+	//nolint:errcheck
+	d.Set("click", webhook.Click)
+	//nolint:errcheck
+	d.Set("dropped", webhook.Dropped)
+	// oauth_client_secret isn't returned by Sendgrid, the configured one is kept.
+	//nolint:errcheck
+	d.Set("oauth_client_id", webhook.OAuthClientID)
+	//nolint:errcheck
+	d.Set("oauth_token_url", webhook.OAuthTokenURL)
+	//nolint:errcheck
+	d.Set("public_key", signing.PublicKey)
+
+	return nil
+}
+
+func resourceSendgridEventWebhookUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := eventWebhookClient(d, m).WithContext(ctx)
+
+	if diags := updateEventWebhook(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceSendgridEventWebhookRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := eventWebhookClient(d, m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEventWebhook(sendgrid.EventWebhook{
+			Enabled: false,
+			URL:     d.Get("url").(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed disabling event webhook", err)
+	}
+
+	return nil
+}
+
+// resourceSendgridEventWebhookImport imports the event webhook, of a subuser when its username prefixes the ID.
+// The ID is then scoped to the subuser the event webhook is read on behalf of, e.g. the default one of the provider.
+func resourceSendgridEventWebhookImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("parent_account", false)
+
+	parts := strings.Split(d.Id(), "/")
+
+	switch {
+	case len(parts) == 1 && parts[0] == eventWebhookID:
+	case len(parts) == ImportSplitParts && parts[1] == eventWebhookID:
+		setImportedOnBehalfOf(d, parts[0])
+	default:
+		return nil, ErrInvalidEventWebhookImportFormat
+	}
+
+	d.SetId(eventWebhookResourceID(eventWebhookClient(d, m).OnBehalfOf))
+
+	return []*schema.ResourceData{d}, nil
+}
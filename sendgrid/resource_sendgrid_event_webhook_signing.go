@@ -0,0 +1,173 @@
+/*
+Provide a resource to sign the events posted by the event webhook, and retrieve the public key verifying them.
+The key pair is rotated, without destroying the resource, whenever the `rotation_id` or the `rotation_triggers` change.
+Sendgrid generates a new key pair by disabling then enabling the signature, and doesn't keep the previous key valid:
+the events posted during the rotation aren't signed, and the events signed with the previous key can't be verified
+with the new `public_key`, so the receiver should accept both keys for a while.
+Destroying the resource disables the signature.
+Example Usage
+```hcl
+resource "sendgrid_event_webhook_signing" "signing" {
+	rotation_id = "2021-06"
+}
+```
+The rotation can also depend on arbitrary values, e.g.
+```hcl
+resource "sendgrid_event_webhook_signing" "signing" {
+	rotation_triggers = {
+		rotated_on = "2021-06-01"
+	}
+}
+```
+Import
+The signature of the events can be imported, e.g.
+```hcl
+$ terraform import sendgrid_event_webhook_signing.signing event_webhook_signing
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// eventWebhookSigningID is the ID of the signature of the events, which exists once per account.
+const eventWebhookSigningID = "event_webhook_signing"
+
+func resourceSendgridEventWebhookSigning() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridEventWebhookSigningCreate,
+		ReadContext:   resourceSendgridEventWebhookSigningRead,
+		UpdateContext: resourceSendgridEventWebhookSigningUpdate,
+		DeleteContext: resourceSendgridEventWebhookSigningDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rotation_id": {
+				Type:        schema.TypeString,
+				Description: "An arbitrary identifier of the key pair, which rotates the key pair when it changes.",
+				Optional:    true,
+			},
+			"rotation_triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values which rotate the key pair when they change.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Description: "The public key verifying the signature of the events.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func enableEventWebhookSigning(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	summary string,
+) diag.Diagnostics {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEventWebhookSigning(true)
+	})
+	if err != nil {
+		return errorToDiags(summary, err)
+	}
+
+	return nil
+}
+
+func resourceSendgridEventWebhookSigningCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if diags := enableEventWebhookSigning(
+		ctx, c, d, schema.TimeoutCreate, "failed enabling event webhook signing",
+	); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(eventWebhookSigningID)
+
+	return resourceSendgridEventWebhookSigningRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookSigningRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	signing, requestErr := c.ReadEventWebhookSigning()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading event webhook signing", requestErr)}
+	}
+
+	if !signing.Enabled {
+		// the signature was disabled outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("public_key", signing.PublicKey)
+
+	return nil
+}
+
+func resourceSendgridEventWebhookSigningUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if d.HasChanges("rotation_id", "rotation_triggers") {
+		// disabling then enabling the signature generates a new key pair.
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateEventWebhookSigning(false)
+		})
+		if err != nil {
+			return errorToDiags("failed rotating event webhook signing key", err)
+		}
+
+		if diags := enableEventWebhookSigning(
+			ctx, c, d, schema.TimeoutUpdate, "failed rotating event webhook signing key",
+		); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceSendgridEventWebhookSigningRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookSigningDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateEventWebhookSigning(false)
+	})
+	if err != nil {
+		return errorToDiags("failed disabling event webhook signing", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridEventWebhookSigningBasic(t *testing.T) {
+	var publicKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookSigningDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookSigningConfigBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridEventWebhookSigningPublicKey("sendgrid_event_webhook_signing.signing", &publicKey),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookSigningConfigBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridEventWebhookSigningRotated("sendgrid_event_webhook_signing.signing", &publicKey),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridEventWebhookSigningRotationID(t *testing.T) {
+	var publicKey string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookSigningDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookSigningConfigRotationID("2021-06"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridEventWebhookSigningPublicKey("sendgrid_event_webhook_signing.signing", &publicKey),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookSigningConfigRotationID("2021-07"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridEventWebhookSigningRotated("sendgrid_event_webhook_signing.signing", &publicKey),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridEventWebhookSigningDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_event_webhook_signing" {
+			continue
+		}
+
+		signing, requestErr := c.ReadEventWebhookSigning()
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if signing.Enabled {
+			return fmt.Errorf("event webhook signing is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridEventWebhookSigningConfigBasic(rotation string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_event_webhook_signing" "signing" {
+		rotation_triggers = {
+			rotation = %q
+		}
+	}
+	`, rotation)
+}
+
+func testAccCheckSendgridEventWebhookSigningConfigRotationID(rotationID string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_event_webhook_signing" "signing" {
+		rotation_id = %q
+	}
+	`, rotationID)
+}
+
+func testAccCheckSendgridEventWebhookSigningPublicKey(n string, publicKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["public_key"] == "" {
+			return fmt.Errorf("No public key set")
+		}
+
+		*publicKey = rs.Primary.Attributes["public_key"]
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridEventWebhookSigningRotated(n string, publicKey *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.Attributes["public_key"] == *publicKey {
+			return fmt.Errorf("the public key wasn't rotated")
+		}
+
+		return nil
+	}
+}
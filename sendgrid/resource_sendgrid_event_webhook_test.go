@@ -0,0 +1,229 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridEventWebhookBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookConfigBasic(true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "id", "event_webhook"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "delivered", "true"),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "bounce", "false"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "delivered", "false"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridEventWebhookConfigOAuth(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "oauth_client_id", "client"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_event_webhook.main", "oauth_token_url", "https://example.org/oauth/token",
+					),
+				),
+			},
+			{
+				// the write-only secret mustn't produce any diff.
+				Config:   testAccCheckSendgridEventWebhookConfigOAuth(),
+				PlanOnly: true,
+			},
+			{
+				// removing the OAuth configuration disables OAuth.
+				Config: testAccCheckSendgridEventWebhookConfigBasic(false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "oauth_client_id", ""),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "oauth_token_url", ""),
+				),
+			},
+			{
+				// the event webhook is imported without knowing any ID.
+				ResourceName:            "sendgrid_event_webhook.main",
+				ImportState:             true,
+				ImportStateId:           "event_webhook",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"oauth_client_secret"},
+			},
+		},
+	})
+}
+
+// TestSendgridEventWebhookImportID checks that only the IDs of event webhooks are imported,
+// and that their ID is scoped to the subuser the event webhook is read on behalf of.
+func TestSendgridEventWebhookImportID(t *testing.T) {
+	r := provider.Provider().ResourcesMap["sendgrid_event_webhook"]
+
+	tests := map[string]struct {
+		onBehalfOf string
+		id         string
+		expected   string
+	}{
+		"account":                  {id: "event_webhook", expected: "event_webhook"},
+		"subuser":                  {id: "sales/event_webhook", expected: "sales/event_webhook"},
+		"default subuser":          {onBehalfOf: "marketing", id: "event_webhook", expected: "marketing/event_webhook"},
+		"override default subuser": {onBehalfOf: "marketing", id: "sales/event_webhook", expected: "sales/event_webhook"},
+		"account despite default":  {onBehalfOf: "marketing", id: "/event_webhook", expected: "event_webhook"},
+		"other ID":                 {id: "webhook"},
+		"other ID of subuser":      {id: "sales/webhook"},
+		"too many parts":           {id: "sales/event_webhook/event_webhook"},
+	}
+
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{})
+			d.SetId(test.id)
+
+			imported, err := r.Importer.StateContext(
+				context.Background(), d, sendgrid.NewClient("key", "", test.onBehalfOf),
+			)
+
+			if test.expected == "" {
+				if err == nil {
+					t.Fatalf("expected the import of %q to fail", test.id)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("failed importing %q: %s", test.id, err)
+			}
+
+			if id := imported[0].Id(); id != test.expected {
+				t.Errorf("expected the ID %q, got: %q", test.expected, id)
+			}
+		})
+	}
+}
+
+func TestSendgridWebhookURLDiffSuppression(t *testing.T) {
+	for _, name := range []string{"sendgrid_event_webhook", "sendgrid_webhook_parse"} {
+		suppress := provider.Provider().ResourcesMap[name].Schema["url"].DiffSuppressFunc
+
+		for urls, suppressed := range map[[2]string]bool{
+			{"https://X.com/hook", "https://x.com/hook/"}:             true,
+			{"HTTPS://example.com", "https://example.com/"}:           true,
+			{"https://example.com/Hook", "https://example.com/hook"}:  false,
+			{"https://example.com/hook", "https://example.com/other"}: false,
+			{"https://example.com/hook", "http://example.com/hook"}:   false,
+		} {
+			if suppress("url", urls[0], urls[1], nil) != suppressed {
+				t.Errorf("%s: %s and %s: expected suppressed %t", name, urls[0], urls[1], suppressed)
+			}
+		}
+	}
+}
+
+func TestAccSendgridEventWebhookOnBehalfOf(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridEventWebhookDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the event webhooks of the account and of the subuser don't overwrite each other.
+				Config: testAccCheckSendgridEventWebhookConfigBasic(true) + fmt.Sprintf(`
+				resource "sendgrid_subuser" "subuser" {
+					username = %q
+					password = "Passw0rd!%s"
+					email    = "%s@example.org"
+					ips      = ["127.0.0.1"]
+				}
+
+				resource "sendgrid_event_webhook" "subuser" {
+					sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+					url                   = "https://example.org/sendgrid/subuser-events"
+					bounce                = true
+				}
+				`, username, acctest.RandString(10), username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "id", "event_webhook"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_event_webhook.main", "url", "https://example.org/sendgrid/events",
+					),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.main", "bounce", "false"),
+					resource.TestCheckResourceAttr(
+						"sendgrid_event_webhook.subuser", "id", username+"/event_webhook",
+					),
+					resource.TestCheckResourceAttr(
+						"sendgrid_event_webhook.subuser", "url", "https://example.org/sendgrid/subuser-events",
+					),
+					resource.TestCheckResourceAttr("sendgrid_event_webhook.subuser", "bounce", "true"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_event_webhook.subuser",
+				ImportState:       true,
+				ImportStateId:     username + "/event_webhook",
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridEventWebhookDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		// the event webhooks of the subusers are destroyed with them.
+		if rs.Type != "sendgrid_event_webhook" || rs.Primary.Attributes["sub_user_on_behalf_of"] != "" {
+			continue
+		}
+
+		webhook, requestErr := c.ReadEventWebhook()
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if webhook.Enabled {
+			return fmt.Errorf("event webhook is still enabled")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridEventWebhookConfigBasic(delivered bool) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_event_webhook" "main" {
+		url       = "https://example.org/sendgrid/events"
+		delivered = %t
+		dropped   = true
+	}
+	`, delivered)
+}
+
+func testAccCheckSendgridEventWebhookConfigOAuth() string {
+	return `
+	resource "sendgrid_event_webhook" "main" {
+		url                 = "https://example.org/sendgrid/events"
+		dropped             = true
+		oauth_client_id     = "client"
+		oauth_client_secret = "secret"
+		oauth_token_url     = "https://example.org/oauth/token"
+	}
+	`
+}
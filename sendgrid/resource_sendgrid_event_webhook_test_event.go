@@ -0,0 +1,113 @@
+/*
+Provide a resource sending a test event to an event webhook URL when created,
+so the connectivity of the webhook is checked during the apply.
+The test event is sent again whenever the URL, the OAuth settings or the `triggers` change.
+Example Usage
+```hcl
+resource "sendgrid_event_webhook_test_event" "check" {
+	url = "https://example.org/sendgrid/events"
+
+	triggers = {
+		deployment = var.deployment_id
+	}
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridEventWebhookTestEvent() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridEventWebhookTestEventCreate,
+		ReadContext:   resourceSendgridEventWebhookTestEventRead,
+		DeleteContext: resourceSendgridEventWebhookTestEventDelete,
+
+		Schema: map[string]*schema.Schema{
+			"url": {
+				Type:        schema.TypeString,
+				Description: "The URL the test event is posted to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"oauth_client_id": {
+				Type:        schema.TypeString,
+				Description: "The OAuth client ID Sendgrid authenticates with, if the URL requires it.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"oauth_client_secret": {
+				Type:        schema.TypeString,
+				Description: "The OAuth client secret Sendgrid authenticates with, if the URL requires it.",
+				Optional:    true,
+				Sensitive:   true,
+				ForceNew:    true,
+			},
+			"oauth_token_url": {
+				Type:        schema.TypeString,
+				Description: "The URL Sendgrid retrieves its OAuth token from, if the URL requires it.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values which send the test event again when they change.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceSendgridEventWebhookTestEventCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	test := sendgrid.EventWebhookTest{
+		URL:               d.Get("url").(string),
+		OAuthClientID:     d.Get("oauth_client_id").(string),
+		OAuthClientSecret: d.Get("oauth_client_secret").(string),
+		OAuthTokenURL:     d.Get("oauth_token_url").(string),
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.TestEventWebhook(test)
+	})
+	if err != nil {
+		return errorToDiags("failed testing event webhook", err)
+	}
+
+	d.SetId(test.URL)
+
+	return resourceSendgridEventWebhookTestEventRead(ctx, d, m)
+}
+
+func resourceSendgridEventWebhookTestEventRead(
+	_ context.Context,
+	_ *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// a test event is fired once, there is nothing to read back.
+	return nil
+}
+
+func resourceSendgridEventWebhookTestEventDelete(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// a test event can't be undone, it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridEventWebhookTestEventBasic(t *testing.T) {
+	url := "https://example.org/sendgrid/events"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridEventWebhookTestEventConfigBasic(url),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_event_webhook_test_event.check", "id", url),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridEventWebhookTestEventConfigBasic(url string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_event_webhook_test_event" "check" {
+		url = %q
+	}
+	`, url)
+}
@@ -0,0 +1,308 @@
+/*
+Provide a resource to manage the IP access management of the account: only the allowed IP addresses,
+or ranges in CIDR notation, can access it. The resource manages the whole list, the IP addresses allowed
+outside of Terraform are removed, and destroying the resource lifts the restriction.
+Before applying, the resource checks that the IP address of the latest access to the account, most likely the one
+of the provider, is still allowed, and refuses to apply otherwise. The check can be disabled with `skip_lockout_check`.
+Example Usage
+```hcl
+resource "sendgrid_ip_access_management" "access" {
+	ips = ["203.0.113.10", "198.51.100.0/24"]
+}
+```
+Import
+The IP access management can be imported, e.g.
+```hcl
+$ terraform import sendgrid_ip_access_management.access access_settings
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPAccessManagement() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPAccessManagementCreate,
+		ReadContext:   resourceSendgridIPAccessManagementRead,
+		UpdateContext: resourceSendgridIPAccessManagementUpdate,
+		DeleteContext: resourceSendgridIPAccessManagementDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ips": {
+				Type:        schema.TypeSet,
+				Description: "The IP addresses, or ranges in CIDR notation, allowed to access the account.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.Any(validation.IsIPAddress, validation.IsCIDR),
+				},
+			},
+			"skip_lockout_check": {
+				Type:        schema.TypeBool,
+				Description: "Apply the IP addresses even if they don't include the latest one the account was accessed from.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+// accessRuleNetwork returns the network of an allowed IP address, or range in CIDR notation,
+// an IP address being a network of a single address.
+func accessRuleNetwork(ip string) *net.IPNet {
+	if _, network, err := net.ParseCIDR(ip); err == nil {
+		return network
+	}
+
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return nil
+	}
+
+	if v4 := parsed.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+	}
+
+	return &net.IPNet{IP: parsed, Mask: net.CIDRMask(128, 128)}
+}
+
+// sameAccessRule tells whether two allowed IP addresses are the same, e.g. 203.0.113.10 and 203.0.113.10/32.
+func sameAccessRule(a, b string) bool {
+	networkA, networkB := accessRuleNetwork(a), accessRuleNetwork(b)
+	if networkA == nil || networkB == nil {
+		return a == b
+	}
+
+	return networkA.String() == networkB.String()
+}
+
+// containsAccessRule tells whether the IP address is one of the allowed IP addresses.
+func containsAccessRule(ips []string, ip string) bool {
+	for _, v := range ips {
+		if sameAccessRule(v, ip) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkAccessLockout refuses the allowed IP addresses if they don't include the latest one the account
+// was accessed from. The check is best-effort: it's skipped with a warning if the activity can't be read.
+func checkAccessLockout(c *sendgrid.Client, ips []string) diag.Diagnostics {
+	activity, requestErr := c.ListAccessActivity()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "IP access management lockout check skipped",
+			Detail:   "The recent accesses to the account couldn't be read: " + requestErr.Error(),
+		}}
+	}
+
+	var latest *sendgrid.AccessActivity
+
+	for i := range activity {
+		if activity[i].Allowed && (latest == nil || activity[i].LastAt > latest.LastAt) {
+			latest = &activity[i]
+		}
+	}
+
+	if latest == nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "IP access management lockout check skipped",
+			Detail:   "No recent access to the account was found.",
+		}}
+	}
+
+	caller := net.ParseIP(latest.IP)
+
+	for _, ip := range ips {
+		if network := accessRuleNetwork(ip); network != nil && caller != nil && network.Contains(caller) {
+			return nil
+		}
+	}
+
+	return diag.FromErr(accessLockout(latest.IP))
+}
+
+// applyAccessRules makes the allowed IP addresses match the configuration: the missing ones are allowed
+// before the others are removed, so that the access is never more restricted than configured.
+func applyAccessRules(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	ips := stringSetToSlice(d.Get("ips").(*schema.Set))
+
+	var diags diag.Diagnostics
+
+	if !d.Get("skip_lockout_check").(bool) {
+		if diags = checkAccessLockout(c, ips); diags.HasError() {
+			return diags
+		}
+	}
+
+	rules, requestErr := c.ListAccessRules()
+	if requestErr.Err != nil {
+		return append(diags, requestErrorToDiag("failed listing allowed IP addresses", requestErr))
+	}
+
+	allowed := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		allowed = append(allowed, rule.IP)
+	}
+
+	var missing []string
+
+	for _, ip := range ips {
+		if !containsAccessRule(allowed, ip) {
+			missing = append(missing, ip)
+		}
+	}
+
+	var extra []int64
+
+	for _, rule := range rules {
+		if !containsAccessRule(ips, rule.IP) {
+			extra = append(extra, rule.ID)
+		}
+	}
+
+	if len(missing) > 0 {
+		_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+			return c.CreateAccessRules(missing)
+		})
+		if err != nil {
+			return append(diags, errorToDiags("failed allowing IP addresses", err)...)
+		}
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteAccessRules(extra)
+	})
+	if err != nil {
+		return append(diags, errorToDiags("failed removing allowed IP addresses", err)...)
+	}
+
+	return diags
+}
+
+func resourceSendgridIPAccessManagementCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	diags := applyAccessRules(ctx, c, d, schema.TimeoutCreate)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId("access_settings")
+
+	return append(diags, resourceSendgridIPAccessManagementRead(ctx, d, m)...)
+}
+
+func resourceSendgridIPAccessManagementRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	rules, requestErr := c.ListAccessRules()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing allowed IP addresses", requestErr)}
+	}
+
+	if len(rules) == 0 {
+		// the restriction was lifted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	// keep the IP addresses as configured, Sendgrid returns them in CIDR notation.
+	configured := stringSetToSlice(d.Get("ips").(*schema.Set))
+	ips := make([]string, 0, len(rules))
+
+	for _, rule := range rules {
+		ip := rule.IP
+
+		for _, v := range configured {
+			if sameAccessRule(rule.IP, v) {
+				ip = v
+
+				break
+			}
+		}
+
+		ips = append(ips, ip)
+	}
+
+	//nolint:errcheck
+	d.Set("ips", ips)
+
+	return nil
+}
+
+func resourceSendgridIPAccessManagementUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	if d.HasChange("ips") {
+		diags := applyAccessRules(ctx, c, d, schema.TimeoutUpdate)
+		if diags.HasError() {
+			return diags
+		}
+
+		return append(diags, resourceSendgridIPAccessManagementRead(ctx, d, m)...)
+	}
+
+	return resourceSendgridIPAccessManagementRead(ctx, d, m)
+}
+
+func resourceSendgridIPAccessManagementDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	rules, requestErr := c.ListAccessRules()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing allowed IP addresses", requestErr)}
+	}
+
+	ids := make([]int64, 0, len(rules))
+	for _, rule := range rules {
+		ids = append(ids, rule.ID)
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteAccessRules(ids)
+	})
+	if err != nil {
+		return errorToDiags("failed removing allowed IP addresses", err)
+	}
+
+	return nil
+}
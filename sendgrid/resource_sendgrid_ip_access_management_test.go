@@ -0,0 +1,89 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridIPAccessManagementBasic(t *testing.T) {
+	ip := os.Getenv("SENDGRID_TEST_ACCESS_IP")
+	if ip == "" {
+		t.Skip("SENDGRID_TEST_ACCESS_IP must be set to the IP address the tests access Sendgrid from")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPAccessManagementConfigBasic(ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_access_management.access", "ips.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_ip_access_management.access",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"skip_lockout_check"},
+			},
+		},
+	})
+}
+
+func TestSendgridIPAccessManagementLockout(t *testing.T) {
+	var created int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/access_settings/activity":
+			fmt.Fprint(w, `{"result": [
+				{"ip": "198.51.100.1", "allowed": true, "last_at": 1},
+				{"ip": "203.0.113.10", "allowed": true, "last_at": 2}
+			]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/access_settings/whitelist":
+			atomic.StoreInt32(&created, 1)
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"result": []}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_ip_access_management"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		// the latest access, from 203.0.113.10, isn't allowed.
+		"ips": []interface{}{"198.51.100.0/24"},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	if !diags.HasError() || !strings.HasPrefix(diags[0].Summary, provider.ErrAccessLockout.Error()+": 203.0.113.10") {
+		t.Fatalf("expected a lockout error, got: %v", diags)
+	}
+
+	if atomic.LoadInt32(&created) != 0 {
+		t.Fatal("expected no IP address to be allowed")
+	}
+}
+
+func testAccCheckSendgridIPAccessManagementConfigBasic(ip string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_ip_access_management" "access" {
+		ips = [%q, "198.51.100.0/24"]
+	}
+	`, ip)
+}
@@ -0,0 +1,225 @@
+/*
+Provide a resource to manage a pool of dedicated IP addresses.
+Sendgrid identifies the pools by name: renaming a pool renames it in place, it keeps its IP addresses,
+and the ID of the resource becomes the new name.
+The IP addresses of the pool are managed by `sendgrid_ip_pool_membership`, so that several configurations
+can each own some of them. `ips` is deprecated: when it's set at creation or changed, the IP addresses of the pool
+are replaced by it, removing the ones added by any membership. Otherwise, the IP addresses are only read.
+Example Usage
+```hcl
+resource "sendgrid_ip_pool" "transactional" {
+	name = "transactional"
+}
+
+resource "sendgrid_ip_pool_membership" "transactional" {
+	pool_name = sendgrid_ip_pool.transactional.name
+	ip        = "192.0.2.1"
+}
+```
+Import
+An IP pool can be imported by name, e.g.
+```hcl
+$ terraform import sendgrid_ip_pool.transactional transactional
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolCreate,
+		ReadContext:   resourceSendgridIPPoolRead,
+		UpdateContext: resourceSendgridIPPoolUpdate,
+		DeleteContext: resourceSendgridIPPoolDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Description:  "The name of the IP pool, renaming the pool keeps its IP addresses.",
+				Required:     true,
+				ValidateFunc: validation.StringLenBetween(1, 64),
+			},
+			"ips": {
+				Type: schema.TypeSet,
+				Description: "The dedicated IP addresses of the pool. When set, the pool owns all its IP addresses, " +
+					"otherwise they're only read.",
+				Optional:   true,
+				Computed:   true,
+				Deprecated: "use sendgrid_ip_pool_membership instead",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsIPAddress,
+				},
+			},
+		},
+	}
+}
+
+func resourceSendgridIPPoolCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	name := d.Get("name").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateIPPool(name)
+	})
+	if err != nil {
+		return errorToDiags("failed creating IP pool", err)
+	}
+
+	d.SetId(name)
+
+	if diags := resourceSendgridIPPoolSyncIPs(ctx, d, schema.TimeoutCreate, c, true); diags.HasError() {
+		return diags
+	}
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	pool, requestErr := c.ReadIPPool(d.Id())
+	if isNotFound(requestErr) {
+		// the pool was deleted or renamed outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading IP pool", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", pool.Name)
+	//nolint:errcheck
+	d.Set("ips", stringSliceToSet(ipPoolIPs(pool)))
+
+	return nil
+}
+
+func resourceSendgridIPPoolUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	// the pool is renamed in place rather than replaced: a new pool would have none of the IP addresses.
+	renamed := d.HasChange("name")
+	if renamed {
+		name := d.Get("name").(string)
+
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.RenameIPPool(d.Id(), name)
+		})
+		if err != nil {
+			return errorToDiags("failed renaming IP pool", err)
+		}
+
+		d.SetId(name)
+	}
+
+	// ips only changes when it's configured, since it's computed otherwise: the pool then owns its IP addresses.
+	// A rename only adds back the IP addresses it lost, the ones added by the memberships since the refresh are kept.
+	if owned := d.HasChange("ips"); owned || renamed {
+		if diags := resourceSendgridIPPoolSyncIPs(ctx, d, schema.TimeoutUpdate, c, owned); diags.HasError() {
+			return diags
+		}
+	}
+
+	return resourceSendgridIPPoolRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteIPPool(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting IP pool", err)
+	}
+
+	return nil
+}
+
+// resourceSendgridIPPoolSyncIPs adds the IP addresses of the resource missing from the pool,
+// and when the pool owns its IP addresses, removes the IP addresses of the pool which aren't in the resource.
+func resourceSendgridIPPoolSyncIPs(
+	ctx context.Context,
+	d *schema.ResourceData,
+	timeoutKey string,
+	c *sendgrid.Client,
+	owned bool,
+) diag.Diagnostics {
+	pool, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.ReadIPPool(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed reading IP pool", err)
+	}
+
+	current := ipPoolIPs(pool.(*sendgrid.IPPool))
+	wanted := stringSetToSlice(d.Get("ips").(*schema.Set))
+
+	for _, ip := range wanted {
+		if ipInIPs(current, ip) {
+			continue
+		}
+
+		ip := ip
+
+		_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+			return c.AddIPToPool(d.Id(), ip)
+		})
+		if err != nil {
+			return errorToDiags("failed adding IP address to IP pool", err)
+		}
+	}
+
+	for _, ip := range current {
+		if !owned || ipInIPs(wanted, ip) {
+			continue
+		}
+
+		ip := ip
+
+		_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+			return c.RemoveIPFromPool(d.Id(), ip)
+		})
+		if err != nil {
+			return errorToDiags("failed removing IP address from IP pool", err)
+		}
+	}
+
+	return nil
+}
+
+func ipPoolIPs(pool *sendgrid.IPPool) []string {
+	ips := make([]string, 0, len(pool.IPs))
+	for _, ip := range pool.IPs {
+		ips = append(ips, ip.IP)
+	}
+
+	return ips
+}
+
+// ipInIPs tells whether the IP address is one of the IP addresses.
+func ipInIPs(ips []string, ip string) bool {
+	for _, v := range ips {
+		if v == ip {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,190 @@
+/*
+Provide a resource to manage the membership of a dedicated IP address in an IP pool,
+so that the IP addresses of a pool can be owned by several configurations.
+Destroying the resource removes the IP address from the pool. An IP address removed from the pool outside of Terraform
+is added again by the next apply. Renaming the pool keeps the membership.
+Example Usage
+```hcl
+resource "sendgrid_ip_pool" "marketing" {
+	name = "marketing"
+}
+
+resource "sendgrid_ip_pool_membership" "marketing" {
+	pool_name = sendgrid_ip_pool.marketing.name
+	ip        = "192.0.2.1"
+}
+```
+Import
+The membership of an IP address in an IP pool can be imported using the name of the pool and the IP address, e.g.
+```hcl
+$ terraform import sendgrid_ip_pool_membership.marketing marketing/192.0.2.1
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPPoolMembership() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPPoolMembershipCreate,
+		ReadContext:   resourceSendgridIPPoolMembershipRead,
+		UpdateContext: resourceSendgridIPPoolMembershipUpdate,
+		DeleteContext: resourceSendgridIPPoolMembershipDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridIPPoolMembershipImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"pool_name": {
+				Type: schema.TypeString,
+				Description: "The name of the IP pool. Changing it moves the IP address to the other pool, " +
+					"or follows the pool when it's renamed.",
+				Required: true,
+			},
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The dedicated IP address added to the pool.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+		},
+	}
+}
+
+func ipPoolMembershipID(poolName, ip string) string {
+	return poolName + "/" + ip
+}
+
+func resourceSendgridIPPoolMembershipCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	poolName := d.Get("pool_name").(string)
+	ip := d.Get("ip").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.AddIPToPool(poolName, ip)
+	})
+	if err != nil {
+		return errorToDiags("failed adding IP address to IP pool", err)
+	}
+
+	d.SetId(ipPoolMembershipID(poolName, ip))
+
+	return resourceSendgridIPPoolMembershipRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolMembershipRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	pool, requestErr := c.ReadIPPool(d.Get("pool_name").(string))
+	if isNotFound(requestErr) {
+		// the pool was deleted or renamed outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading IP pool", requestErr)}
+	}
+
+	if !ipInIPs(ipPoolIPs(pool), d.Get("ip").(string)) {
+		// the IP address was removed outside of Terraform, it has to be added again.
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceSendgridIPPoolMembershipUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	oldPoolName, newPoolName := d.GetChange("pool_name")
+	ip := d.Get("ip").(string)
+
+	// when the pool was renamed, the IP address is already in it.
+	pool, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.ReadIPPool(newPoolName.(string))
+	})
+	if err != nil {
+		return errorToDiags("failed reading IP pool", err)
+	}
+
+	if !ipInIPs(ipPoolIPs(pool.(*sendgrid.IPPool)), ip) {
+		_, err = c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.AddIPToPool(newPoolName.(string), ip)
+		})
+		if err != nil {
+			return errorToDiags("failed adding IP address to IP pool", err)
+		}
+	}
+
+	// the former pool doesn't exist anymore if it was renamed.
+	_, err = c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.RemoveIPFromPool(oldPoolName.(string), ip)
+	})
+	if err != nil {
+		return errorToDiags("failed removing IP address from IP pool", err)
+	}
+
+	d.SetId(ipPoolMembershipID(newPoolName.(string), ip))
+
+	return resourceSendgridIPPoolMembershipRead(ctx, d, m)
+}
+
+func resourceSendgridIPPoolMembershipDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.RemoveIPFromPool(d.Get("pool_name").(string), d.Get("ip").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed removing IP address from IP pool", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridIPPoolMembershipImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// the name of the pool may contain a slash, the IP address can't.
+	i := strings.LastIndex(d.Id(), "/")
+	if i <= 0 || i == len(d.Id())-1 {
+		return nil, ErrInvalidIPPoolMembershipImportFormat
+	}
+
+	//nolint:errcheck
+	d.Set("pool_name", d.Id()[:i])
+	//nolint:errcheck
+	d.Set("ip", d.Id()[i+1:])
+
+	return []*schema.ResourceData{d}, nil
+}
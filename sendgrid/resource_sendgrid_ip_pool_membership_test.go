@@ -0,0 +1,105 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridIPPoolMembershipBasic(t *testing.T) {
+	ip := os.Getenv("SENDGRID_TEST_IP")
+	if ip == "" {
+		t.Skip("SENDGRID_TEST_IP must be set to a dedicated IP address of the account")
+	}
+
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPPoolMembershipConfigBasic(name, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool_membership.membership", "id", name+"/"+ip),
+					testAccCheckSendgridIPPoolHasIP(name, ip),
+				),
+			},
+			{
+				// the membership follows the pool when it's renamed.
+				Config: testAccCheckSendgridIPPoolMembershipConfigBasic(name+"-renamed", ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sendgrid_ip_pool_membership.membership", "id", name+"-renamed/"+ip,
+					),
+					testAccCheckSendgridIPPoolHasIP(name+"-renamed", ip),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_pool_membership.membership",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the IP address removed outside of Terraform must be added again.
+				PreConfig: func() {
+					c := sendgrid.NewClient(os.Getenv("SENDGRID_API_KEY"), os.Getenv("SENDGRID_HOST"), "")
+
+					if _, requestErr := c.RemoveIPFromPool(name+"-renamed", ip); requestErr.Err != nil {
+						t.Fatal(requestErr.Err)
+					}
+				},
+				Config:             testAccCheckSendgridIPPoolMembershipConfigBasic(name+"-renamed", ip),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// destroying the membership only removes the IP address from the pool.
+				Config: fmt.Sprintf(`
+				resource "sendgrid_ip_pool" "pool" {
+					name = %q
+				}
+				`, name+"-renamed"),
+				Check: testAccCheckSendgridIPPoolMembershipDestroyed(name+"-renamed", ip),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridIPPoolMembershipDestroyed(name, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		pool, requestErr := c.ReadIPPool(name)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		for _, poolIP := range pool.IPs {
+			if poolIP.IP == ip {
+				return fmt.Errorf("IP address %s is still in IP pool %s", ip, name)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridIPPoolMembershipConfigBasic(name, ip string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_ip_pool" "pool" {
+		name = %q
+	}
+
+	resource "sendgrid_ip_pool_membership" "membership" {
+		pool_name = sendgrid_ip_pool.pool.name
+		ip        = %q
+	}
+	`, name, ip)
+}
@@ -0,0 +1,160 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridIPPoolRename(t *testing.T) {
+	ip := os.Getenv("SENDGRID_TEST_IP")
+	if ip == "" {
+		t.Skip("SENDGRID_TEST_IP must be set to a dedicated IP address of the account")
+	}
+
+	name := "terraform-pool-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPPoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.pool", "id", name),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.pool", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_ip_pool.pool", "ips.*", ip),
+				),
+			},
+			{
+				Config: testAccCheckSendgridIPPoolConfigBasic(name+"-renamed", ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.pool", "id", name+"-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.pool", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_ip_pool.pool", "ips.#", "1"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_ip_pool.pool", "ips.*", ip),
+					testAccCheckSendgridIPPoolHasIP(name+"-renamed", ip),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_pool.pool",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// TestSendgridIPPoolRenameKeepsMemberships checks that renaming a pool without ips configured
+// adds back the IP address lost by the rename, but doesn't remove the one added by a membership since the refresh.
+func TestSendgridIPPoolRenameKeepsMemberships(t *testing.T) {
+	var added, removed int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPut && r.URL.Path == "/ips/pools/pool":
+			fmt.Fprint(w, `{"pool_name": "renamed"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/ips/pools/renamed":
+			if atomic.LoadInt32(&added) > 0 {
+				fmt.Fprint(w, `{"pool_name": "renamed", "ips": [{"ip": "192.0.2.1"}, {"ip": "192.0.2.2"}]}`)
+			} else {
+				fmt.Fprint(w, `{"pool_name": "renamed", "ips": [{"ip": "192.0.2.2"}]}`)
+			}
+		case r.Method == http.MethodPost && r.URL.Path == "/ips/pools/renamed/ips":
+			atomic.AddInt32(&added, 1)
+			w.WriteHeader(http.StatusCreated)
+		case r.Method == http.MethodDelete:
+			atomic.AddInt32(&removed, 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	r := provider.Provider().ResourcesMap["sendgrid_ip_pool"]
+
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{"name": "pool"})
+	d.SetId("pool")
+	//nolint:errcheck
+	d.Set("ips", []interface{}{"192.0.2.1"})
+
+	diff, err := r.Diff(
+		context.Background(), d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{"name": "renamed"}), c,
+	)
+	if err != nil {
+		t.Fatalf("failed planning: %v", err)
+	}
+
+	state, diags := r.Apply(context.Background(), d.State(), diff, c)
+	if diags.HasError() {
+		t.Fatalf("failed renaming IP pool: %v", diags)
+	}
+
+	if added != 1 || removed != 0 {
+		t.Errorf("expected the lost IP address added back and none removed, got %d added, %d removed", added, removed)
+	}
+
+	if state.ID != "renamed" || state.Attributes["ips.#"] != "2" {
+		t.Errorf("expected the renamed pool with both IP addresses, got: %v", state)
+	}
+}
+
+func testAccCheckSendgridIPPoolHasIP(name, ip string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		pool, requestErr := c.ReadIPPool(name)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		for _, poolIP := range pool.IPs {
+			if poolIP.IP == ip {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("IP address %s isn't in IP pool %s anymore", ip, name)
+	}
+}
+
+func testAccCheckSendgridIPPoolDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_pool" {
+			continue
+		}
+
+		_, requestErr := c.ReadIPPool(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("IP pool %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridIPPoolConfigBasic(name, ip string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_ip_pool" "pool" {
+		name = %q
+		ips  = [%q]
+	}
+	`, name, ip)
+}
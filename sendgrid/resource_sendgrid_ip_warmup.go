@@ -0,0 +1,104 @@
+/*
+Provide a resource to warm up a dedicated IP address: Sendgrid gradually increases the number of emails
+sent from it. Destroying the resource stops the warmup.
+Example Usage
+```hcl
+resource "sendgrid_ip_warmup" "warmup" {
+	ip = "192.0.2.1"
+}
+```
+Import
+The warmup of an IP address can be imported, e.g.
+```hcl
+$ terraform import sendgrid_ip_warmup.warmup 192.0.2.1
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridIPWarmup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridIPWarmupCreate,
+		ReadContext:   resourceSendgridIPWarmupRead,
+		DeleteContext: resourceSendgridIPWarmupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:         schema.TypeString,
+				Description:  "The dedicated IP address to warm up.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsIPAddress,
+			},
+			"start_date": {
+				Type:        schema.TypeInt,
+				Description: "The date (unix timestamp) the warmup started.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridIPWarmupCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	ip := d.Get("ip").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.StartIPWarmup(ip)
+	})
+	if err != nil {
+		return errorToDiags("failed starting IP warmup", err)
+	}
+
+	d.SetId(ip)
+
+	return resourceSendgridIPWarmupRead(ctx, d, m)
+}
+
+func resourceSendgridIPWarmupRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	warmup, requestErr := c.ReadIPWarmup(d.Id())
+	if isNotFound(requestErr) {
+		// the warmup was stopped, or finished, outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading IP warmup", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("ip", warmup.IP)
+	//nolint:errcheck
+	d.Set("start_date", warmup.StartDate)
+
+	return nil
+}
+
+func resourceSendgridIPWarmupDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.StopIPWarmup(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed stopping IP warmup", err)
+	}
+
+	return nil
+}
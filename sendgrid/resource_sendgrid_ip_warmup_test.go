@@ -0,0 +1,60 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridIPWarmupBasic(t *testing.T) {
+	ip := os.Getenv("SENDGRID_TEST_IP")
+	if ip == "" {
+		t.Skip("SENDGRID_TEST_IP must be set to a dedicated IP address of the account which isn't warming up")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridIPWarmupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_ip_warmup" "warmup" {
+					ip = %q
+				}
+				`, ip),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_ip_warmup.warmup", "ip", ip),
+					resource.TestCheckResourceAttrSet("sendgrid_ip_warmup.warmup", "start_date"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_ip_warmup.warmup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridIPWarmupDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_ip_warmup" {
+			continue
+		}
+
+		_, requestErr := c.ReadIPWarmup(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("IP address %s is still warming up", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
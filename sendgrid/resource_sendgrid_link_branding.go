@@ -0,0 +1,168 @@
+/*
+Provide a resource to brand the links of the emails with a domain,
+and retrieve the DNS records to create to validate it.
+Sendgrid allows a single default link branding: making a link branding the default one unsets the previous default one,
+so switching the default between link brandings of the same configuration doesn't leave a diff behind.
+When default isn't set, the resource doesn't manage it.
+Example Usage
+```hcl
+resource "sendgrid_link_branding" "example" {
+	domain    = "example.org"
+	subdomain = "links"
+	default   = true
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(sendgrid_link_branding.example.dns)
+	zone_id = var.zone_id
+	type    = upper(sendgrid_link_branding.example.dns[count.index].type)
+	name    = sendgrid_link_branding.example.dns[count.index].host
+	records = [sendgrid_link_branding.example.dns[count.index].data]
+	ttl     = 300
+}
+```
+Import
+A link branding can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_link_branding.example linkBrandingID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridLinkBranding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridLinkBrandingCreate,
+		ReadContext:   resourceSendgridLinkBrandingRead,
+		UpdateContext: resourceSendgridLinkBrandingUpdate,
+		DeleteContext: resourceSendgridLinkBrandingDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain the links are rewritten to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain the links are rewritten to, generated by Sendgrid if not set.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"default": {
+				Type:        schema.TypeBool,
+				Description: "Whether the link branding is the default one, not managed if not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the DNS records of the link branding were validated.",
+				Computed:    true,
+			},
+			"dns": dnsRecordsSchema("The DNS records to create to validate the link branding"),
+		},
+	}
+}
+
+func resourceSendgridLinkBrandingCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	linkBranding, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateLinkBranding(sendgrid.LinkBrandingRequest{
+			Domain:    d.Get("domain").(string),
+			Subdomain: d.Get("subdomain").(string),
+			Default:   d.Get("default").(bool),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed creating link branding", err)
+	}
+
+	d.SetId(strconv.FormatInt(linkBranding.(*sendgrid.LinkBranding).ID, 10))
+
+	return resourceSendgridLinkBrandingRead(ctx, d, m)
+}
+
+func resourceSendgridLinkBrandingRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	linkBranding, requestErr := c.ReadLinkBranding(d.Id())
+	if isNotFound(requestErr) {
+		// the link branding was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading link branding", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("domain", linkBranding.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", linkBranding.Subdomain)
+	//nolint:errcheck
+	d.Set("default", linkBranding.Default)
+	//nolint:errcheck
+	d.Set("valid", linkBranding.Valid)
+	//nolint:errcheck
+	d.Set("dns", flattenDNSRecords(dnsRecordsValues(linkBranding.DNS)))
+
+	return nil
+}
+
+func resourceSendgridLinkBrandingUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	isDefault := d.Get("default").(bool)
+
+	if !isDefault {
+		// when the default is switched to another link branding of the same apply, Sendgrid may already
+		// have unset it on this one: there is nothing left to update.
+		linkBranding, requestErr := c.ReadLinkBranding(d.Id())
+		if requestErr.Err != nil {
+			return diag.Diagnostics{requestErrorToDiag("failed reading link branding", requestErr)}
+		}
+
+		if !linkBranding.Default {
+			return resourceSendgridLinkBrandingRead(ctx, d, m)
+		}
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateLinkBranding(d.Id(), isDefault)
+	})
+	if err != nil {
+		return errorToDiags("failed updating link branding", err)
+	}
+
+	return resourceSendgridLinkBrandingRead(ctx, d, m)
+}
+
+func resourceSendgridLinkBrandingDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteLinkBranding(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting link branding", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridLinkBrandingSwitchDefault(t *testing.T) {
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridLinkBrandingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridLinkBrandingConfigDefault(domain, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_link_branding.first", "default", "true"),
+					resource.TestCheckResourceAttr("sendgrid_link_branding.second", "default", "false"),
+					resource.TestCheckResourceAttrSet("sendgrid_link_branding.first", "dns.#"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridLinkBrandingConfigDefault(domain, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_link_branding.second", "default", "true"),
+				),
+			},
+			{
+				// the link branding the default was moved off mustn't have a diff.
+				Config:   testAccCheckSendgridLinkBrandingConfigDefault(domain, false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridLinkBrandingDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_link_branding" {
+			continue
+		}
+
+		_, requestErr := c.ReadLinkBranding(rs.Primary.ID)
+		if requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("link branding %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridLinkBrandingConfigDefault(domain string, firstIsDefault bool) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_link_branding" "first" {
+		domain    = %q
+		subdomain = "links"
+		default   = %t
+	}
+
+	resource "sendgrid_link_branding" "second" {
+		domain    = %q
+		subdomain = "clicks"
+		default   = %t
+	}
+	`, domain, firstIsDefault, domain, !firstIsDefault)
+}
@@ -0,0 +1,156 @@
+/*
+Provide a resource to manage the address whitelist mail setting:
+the emails sent to these addresses or domains are never suppressed.
+Destroying the resource disables the whitelist and clears it.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_address_whitelist" "address_whitelist" {
+	enabled = true
+	list    = ["example.org", "admin@example.com"]
+}
+```
+Import
+The address whitelist mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_address_whitelist.address_whitelist address_whitelist
+```
+The address whitelist mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_address_whitelist.subuser_address_whitelist subUserName/address_whitelist
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsAddressWhitelist() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsAddressWhitelistCreate,
+		ReadContext:   resourceSendgridMailSettingsAddressWhitelistRead,
+		UpdateContext: resourceSendgridMailSettingsAddressWhitelistUpdate,
+		DeleteContext: resourceSendgridMailSettingsAddressWhitelistDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Never suppress the emails sent to the whitelisted addresses and domains.",
+				Optional:    true,
+				Default:     true,
+			},
+			"list": {
+				Type:        schema.TypeSet,
+				Description: "The whitelisted email addresses and domains.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func updateMailSettingsAddressWhitelist(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingAddressWhitelist,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingAddressWhitelist(setting)
+	})
+
+	return err
+}
+
+func mailSettingsAddressWhitelistFromResourceData(d *schema.ResourceData) sendgrid.MailSettingAddressWhitelist {
+	return sendgrid.MailSettingAddressWhitelist{
+		Enabled: d.Get("enabled").(bool),
+		List:    stringSetToSlice(d.Get("list").(*schema.Set)),
+	}
+}
+
+func resourceSendgridMailSettingsAddressWhitelistCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsAddressWhitelist(
+		ctx, c, d, schema.TimeoutCreate, mailSettingsAddressWhitelistFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating address whitelist mail setting", err)
+	}
+
+	d.SetId("address_whitelist")
+
+	return resourceSendgridMailSettingsAddressWhitelistRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsAddressWhitelistRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingAddressWhitelist()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading address whitelist mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("list", setting.List)
+
+	return nil
+}
+
+func resourceSendgridMailSettingsAddressWhitelistUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsAddressWhitelist(
+		ctx, c, d, schema.TimeoutUpdate, mailSettingsAddressWhitelistFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating address whitelist mail setting", err)
+	}
+
+	return resourceSendgridMailSettingsAddressWhitelistRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsAddressWhitelistDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsAddressWhitelist(
+		ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingAddressWhitelist{},
+	); err != nil {
+		return errorToDiags("failed deleting address whitelist mail setting", err)
+	}
+
+	return nil
+}
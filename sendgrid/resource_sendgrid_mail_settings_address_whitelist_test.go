@@ -0,0 +1,43 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridMailSettingsAddressWhitelistBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsAddressWhitelistConfigBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_whitelist.address_whitelist", "list.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsAddressWhitelistConfigBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_address_whitelist.address_whitelist", "list.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_address_whitelist.address_whitelist",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMailSettingsAddressWhitelistConfigBasic(value string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_address_whitelist" "address_whitelist" {
+		enabled = true
+		list    = ["%s.example.org"]
+	}
+	`, value)
+}
@@ -0,0 +1,148 @@
+/*
+Provide a resource to manage the BCC mail setting: every email is blind copied to an address.
+Destroying the resource disables the BCC and clears its address.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_bcc" "bcc" {
+	enabled = true
+	email   = "archive@example.org"
+}
+```
+Import
+The BCC mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bcc.bcc bcc
+```
+The BCC mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bcc.subuser_bcc subUserName/bcc
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsBCC() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsBCCCreate,
+		ReadContext:   resourceSendgridMailSettingsBCCRead,
+		UpdateContext: resourceSendgridMailSettingsBCCUpdate,
+		DeleteContext: resourceSendgridMailSettingsBCCDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Blind copy every email to the address.",
+				Optional:    true,
+				Default:     true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The address every email is blind copied to.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func updateMailSettingsBCC(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingBCC,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingBCC(setting)
+	})
+
+	return err
+}
+
+func mailSettingsBCCFromResourceData(d *schema.ResourceData) sendgrid.MailSettingBCC {
+	return sendgrid.MailSettingBCC{
+		Enabled: d.Get("enabled").(bool),
+		Email:   d.Get("email").(string),
+	}
+}
+
+func resourceSendgridMailSettingsBCCCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsBCC(ctx, c, d, schema.TimeoutCreate, mailSettingsBCCFromResourceData(d)); err != nil {
+		return errorToDiags("failed creating BCC mail setting", err)
+	}
+
+	d.SetId("bcc")
+
+	return resourceSendgridMailSettingsBCCRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBCCRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingBCC()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading BCC mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("email", setting.Email)
+
+	return nil
+}
+
+func resourceSendgridMailSettingsBCCUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsBCC(ctx, c, d, schema.TimeoutUpdate, mailSettingsBCCFromResourceData(d)); err != nil {
+		return errorToDiags("failed updating BCC mail setting", err)
+	}
+
+	return resourceSendgridMailSettingsBCCRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBCCDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsBCC(ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingBCC{}); err != nil {
+		return errorToDiags("failed deleting BCC mail setting", err)
+	}
+
+	return nil
+}
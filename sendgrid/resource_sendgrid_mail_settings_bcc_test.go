@@ -0,0 +1,43 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridMailSettingsBCCBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsBCCConfigBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bcc.bcc", "email", "first@example.org"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsBCCConfigBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bcc.bcc", "email", "second@example.org"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bcc.bcc",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMailSettingsBCCConfigBasic(value string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_bcc" "bcc" {
+		enabled = true
+		email   = "%s@example.org"
+	}
+	`, value)
+}
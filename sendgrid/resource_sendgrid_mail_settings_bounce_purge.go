@@ -0,0 +1,175 @@
+/*
+Provide a resource to manage the bounce purge mail setting:
+the bounces are removed from the suppression list after a number of days.
+Destroying the resource disables the bounce purge and clears its delays.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_bounce_purge" "bounce_purge" {
+	enabled      = true
+	soft_bounces = 7
+	hard_bounces = 30
+}
+```
+The bounce purge of a subuser can be managed from the parent account, e.g.
+```hcl
+resource "sendgrid_mail_settings_bounce_purge" "subuser_bounce_purge" {
+	sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+	enabled               = true
+	hard_bounces          = 30
+}
+```
+Import
+The bounce purge mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bounce_purge.bounce_purge bounce_purge
+```
+The bounce purge mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_bounce_purge.subuser_bounce_purge subUserName/bounce_purge
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsBouncePurge() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsBouncePurgeCreate,
+		ReadContext:   resourceSendgridMailSettingsBouncePurgeRead,
+		UpdateContext: resourceSendgridMailSettingsBouncePurgeUpdate,
+		DeleteContext: resourceSendgridMailSettingsBouncePurgeDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Purge the bounces after the given number of days.",
+				Optional:    true,
+				Default:     true,
+			},
+			"soft_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the soft bounces are purged.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 3650),
+			},
+			"hard_bounces": {
+				Type:         schema.TypeInt,
+				Description:  "The number of days after which the hard bounces are purged.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 3650),
+			},
+		},
+	}
+}
+
+func updateMailSettingsBouncePurge(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingBouncePurge,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingBouncePurge(setting)
+	})
+
+	return err
+}
+
+func mailSettingsBouncePurgeFromResourceData(d *schema.ResourceData) sendgrid.MailSettingBouncePurge {
+	return sendgrid.MailSettingBouncePurge{
+		Enabled:     d.Get("enabled").(bool),
+		SoftBounces: d.Get("soft_bounces").(int),
+		HardBounces: d.Get("hard_bounces").(int),
+	}
+}
+
+func resourceSendgridMailSettingsBouncePurgeCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsBouncePurge(
+		ctx, c, d, schema.TimeoutCreate, mailSettingsBouncePurgeFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating bounce purge mail setting", err)
+	}
+
+	d.SetId("bounce_purge")
+
+	return resourceSendgridMailSettingsBouncePurgeRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBouncePurgeRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingBouncePurge()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading bounce purge mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("soft_bounces", setting.SoftBounces)
+	//nolint:errcheck
+	d.Set("hard_bounces", setting.HardBounces)
+
+	return nil
+}
+
+func resourceSendgridMailSettingsBouncePurgeUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsBouncePurge(
+		ctx, c, d, schema.TimeoutUpdate, mailSettingsBouncePurgeFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating bounce purge mail setting", err)
+	}
+
+	return resourceSendgridMailSettingsBouncePurgeRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsBouncePurgeDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsBouncePurge(
+		ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingBouncePurge{},
+	); err != nil {
+		return errorToDiags("failed deleting bounce purge mail setting", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridMailSettingsBouncePurgeBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsBouncePurgeConfigBasic(7),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.bounce_purge", "soft_bounces", "7"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsBouncePurgeConfigBasic(14),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_bounce_purge.bounce_purge", "soft_bounces", "14"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_bounce_purge.bounce_purge",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMailSettingsBouncePurgeConfigBasic(days int) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_bounce_purge" "bounce_purge" {
+		enabled      = true
+		soft_bounces = %d
+		hard_bounces = 30
+	}
+	`, days)
+}
@@ -0,0 +1,286 @@
+/*
+Provide a resource to manage the footer mail setting: a footer appended to every email.
+Destroying the resource disables the footer and clears its content.
+The HTML content is checked, since a malformed footer would be appended to every email: the plan warns
+when its tags aren't closed in order, except the void elements like `<br>`, or its attribute values aren't quoted,
+and so does the apply for the content read from html_content_file. The check isn't an HTML parser, valid HTML
+omitting optional end tags is reported too, so it never fails.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_footer" "footer" {
+	enabled       = true
+	html_content  = "<p>Example Inc., 1 Example street</p>"
+	plain_content = "Example Inc., 1 Example street"
+}
+
+resource "sendgrid_mail_settings_footer" "legal" {
+	enabled            = true
+	html_content_file  = "${path.module}/footers/legal.html"
+	plain_content_file = "${path.module}/footers/legal.txt"
+}
+```
+Import
+The footer mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_footer.footer footer
+```
+The footer mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_footer.subuser_footer subUserName/footer
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsFooter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsFooterCreate,
+		ReadContext:   resourceSendgridMailSettingsFooterRead,
+		UpdateContext: resourceSendgridMailSettingsFooterUpdate,
+		DeleteContext: resourceSendgridMailSettingsFooterDelete,
+		CustomizeDiff: resourceSendgridMailSettingsFooterCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Append the footer to every email.",
+				Optional:    true,
+				Default:     true,
+			},
+			"html_content": {
+				Type:          schema.TypeString,
+				Description:   "The HTML content of the footer.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"html_content_file"},
+				ValidateFunc:  validateHTML,
+			},
+			"html_content_file": {
+				Type:          schema.TypeString,
+				Description:   "The path of a file to read the HTML content of the footer from.",
+				Optional:      true,
+				ConflictsWith: []string{"html_content"},
+			},
+			"plain_content": {
+				Type:          schema.TypeString,
+				Description:   "The plain text content of the footer.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"plain_content_file"},
+			},
+			"plain_content_file": {
+				Type:          schema.TypeString,
+				Description:   "The path of a file to read the plain text content of the footer from.",
+				Optional:      true,
+				ConflictsWith: []string{"plain_content"},
+			},
+		},
+	}
+}
+
+// htmlVoidElements are the HTML elements which have no closing tag.
+var htmlVoidElements = map[string]bool{
+	"area": true, "base": true, "br": true, "col": true, "embed": true, "hr": true, "img": true,
+	"input": true, "link": true, "meta": true, "param": true, "source": true, "track": true, "wbr": true,
+}
+
+// checkHTML checks that the HTML content looks well-formed: its tags are closed in order, except the void elements,
+// and its attribute values are quoted. It isn't an HTML parser and rejects the valid HTML omitting optional end tags,
+// so its errors are only reported as warnings.
+func checkHTML(content string) error {
+	decoder := xml.NewDecoder(strings.NewReader(content))
+	decoder.Strict = false
+	decoder.Entity = xml.HTMLEntity
+
+	var open []string
+
+	for {
+		// the raw tokens aren't matched by the decoder, which would silently close the tags when not strict.
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		switch t := token.(type) {
+		case xml.StartElement:
+			if name := strings.ToLower(t.Name.Local); !htmlVoidElements[name] {
+				open = append(open, name)
+			}
+		case xml.EndElement:
+			name := strings.ToLower(t.Name.Local)
+			if htmlVoidElements[name] {
+				continue
+			}
+
+			if len(open) == 0 || open[len(open)-1] != name {
+				line := 1 + strings.Count(content[:decoder.InputOffset()], "\n")
+
+				return fmt.Errorf("line %d: unexpected </%s>", line, name)
+			}
+
+			open = open[:len(open)-1]
+		}
+	}
+
+	if len(open) > 0 {
+		return fmt.Errorf("<%s> isn't closed", open[len(open)-1])
+	}
+
+	return nil
+}
+
+func validateHTML(v interface{}, k string) ([]string, []error) {
+	if err := checkHTML(v.(string)); err != nil {
+		return []string{malformedHTML(k, err).Error()}, nil
+	}
+
+	return nil, nil
+}
+
+// htmlContentFileWarnings warns when the HTML content read from html_content_file doesn't look well-formed,
+// the content set in the configuration was already checked by validateHTML.
+func htmlContentFileWarnings(d *schema.ResourceData) diag.Diagnostics {
+	if d.Get("html_content_file").(string) == "" {
+		return nil
+	}
+
+	if err := checkHTML(d.Get("html_content").(string)); err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  malformedHTML("html_content_file", err).Error(),
+		}}
+	}
+
+	return nil
+}
+
+func resourceSendgridMailSettingsFooterCustomizeDiff(
+	_ context.Context,
+	d *schema.ResourceDiff,
+	_ interface{},
+) error {
+	if err := setContentFromFile(d, "html_content", "html_content_file"); err != nil {
+		return err
+	}
+
+	return setContentFromFile(d, "plain_content", "plain_content_file")
+}
+
+func updateMailSettingsFooter(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingFooter,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingFooter(setting)
+	})
+
+	return err
+}
+
+func mailSettingsFooterFromResourceData(d *schema.ResourceData) sendgrid.MailSettingFooter {
+	return sendgrid.MailSettingFooter{
+		Enabled:      d.Get("enabled").(bool),
+		HTMLContent:  d.Get("html_content").(string),
+		PlainContent: d.Get("plain_content").(string),
+	}
+}
+
+func resourceSendgridMailSettingsFooterCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsFooter(
+		ctx, c, d, schema.TimeoutCreate, mailSettingsFooterFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating footer mail setting", err)
+	}
+
+	d.SetId("footer")
+
+	return append(htmlContentFileWarnings(d), resourceSendgridMailSettingsFooterRead(ctx, d, m)...)
+}
+
+func resourceSendgridMailSettingsFooterRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingFooter()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading footer mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("html_content", setting.HTMLContent)
+	//nolint:errcheck
+	d.Set("plain_content", setting.PlainContent)
+
+	return nil
+}
+
+func resourceSendgridMailSettingsFooterUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsFooter(
+		ctx, c, d, schema.TimeoutUpdate, mailSettingsFooterFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating footer mail setting", err)
+	}
+
+	return append(htmlContentFileWarnings(d), resourceSendgridMailSettingsFooterRead(ctx, d, m)...)
+}
+
+func resourceSendgridMailSettingsFooterDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsFooter(ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingFooter{}); err != nil {
+		return errorToDiags("failed deleting footer mail setting", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,128 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridMailSettingsFooterBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsFooterConfigBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.footer", "html_content", "<p>first</p>"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsFooterConfigBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_footer.footer", "html_content", "<p>second</p>"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_footer.footer",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsFooterFromFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "terraform-footer")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	htmlFile := filepath.Join(dir, "footer.html")
+	plainFile := filepath.Join(dir, "footer.txt")
+
+	writeFile := func(path, content string) func() {
+		return func() {
+			if writeErr := ioutil.WriteFile(path, []byte(content), 0o600); writeErr != nil {
+				t.Fatal(writeErr)
+			}
+		}
+	}
+
+	config := fmt.Sprintf(`
+	resource "sendgrid_mail_settings_footer" "footer" {
+		enabled            = true
+		html_content_file  = %q
+		plain_content_file = %q
+	}
+	`, htmlFile, plainFile)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFile(htmlFile, "<p>Example Inc.<br>1 Example street</p>\n")()
+					writeFile(plainFile, "Example Inc., 1 Example street\n")()
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sendgrid_mail_settings_footer.footer", "html_content", "<p>Example Inc.<br>1 Example street</p>\n",
+					),
+				),
+			},
+			{
+				PreConfig: writeFile(htmlFile, "<ul><li>Example Inc.<li>1 Example street</ul>"),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"sendgrid_mail_settings_footer.footer", "html_content", "<ul><li>Example Inc.<li>1 Example street</ul>",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestSendgridMailSettingsFooterHTMLValidation(t *testing.T) {
+	validate := sendgrid.Provider().ResourcesMap["sendgrid_mail_settings_footer"].Schema["html_content"].ValidateFunc
+
+	for content, warned := range map[string]bool{
+		"<p>Example Inc., 1 Example street</p>":               false,
+		`<p class="legal">A &amp; B &copy;<br>C<br/><hr></p>`: false,
+		"<!-- legal --><div><p>Example</p><p>Inc.</p></div>":  false,
+		"<p>Example Inc.":                  true,
+		"<p><b>Example Inc.</p></b>":       true,
+		`<p class="legal>Example Inc.</p>`: true,
+		"</p>":                             true,
+		"<ul><li>Example<li>Inc.</ul>":     true,
+	} {
+		// the check isn't an HTML parser, so it only warns.
+		warnings, errs := validate(content, "html_content")
+		if len(errs) > 0 {
+			t.Errorf("%s: expected no error, got: %v", content, errs)
+		}
+
+		if warned != (len(warnings) > 0) {
+			t.Errorf("%s: expected warned %t, got warnings: %v", content, warned, warnings)
+		}
+	}
+}
+
+func testAccCheckSendgridMailSettingsFooterConfigBasic(value string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_footer" "footer" {
+		enabled       = true
+		html_content  = "<p>%s</p>"
+		plain_content = "%s"
+	}
+	`, value, value)
+}
@@ -0,0 +1,171 @@
+/*
+Provide a resource to manage the forward spam mail setting:
+the spam reports are forwarded to a list of addresses.
+Destroying the resource disables the forwarding and clears its addresses.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_forward_spam" "forward_spam" {
+	enabled = true
+	emails  = ["abuse@example.org", "postmaster@example.org"]
+}
+```
+Import
+The forward spam mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_forward_spam.forward_spam forward_spam
+```
+The forward spam mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_forward_spam.subuser_forward_spam subUserName/forward_spam
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMailSettingsForwardSpam() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsForwardSpamCreate,
+		ReadContext:   resourceSendgridMailSettingsForwardSpamRead,
+		UpdateContext: resourceSendgridMailSettingsForwardSpamUpdate,
+		DeleteContext: resourceSendgridMailSettingsForwardSpamDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Forward the spam reports to the addresses.",
+				Optional:    true,
+				Default:     true,
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The addresses the spam reports are forwarded to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func updateMailSettingsForwardSpam(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingForwardSpam,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingForwardSpam(setting)
+	})
+
+	return err
+}
+
+func mailSettingsForwardSpamFromResourceData(d *schema.ResourceData) sendgrid.MailSettingForwardSpam {
+	return sendgrid.MailSettingForwardSpam{
+		Enabled: d.Get("enabled").(bool),
+		Email:   strings.Join(stringSetToSlice(d.Get("emails").(*schema.Set)), ","),
+	}
+}
+
+// splitEmails splits the comma separated addresses returned by Sendgrid.
+func splitEmails(emails string) []string {
+	if emails == "" {
+		return nil
+	}
+
+	split := strings.Split(emails, ",")
+	for i, email := range split {
+		split[i] = strings.TrimSpace(email)
+	}
+
+	return split
+}
+
+func resourceSendgridMailSettingsForwardSpamCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsForwardSpam(
+		ctx, c, d, schema.TimeoutCreate, mailSettingsForwardSpamFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating forward spam mail setting", err)
+	}
+
+	d.SetId("forward_spam")
+
+	return resourceSendgridMailSettingsForwardSpamRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsForwardSpamRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingForwardSpam()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading forward spam mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("emails", splitEmails(setting.Email))
+
+	return nil
+}
+
+func resourceSendgridMailSettingsForwardSpamUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsForwardSpam(
+		ctx, c, d, schema.TimeoutUpdate, mailSettingsForwardSpamFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating forward spam mail setting", err)
+	}
+
+	return resourceSendgridMailSettingsForwardSpamRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsForwardSpamDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsForwardSpam(
+		ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingForwardSpam{},
+	); err != nil {
+		return errorToDiags("failed deleting forward spam mail setting", err)
+	}
+
+	return nil
+}
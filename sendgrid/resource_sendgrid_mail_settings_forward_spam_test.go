@@ -0,0 +1,43 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridMailSettingsForwardSpamBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsForwardSpamConfigBasic("first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_spam.forward_spam", "emails.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsForwardSpamConfigBasic("second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_forward_spam.forward_spam", "emails.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_forward_spam.forward_spam",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMailSettingsForwardSpamConfigBasic(value string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_forward_spam" "forward_spam" {
+		enabled = true
+		emails  = ["%s@example.org"]
+	}
+	`, value)
+}
@@ -0,0 +1,205 @@
+/*
+Provide a resource to manage the spam check mail setting:
+the emails whose spam score is above the maximum score are dropped, and posted to a URL if it's set.
+Destroying the resource disables the spam check and resets its maximum score.
+Example Usage
+```hcl
+resource "sendgrid_mail_settings_spam_check" "spam_check" {
+	enabled   = true
+	max_score = 5
+	url       = "https://example.org/spam"
+}
+```
+The spam check of a subuser can be managed from the parent account, e.g.
+```hcl
+resource "sendgrid_mail_settings_spam_check" "subuser_spam_check" {
+	sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+	enabled               = true
+	max_score             = 5
+}
+```
+Import
+The spam check mail setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_spam_check.spam_check spam_check
+```
+The spam check mail setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_mail_settings_spam_check.subuser_spam_check subUserName/spam_check
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// defaultSpamCheckMaxScore is the maximum spam score of Sendgrid, restored when the resource is destroyed.
+const defaultSpamCheckMaxScore = 5
+
+func resourceSendgridMailSettingsSpamCheck() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMailSettingsSpamCheckCreate,
+		ReadContext:   resourceSendgridMailSettingsSpamCheckRead,
+		UpdateContext: resourceSendgridMailSettingsSpamCheckUpdate,
+		DeleteContext: resourceSendgridMailSettingsSpamCheckDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the mail setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Drop the emails whose spam score is above the maximum score.",
+				Optional:    true,
+				Default:     true,
+			},
+			"max_score": {
+				Type:         schema.TypeInt,
+				Description:  "The spam score above which the emails are dropped, from 1 (strictest) to 10.",
+				Optional:     true,
+				Default:      defaultSpamCheckMaxScore,
+				ValidateFunc: validation.IntBetween(1, 10),
+			},
+			"url": {
+				Type:         schema.TypeString,
+				Description:  "The URL the dropped emails are posted to.",
+				Optional:     true,
+				ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+			},
+		},
+	}
+}
+
+// mailSettingsClient returns the client managing the mail settings of the subuser, if any,
+// otherwise the ones of the default subuser of the provider, unless parent_account is set.
+func mailSettingsClient(d *schema.ResourceData, m interface{}) *sendgrid.Client {
+	return onBehalfOfClient(d, m)
+}
+
+// resourceSendgridMailSettingsImport imports a mail setting, of a subuser when its username prefixes the ID.
+func resourceSendgridMailSettingsImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("parent_account", false)
+
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) == ImportSplitParts {
+		setImportedOnBehalfOf(d, parts[0])
+		d.SetId(parts[1])
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func updateMailSettingsSpamCheck(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.MailSettingSpamCheck,
+) error {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateMailSettingSpamCheck(setting)
+	})
+
+	return err
+}
+
+func mailSettingsSpamCheckFromResourceData(d *schema.ResourceData) sendgrid.MailSettingSpamCheck {
+	return sendgrid.MailSettingSpamCheck{
+		Enabled:  d.Get("enabled").(bool),
+		MaxScore: d.Get("max_score").(int),
+		URL:      d.Get("url").(string),
+	}
+}
+
+func resourceSendgridMailSettingsSpamCheckCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsSpamCheck(
+		ctx, c, d, schema.TimeoutCreate, mailSettingsSpamCheckFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating spam check mail setting", err)
+	}
+
+	d.SetId("spam_check")
+
+	return resourceSendgridMailSettingsSpamCheckRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsSpamCheckRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadMailSettingSpamCheck()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading spam check mail setting", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("enabled", setting.Enabled)
+	//nolint:errcheck
+	d.Set("max_score", setting.MaxScore)
+	//nolint:errcheck
+	d.Set("url", setting.URL)
+
+	return nil
+}
+
+func resourceSendgridMailSettingsSpamCheckUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	if err := updateMailSettingsSpamCheck(
+		ctx, c, d, schema.TimeoutUpdate, mailSettingsSpamCheckFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating spam check mail setting", err)
+	}
+
+	return resourceSendgridMailSettingsSpamCheckRead(ctx, d, m)
+}
+
+func resourceSendgridMailSettingsSpamCheckDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// reset the setting to the Sendgrid defaults.
+	if err := updateMailSettingsSpamCheck(ctx, c, d, schema.TimeoutDelete, sendgrid.MailSettingSpamCheck{
+		MaxScore: defaultSpamCheckMaxScore,
+	}); err != nil {
+		return errorToDiags("failed deleting spam check mail setting", err)
+	}
+
+	return nil
+}
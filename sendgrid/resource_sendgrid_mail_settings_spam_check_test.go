@@ -0,0 +1,75 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridMailSettingsSpamCheckBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMailSettingsSpamCheckConfigBasic(5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.spam_check", "max_score", "5"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMailSettingsSpamCheckConfigBasic(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.spam_check", "max_score", "3"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_mail_settings_spam_check.spam_check",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridMailSettingsSpamCheckOnBehalfOf(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_subuser" "subuser" {
+					username = %q
+					password = "Passw0rd!%s"
+					email    = "%s@example.org"
+					ips      = ["127.0.0.1"]
+				}
+
+				resource "sendgrid_mail_settings_spam_check" "spam_check" {
+					sub_user_on_behalf_of = sendgrid_subuser.subuser.username
+					enabled               = true
+					max_score             = 4
+				}
+				`, username, acctest.RandString(10), username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_mail_settings_spam_check.spam_check", "max_score", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMailSettingsSpamCheckConfigBasic(maxScore int) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_mail_settings_spam_check" "spam_check" {
+		enabled   = true
+		max_score = %d
+		url       = "https://example.org/spam"
+	}
+	`, maxScore)
+}
@@ -0,0 +1,395 @@
+/*
+Provide a resource to manage marketing contacts in batch: all the contacts are upserted by a single request,
+and the apply waits for the one job upserting them, instead of a job per contact as with `sendgrid_contact`.
+The contacts are identified by email, case insensitively: when an email is listed several times,
+the last contact with it is kept. The resource only manages the contacts it lists, removing a contact
+from the list deletes it, the other contacts of the account are left untouched.
+The custom fields are keyed by name and converted to the type of the field, as with `sendgrid_contact`.
+Example Usage
+```hcl
+resource "sendgrid_marketing_contacts" "newsletter" {
+	list_ids = [sendgrid_marketing_list.newsletter.id]
+
+	contact {
+		email      = "john.doe@example.org"
+		first_name = "John"
+		last_name  = "Doe"
+	}
+
+	contact {
+		email = "jane.doe@example.org"
+
+		custom_fields = {
+			age = "42"
+		}
+	}
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMarketingContacts() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMarketingContactsCreate,
+		ReadContext:   resourceSendgridMarketingContactsRead,
+		UpdateContext: resourceSendgridMarketingContactsUpdate,
+		DeleteContext: resourceSendgridMarketingContactsDelete,
+
+		Schema: map[string]*schema.Schema{
+			"list_ids": {
+				Type:        schema.TypeSet,
+				Description: "The IDs of the lists all the contacts belong to.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"contact": {
+				Type:        schema.TypeList,
+				Description: "The contacts, identified by email: the last contact with an email listed several times is kept.",
+				Required:    true,
+				MinItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeString,
+							Description: "The email address of the contact.",
+							Required:    true,
+						},
+						"first_name": {
+							Type:        schema.TypeString,
+							Description: "The first name of the contact.",
+							Optional:    true,
+						},
+						"last_name": {
+							Type:        schema.TypeString,
+							Description: "The last name of the contact.",
+							Optional:    true,
+						},
+						"custom_fields": {
+							Type:        schema.TypeMap,
+							Description: "The values of the custom fields of the contact, keyed by name.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"contact_ids": {
+				Type:        schema.TypeMap,
+				Description: "The IDs of the contacts managed by the resource, keyed by email.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// lastMarketingContacts returns the index of the last contact listed with each email, case insensitively.
+func lastMarketingContacts(contacts []interface{}) map[string]int {
+	last := make(map[string]int, len(contacts))
+
+	for i, v := range contacts {
+		last[strings.ToLower(v.(map[string]interface{})["email"].(string))] = i
+	}
+
+	return last
+}
+
+// dedupMarketingContacts removes the contacts whose email is listed again later, case insensitively,
+// so that the batch upserts each contact once, with the last values configured for it.
+// It also returns the emails listed several times.
+func dedupMarketingContacts(contacts []interface{}) ([]map[string]interface{}, []string) {
+	last := lastMarketingContacts(contacts)
+	deduped := make([]map[string]interface{}, 0, len(last))
+
+	var duplicates []string
+
+	for i, v := range contacts {
+		contact := v.(map[string]interface{})
+		if last[strings.ToLower(contact["email"].(string))] == i {
+			deduped = append(deduped, contact)
+		} else {
+			duplicates = append(duplicates, contact["email"].(string))
+		}
+	}
+
+	return deduped, duplicates
+}
+
+// upsertMarketingContacts upserts all the contacts with a single request, and waits for the job upserting them.
+func upsertMarketingContacts(
+	ctx context.Context,
+	d *schema.ResourceData,
+	timeoutKey string,
+	c *sendgrid.Client,
+) (string, diag.Diagnostics) {
+	definitions, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.Cached().ListFieldDefinitions()
+	})
+	if err != nil {
+		return "", errorToDiags("failed listing field definitions", err)
+	}
+
+	contacts, duplicates := dedupMarketingContacts(d.Get("contact").([]interface{}))
+	requests := make([]sendgrid.ContactRequest, 0, len(contacts))
+
+	var diags diag.Diagnostics
+
+	if len(duplicates) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity:      diag.Warning,
+			Summary:       "contacts listed several times",
+			Detail:        "only the last contact listed with these emails is upserted: " + strings.Join(duplicates, ", "),
+			AttributePath: cty.GetAttrPath("contact"),
+		})
+	}
+
+	for _, contact := range contacts {
+		customFields, err := contactCustomFields(
+			definitions.([]sendgrid.FieldDefinition),
+			contact["custom_fields"].(map[string]interface{}),
+		)
+		if err != nil {
+			summary := "failed converting custom fields of " + contact["email"].(string)
+
+			return "", append(diags, errorToDiags(summary, err)...)
+		}
+
+		requests = append(requests, sendgrid.ContactRequest{
+			Email:        contact["email"].(string),
+			FirstName:    contact["first_name"].(string),
+			LastName:     contact["last_name"].(string),
+			CustomFields: customFields,
+		})
+	}
+
+	jobID, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpsertContacts(stringSetToSlice(d.Get("list_ids").(*schema.Set)), requests)
+	})
+	if err != nil {
+		return "", append(diags, errorToDiags("failed upserting contacts", err)...)
+	}
+
+	id := jobID.(string)
+
+	err = resource.RetryContext(ctx, d.Timeout(timeoutKey), func() *resource.RetryError {
+		job, requestErr := c.ReadContactsImport(id)
+		if requestErr.Err != nil {
+			return resource.NonRetryableError(requestErr)
+		}
+
+		switch job.Status {
+		case sendgrid.ContactsImportStatusCompleted:
+			return nil
+		case sendgrid.ContactsImportStatusErrored, sendgrid.ContactsImportStatusFailed:
+			return resource.NonRetryableError(contactsImportFailed(id, job))
+		default:
+			return resource.RetryableError(ErrContactsImportPending)
+		}
+	})
+	if err != nil {
+		return "", append(diags, errorToDiags("failed waiting for the contacts to be upserted", err)...)
+	}
+
+	return id, diags
+}
+
+func resourceSendgridMarketingContactsCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	jobID, diags := upsertMarketingContacts(ctx, d, schema.TimeoutCreate, c)
+	if diags.HasError() {
+		return diags
+	}
+
+	// the contacts have no common ID, the resource is identified by the job which created them.
+	d.SetId(jobID)
+
+	return append(diags, resourceSendgridMarketingContactsRead(ctx, d, m)...)
+}
+
+func resourceSendgridMarketingContactsRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	configured := d.Get("contact").([]interface{})
+	deduped, _ := dedupMarketingContacts(configured)
+	emails := make([]string, 0, len(deduped))
+
+	for _, contact := range deduped {
+		emails = append(emails, contact["email"].(string))
+	}
+
+	found, requestErr := c.ReadContactsByEmails(emails)
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading contacts", requestErr)}
+	}
+
+	byEmail := make(map[string]sendgrid.Contact, len(found))
+	for _, contact := range found {
+		byEmail[strings.ToLower(contact.Email)] = contact
+	}
+
+	// the lists are the configured ones all the contacts still belong to.
+	listIDs := d.Get("list_ids").(*schema.Set)
+	contacts := make([]interface{}, 0, len(configured))
+	contactIDs := make(map[string]interface{}, len(deduped))
+	last := lastMarketingContacts(configured)
+
+	for i, raw := range configured {
+		v := raw.(map[string]interface{})
+
+		if last[strings.ToLower(v["email"].(string))] != i {
+			// the contacts overridden by a later one are kept as configured, so that they don't show a diff.
+			contacts = append(contacts, v)
+
+			continue
+		}
+
+		contact, ok := byEmail[strings.ToLower(v["email"].(string))]
+		if !ok {
+			// the contact was deleted outside of Terraform, the next apply upserts it again.
+			continue
+		}
+
+		contactLists := stringSliceToSet(contact.ListIDs)
+		listIDs = listIDs.Intersection(contactLists)
+
+		contacts = append(contacts, map[string]interface{}{
+			"email":      v["email"],
+			"first_name": contact.FirstName,
+			"last_name":  contact.LastName,
+			"custom_fields": flattenContactCustomFields(
+				v["custom_fields"].(map[string]interface{}), contact.CustomFields,
+			),
+		})
+		contactIDs[v["email"].(string)] = contact.ID
+	}
+
+	//nolint:errcheck
+	d.Set("contact", contacts)
+	//nolint:errcheck
+	d.Set("contact_ids", contactIDs)
+	//nolint:errcheck
+	d.Set("list_ids", listIDs)
+
+	return nil
+}
+
+// removedMarketingContactIDs returns the IDs of the managed contacts whose email isn't configured anymore.
+func removedMarketingContactIDs(managed map[string]interface{}, contacts []interface{}) []string {
+	configured := make(map[string]bool, len(contacts))
+	for _, v := range contacts {
+		configured[strings.ToLower(v.(map[string]interface{})["email"].(string))] = true
+	}
+
+	var removed []string
+
+	for email, id := range managed {
+		if !configured[strings.ToLower(email)] {
+			removed = append(removed, id.(string))
+		}
+	}
+
+	return removed
+}
+
+func resourceSendgridMarketingContactsUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	oldContactIDs, _ := d.GetChange("contact_ids")
+
+	if removed := removedMarketingContactIDs(
+		oldContactIDs.(map[string]interface{}), d.Get("contact").([]interface{}),
+	); len(removed) > 0 {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteContacts(removed)
+		})
+		if err != nil {
+			return errorToDiags("failed deleting contacts", err)
+		}
+	}
+
+	var diags diag.Diagnostics
+
+	if d.HasChanges("contact", "list_ids") {
+		if _, diags = upsertMarketingContacts(ctx, d, schema.TimeoutUpdate, c); diags.HasError() {
+			return diags
+		}
+	}
+
+	// upserting contacts only adds them to lists.
+	oldListIDs, newListIDs := d.GetChange("list_ids")
+	removedLists := oldListIDs.(*schema.Set).Difference(newListIDs.(*schema.Set)).List()
+
+	if len(removedLists) > 0 {
+		if readDiags := resourceSendgridMarketingContactsRead(ctx, d, m); readDiags.HasError() {
+			return append(diags, readDiags...)
+		}
+
+		ids := make([]string, 0, len(d.Get("contact_ids").(map[string]interface{})))
+		for _, id := range d.Get("contact_ids").(map[string]interface{}) {
+			ids = append(ids, id.(string))
+		}
+
+		for _, listID := range removedLists {
+			listID := listID.(string)
+
+			_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+				return c.RemoveContactFromList(listID, strings.Join(ids, ","))
+			})
+			if err != nil {
+				return append(diags, errorToDiags("failed removing contacts from list", err)...)
+			}
+		}
+	}
+
+	return append(diags, resourceSendgridMarketingContactsRead(ctx, d, m)...)
+}
+
+func resourceSendgridMarketingContactsDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	ids := make([]string, 0, len(d.Get("contact_ids").(map[string]interface{})))
+	for _, id := range d.Get("contact_ids").(map[string]interface{}) {
+		ids = append(ids, id.(string))
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteContacts(ids)
+	})
+	if err != nil {
+		return errorToDiags("failed deleting contacts", err)
+	}
+
+	return nil
+}
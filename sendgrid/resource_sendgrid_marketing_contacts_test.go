@@ -0,0 +1,134 @@
+package sendgrid_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridMarketingContactsBasic(t *testing.T) {
+	prefix := "terraform-" + acctest.RandString(10)
+	john := prefix + "-john@example.org"
+	jane := prefix + "-jane@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMarketingContactsConfig(john, jane),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_marketing_contacts.contacts", "contact.#", "2"),
+					resource.TestCheckResourceAttr("sendgrid_marketing_contacts.contacts", "contact_ids.%", "2"),
+				),
+			},
+			{
+				// removing a contact from the list deletes only that one.
+				Config: testAccCheckSendgridMarketingContactsConfig(john),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_marketing_contacts.contacts", "contact.#", "1"),
+					resource.TestCheckResourceAttr("sendgrid_marketing_contacts.contacts", "contact_ids.%", "1"),
+					resource.TestCheckResourceAttrSet("sendgrid_marketing_contacts.contacts", "contact_ids."+john),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMarketingContactsConfig(emails ...string) string {
+	var contacts strings.Builder
+
+	for _, email := range emails {
+		fmt.Fprintf(&contacts, `
+		contact {
+			email      = %q
+			first_name = "Terraform"
+		}
+		`, email)
+	}
+
+	return fmt.Sprintf(`
+	resource "sendgrid_marketing_contacts" "contacts" {
+		%s
+	}
+	`, contacts.String())
+}
+
+func TestSendgridMarketingContactsCreateDedupsEmails(t *testing.T) {
+	var upserted []sendgrid.ContactRequest
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/marketing/field_definitions":
+			fmt.Fprint(w, `{"custom_fields": []}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/marketing/contacts":
+			var body struct {
+				Contacts []sendgrid.ContactRequest `json:"contacts"`
+			}
+
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected body: %s", err)
+			}
+
+			upserted = append(upserted, body.Contacts...)
+
+			w.WriteHeader(http.StatusAccepted)
+			fmt.Fprint(w, `{"job_id": "job"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/marketing/contacts/imports/job":
+			fmt.Fprint(w, `{"id": "job", "status": "completed"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/marketing/contacts/search/emails":
+			fmt.Fprint(w, `{"result": {
+				"john@example.org": {"contact": {"id": "1", "email": "john@example.org", "first_name": "Johnny"}},
+				"jane@example.org": {"contact": {"id": "2", "email": "jane@example.org"}}
+			}}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_marketing_contacts"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"contact": []interface{}{
+			map[string]interface{}{"email": "john@example.org", "first_name": "John"},
+			map[string]interface{}{"email": "jane@example.org"},
+			map[string]interface{}{"email": "John@example.org", "first_name": "Johnny"},
+		},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	if diags.HasError() {
+		t.Fatalf("failed creating contacts: %v", diags)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "john@example.org") {
+		t.Fatalf("expected a warning about the email listed twice, got: %v", diags)
+	}
+
+	// the batch has each email once, with the last values listed.
+	if len(upserted) != 2 || upserted[0].Email != "jane@example.org" || upserted[1].FirstName != "Johnny" {
+		t.Fatalf("unexpected contacts upserted: %+v", upserted)
+	}
+
+	if d.Id() != "job" {
+		t.Errorf("expected the resource to be identified by the job, got %q", d.Id())
+	}
+
+	if ids := d.Get("contact_ids").(map[string]interface{}); len(ids) != 2 || ids["John@example.org"] != "1" {
+		t.Errorf("unexpected contact IDs: %v", ids)
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Provide a resource to manage a list of marketing contacts.
+By default, destroying the list only deletes the list, its contacts are kept and stay in the other lists they belong to.
+When `delete_contacts` is true, destroying the list also deletes every contact of the list from the account,
+including from the other lists they belong to: this can't be undone.
+Sendgrid deletes the contacts asynchronously, the destroy waits until the list is deleted.
+Example Usage
+```hcl
+resource "sendgrid_marketing_list" "newsletter" {
+	name = "newsletter"
+}
+
+resource "sendgrid_marketing_list" "event_attendees" {
+	name            = "event-attendees"
+	delete_contacts = true
+}
+```
+Import
+A marketing list can be imported by ID, its contacts aren't deleted with it unless `delete_contacts` is set, e.g.
+```hcl
+$ terraform import sendgrid_marketing_list.newsletter listID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridMarketingList() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridMarketingListCreate,
+		ReadContext:   resourceSendgridMarketingListRead,
+		UpdateContext: resourceSendgridMarketingListUpdate,
+		DeleteContext: resourceSendgridMarketingListDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMarketingListImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the list.",
+				Required:    true,
+			},
+			"delete_contacts": {
+				Type: schema.TypeBool,
+				Description: "Whether destroying the list deletes its contacts from the account too, " +
+					"instead of only deleting the list. The contacts are deleted from every list they belong to.",
+				Optional: true,
+				Default:  false,
+			},
+			"contact_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of contacts in the list.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridMarketingListCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	list, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateMarketingList(d.Get("name").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed creating marketing list", err)
+	}
+
+	d.SetId(list.(*sendgrid.MarketingList).ID)
+
+	return resourceSendgridMarketingListRead(ctx, d, m)
+}
+
+func resourceSendgridMarketingListRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	list, requestErr := c.ReadMarketingList(d.Id())
+	if isNotFound(requestErr) {
+		// the list was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading marketing list", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", list.Name)
+	//nolint:errcheck
+	d.Set("contact_count", list.ContactCount)
+
+	return nil
+}
+
+func resourceSendgridMarketingListUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	// delete_contacts is only used on destroy.
+	if d.HasChange("name") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateMarketingList(d.Id(), d.Get("name").(string))
+		})
+		if err != nil {
+			return errorToDiags("failed updating marketing list", err)
+		}
+	}
+
+	return resourceSendgridMarketingListRead(ctx, d, m)
+}
+
+func resourceSendgridMarketingListDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	jobID, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteMarketingList(d.Id(), d.Get("delete_contacts").(bool))
+	})
+	if err != nil {
+		return errorToDiags("failed deleting marketing list", err)
+	}
+
+	if jobID.(string) == "" {
+		return nil
+	}
+
+	// Sendgrid doesn't expose the status of the job deleting the contacts,
+	// the list is deleted once the job is done.
+	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutDelete), func() *resource.RetryError {
+		_, requestErr := c.ReadMarketingList(d.Id())
+		if isNotFound(requestErr) {
+			return nil
+		}
+
+		if requestErr.Err != nil {
+			return resource.NonRetryableError(requestErr)
+		}
+
+		return resource.RetryableError(ErrMarketingListDeletionPending)
+	})
+	if err != nil {
+		return errorToDiags("failed waiting for the marketing list and its contacts to be deleted", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridMarketingListImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("delete_contacts", false)
+
+	return []*schema.ResourceData{d}, nil
+}
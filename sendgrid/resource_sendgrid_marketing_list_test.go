@@ -0,0 +1,71 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridMarketingListBasic(t *testing.T) {
+	name := "terraform-list-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridMarketingListDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridMarketingListConfigBasic(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_marketing_list.list", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_marketing_list.list", "delete_contacts", "false"),
+					resource.TestCheckResourceAttr("sendgrid_marketing_list.list", "contact_count", "0"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridMarketingListConfigBasic(name+"-renamed", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_marketing_list.list", "name", name+"-renamed"),
+					resource.TestCheckResourceAttr("sendgrid_marketing_list.list", "delete_contacts", "true"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_marketing_list.list",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_contacts"},
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridMarketingListDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_marketing_list" {
+			continue
+		}
+
+		_, requestErr := c.ReadMarketingList(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("marketing list %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridMarketingListConfigBasic(name string, deleteContacts bool) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_marketing_list" "list" {
+		name            = %q
+		delete_contacts = %t
+	}
+	`, name, deleteContacts)
+}
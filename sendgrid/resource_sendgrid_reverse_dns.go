@@ -0,0 +1,144 @@
+/*
+Provide a resource to set up the reverse DNS of a dedicated IP address, and retrieve the DNS record to create
+to validate it. Its dns attribute has the shape of the ones of sendgrid_domain_authentication and
+sendgrid_link_branding, so that the records of the three resources are created the same way.
+Example Usage
+```hcl
+resource "sendgrid_reverse_dns" "example" {
+	ip        = "192.0.2.1"
+	domain    = "example.org"
+	subdomain = "o1"
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(sendgrid_reverse_dns.example.dns)
+	zone_id = var.zone_id
+	type    = upper(sendgrid_reverse_dns.example.dns[count.index].type)
+	name    = sendgrid_reverse_dns.example.dns[count.index].host
+	records = [sendgrid_reverse_dns.example.dns[count.index].data]
+	ttl     = 300
+}
+```
+Import
+A reverse DNS can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_reverse_dns.example reverseDNSID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridReverseDNS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridReverseDNSCreate,
+		ReadContext:   resourceSendgridReverseDNSRead,
+		DeleteContext: resourceSendgridReverseDNSDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"ip": {
+				Type:        schema.TypeString,
+				Description: "The dedicated IP address to set up the reverse DNS of.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"domain": {
+				Type:        schema.TypeString,
+				Description: "The domain of the reverse DNS.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subdomain": {
+				Type:        schema.TypeString,
+				Description: "The subdomain of the reverse DNS, generated by Sendgrid if not set.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"rdns": {
+				Type:        schema.TypeString,
+				Description: "The reverse DNS of the IP address, e.g. o1.example.org.",
+				Computed:    true,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the DNS record of the reverse DNS was validated.",
+				Computed:    true,
+			},
+			"dns": dnsRecordsSchema("The DNS records to create to validate the reverse DNS (a single A record)"),
+		},
+	}
+}
+
+func resourceSendgridReverseDNSCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	reverseDNS, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateReverseDNS(sendgrid.ReverseDNSRequest{
+			IP:        d.Get("ip").(string),
+			Domain:    d.Get("domain").(string),
+			Subdomain: d.Get("subdomain").(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed creating reverse DNS", err)
+	}
+
+	d.SetId(strconv.FormatInt(reverseDNS.(*sendgrid.ReverseDNS).ID, 10))
+
+	return resourceSendgridReverseDNSRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	reverseDNS, requestErr := c.ReadReverseDNS(d.Id())
+	if isNotFound(requestErr) {
+		// the reverse DNS was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading reverse DNS", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("ip", reverseDNS.IP)
+	//nolint:errcheck
+	d.Set("domain", reverseDNS.Domain)
+	//nolint:errcheck
+	d.Set("subdomain", reverseDNS.Subdomain)
+	//nolint:errcheck
+	d.Set("rdns", reverseDNS.RDNS)
+	//nolint:errcheck
+	d.Set("valid", reverseDNS.Valid)
+	//nolint:errcheck
+	d.Set("dns", flattenDNSRecords([]sendgrid.DNSRecord{reverseDNS.ARecord}))
+
+	return nil
+}
+
+func resourceSendgridReverseDNSDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteReverseDNS(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting reverse DNS", err)
+	}
+
+	return nil
+}
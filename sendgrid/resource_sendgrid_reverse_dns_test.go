@@ -0,0 +1,69 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridReverseDNSBasic(t *testing.T) {
+	ip := os.Getenv("SENDGRID_TEST_IP")
+	if ip == "" {
+		t.Skip("SENDGRID_TEST_IP must be set to a dedicated IP address of the account")
+	}
+
+	domain := "terraform-" + acctest.RandString(10) + ".example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridReverseDNSDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridReverseDNSConfigBasic(ip, domain),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.reverse_dns", "ip", ip),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.reverse_dns", "dns.#", "1"),
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns.reverse_dns", "dns.0.type", "a"),
+				),
+			},
+			{
+				Config:   testAccCheckSendgridReverseDNSConfigBasic(ip, domain),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridReverseDNSDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_reverse_dns" {
+			continue
+		}
+
+		_, requestErr := c.ReadReverseDNS(rs.Primary.ID)
+		if requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("reverse DNS %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridReverseDNSConfigBasic(ip, domain string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_reverse_dns" "reverse_dns" {
+		ip        = %q
+		domain    = %q
+		subdomain = "o1"
+	}
+	`, ip, domain)
+}
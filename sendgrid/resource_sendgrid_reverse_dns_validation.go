@@ -0,0 +1,170 @@
+/*
+Provide a resource to validate the reverse DNS of a dedicated IP address, once its A record is created.
+As the A record can take a while to propagate, the validation is retried until the `validation_timeout`,
+after which the A record expected is reported, with what Sendgrid found instead.
+The validation is checked again on refresh: a reverse DNS which isn't valid anymore is validated again
+by the next apply. Destroying the resource doesn't invalidate the reverse DNS, it is only removed from the state.
+Example Usage
+```hcl
+resource "sendgrid_reverse_dns" "example" {
+	ip        = "192.0.2.1"
+	domain    = "example.org"
+	subdomain = "o1"
+}
+
+resource "aws_route53_record" "sendgrid" {
+	count   = length(sendgrid_reverse_dns.example.dns)
+	zone_id = var.zone_id
+	type    = upper(sendgrid_reverse_dns.example.dns[count.index].type)
+	name    = sendgrid_reverse_dns.example.dns[count.index].host
+	records = [sendgrid_reverse_dns.example.dns[count.index].data]
+	ttl     = 300
+}
+
+resource "sendgrid_reverse_dns_validation" "example" {
+	reverse_dns_id     = sendgrid_reverse_dns.example.id
+	validation_timeout = "15m"
+
+	depends_on = [aws_route53_record.sendgrid]
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// defaultReverseDNSValidationTimeout is the time given to the A record of a reverse DNS to propagate.
+const defaultReverseDNSValidationTimeout = 10 * time.Minute
+
+func resourceSendgridReverseDNSValidation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridReverseDNSValidationCreate,
+		ReadContext:   resourceSendgridReverseDNSValidationRead,
+		DeleteContext: resourceSendgridReverseDNSValidationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"reverse_dns_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the reverse DNS to validate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"validation_timeout": {
+				Type:         schema.TypeString,
+				Description:  "How long to retry the validation while the A record propagates, e.g. 15m.",
+				Optional:     true,
+				ForceNew:     true,
+				Default:      defaultReverseDNSValidationTimeout.String(),
+				ValidateFunc: validateDuration,
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Description: "Whether the A record of the reverse DNS was validated.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// reverseDNSValidationFailure returns the A record Sendgrid expects, and the reason why it failed the validation,
+// which tells what Sendgrid found instead.
+func reverseDNSValidationFailure(reverseDNS *sendgrid.ReverseDNS, validation *sendgrid.ReverseDNSValidation) string {
+	reason := validation.ValidationResults.ARecord.Reason
+	if reason == "" {
+		reason = "no reason given"
+	}
+
+	return "A record " + reverseDNS.ARecord.Host + " expected to point to " + reverseDNS.ARecord.Data + ": " + reason
+}
+
+func resourceSendgridReverseDNSValidationCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	id := d.Get("reverse_dns_id").(string)
+	timeout, _ := time.ParseDuration(d.Get("validation_timeout").(string))
+
+	reverseDNS, requestErr := c.ReadReverseDNS(id)
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading reverse DNS", requestErr)}
+	}
+
+	err := resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		validation, err := c.Retry(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return c.ValidateReverseDNS(id)
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if v := validation.(*sendgrid.ReverseDNSValidation); !v.Valid {
+			return resource.RetryableError(reverseDNSNotValidated(id, reverseDNSValidationFailure(reverseDNS, v)))
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errorToDiags("failed validating reverse DNS", err)
+	}
+
+	d.SetId(id)
+
+	return resourceSendgridReverseDNSValidationRead(ctx, d, m)
+}
+
+func resourceSendgridReverseDNSValidationRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	// the A record is validated again, the valid attribute of the reverse DNS is only updated by a validation.
+	validation, requestErr := c.ValidateReverseDNS(d.Id())
+	if isNotFound(requestErr) {
+		// the reverse DNS was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed validating reverse DNS", requestErr)}
+	}
+
+	if !validation.Valid {
+		// the reverse DNS isn't valid anymore, it has to be validated again.
+		d.SetId("")
+
+		return nil
+	}
+
+	//nolint:errcheck
+	d.Set("reverse_dns_id", d.Id())
+	//nolint:errcheck
+	d.Set("valid", validation.Valid)
+
+	return nil
+}
+
+func resourceSendgridReverseDNSValidationDelete(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// a validation can't be undone, it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
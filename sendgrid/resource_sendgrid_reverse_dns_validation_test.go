@@ -0,0 +1,77 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridReverseDNSValidationBasic(t *testing.T) {
+	id := os.Getenv("SENDGRID_TEST_REVERSE_DNS_ID")
+	if id == "" {
+		t.Skip("SENDGRID_TEST_REVERSE_DNS_ID must be set to a reverse DNS of the account with its A record")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_reverse_dns_validation" "validation" {
+					reverse_dns_id     = %q
+					validation_timeout = "1m"
+				}
+				`, id),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_reverse_dns_validation.validation", "valid", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestSendgridReverseDNSValidationNotPropagated(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/whitelabel/ips/1":
+			fmt.Fprint(w, `{"id": 1, "ip": "192.0.2.1", "rdns": "o1.example.org", "valid": false, `+
+				`"a_record": {"valid": false, "type": "a", "host": "o1.example.org", "data": "192.0.2.1"}}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/whitelabel/ips/1/validate":
+			fmt.Fprint(w, `{"id": 1, "valid": false, "validation_results": {"a_record": {"valid": false, `+
+				`"reason": "Expected your A record to point to 192.0.2.1 but found 198.51.100.1."}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_reverse_dns_validation"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"reverse_dns_id":     "1",
+		"validation_timeout": "1s",
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	if !diags.HasError() || d.Id() != "" {
+		t.Fatalf("expected the validation to fail, got: %v", diags)
+	}
+
+	// the failure tells which A record is expected, and what was found instead.
+	detail := diags[0].Summary + diags[0].Detail
+	if !strings.Contains(detail, "o1.example.org expected to point to 192.0.2.1") ||
+		!strings.Contains(detail, "found 198.51.100.1") {
+		t.Fatalf("unexpected error: %s", detail)
+	}
+}
@@ -0,0 +1,251 @@
+/*
+Provide a resource to manage a segment of marketing contacts: the contacts matching its SGQL query,
+among all the contacts or the ones of its parent list.
+The query is checked at plan: its strings and parentheses must be closed, and the fields it references must be
+reserved or custom fields, unless `validate_fields` is false. The errors point at the character of the problem.
+When `dry_run` is true, the contacts matching the query are also counted at plan, without creating the segment,
+in `estimated_contacts_count`: a query which matches no contact shows before it's applied. They're counted when
+the query changes or `dry_run` is turned on, and when the query changes without a dry run,
+`estimated_contacts_count` is unknown rather than the count of the previous query.
+Example Usage
+```hcl
+resource "sendgrid_segment" "example_org" {
+	name      = "example.org"
+	query_dsl = "email LIKE '%@example.org' AND (first_name IS NOT NULL)"
+	dry_run   = true
+}
+```
+Import
+A segment can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_segment.example_org segmentID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSegment() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSegmentCreate,
+		ReadContext:   resourceSendgridSegmentRead,
+		UpdateContext: resourceSendgridSegmentUpdate,
+		DeleteContext: resourceSendgridSegmentDelete,
+		CustomizeDiff: resourceSendgridSegmentCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridSegmentImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the segment.",
+				Required:    true,
+			},
+			"query_dsl": {
+				Type:         schema.TypeString,
+				Description:  "The SGQL query the contacts of the segment match, e.g. email LIKE '%@example.org'.",
+				Required:     true,
+				ValidateFunc: validateSegmentQuery,
+			},
+			"parent_list_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the list the contacts of the segment are taken from, all the contacts if not set.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"validate_fields": {
+				Type:        schema.TypeBool,
+				Description: "Check at plan that the fields the query references are defined.",
+				Optional:    true,
+				Default:     true,
+			},
+			"dry_run": {
+				Type:        schema.TypeBool,
+				Description: "Count the contacts matching the query at plan, in estimated_contacts_count.",
+				Optional:    true,
+				Default:     false,
+			},
+			"estimated_contacts_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of contacts matching the query, counted at plan with dry_run.",
+				Computed:    true,
+			},
+			"contacts_count": {
+				Type:        schema.TypeInt,
+				Description: "The number of contacts in the segment, as last computed by Sendgrid.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func validateSegmentQuery(v interface{}, k string) ([]string, []error) {
+	if err := sendgrid.ValidateSegmentQuery(v.(string)); err != nil {
+		return nil, []error{err}
+	}
+
+	return nil, nil
+}
+
+// segmentDryRunQuery returns the query matching the contacts of the segment, among the ones of its parent list.
+// The ID of the parent list is quoted in the query, so it can't contain quotes nor backslashes.
+func segmentDryRunQuery(query, parentListID string) (string, error) {
+	if parentListID == "" {
+		return query, nil
+	}
+
+	if strings.ContainsAny(parentListID, `'\`) {
+		return "", invalidParentListID(parentListID)
+	}
+
+	return "(" + query + ") AND CONTAINS(list_ids, '" + parentListID + "')", nil
+}
+
+// resourceSendgridSegmentCustomizeDiff checks the fields the query references when it changes, and counts
+// the contacts it matches when it changes or dry_run is turned on, instead of shipping a segment which fails
+// or matches no contact. The count of a previous query is never kept for a new one.
+func resourceSendgridSegmentCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, m interface{}) error {
+	queryChanged := d.HasChange("query_dsl")
+	dryRun := d.Get("dry_run").(bool)
+
+	if !queryChanged && !(dryRun && d.HasChange("dry_run")) {
+		return nil
+	}
+
+	// the values known after apply can't be checked yet.
+	if !d.NewValueKnown("query_dsl") || !d.NewValueKnown("parent_list_id") {
+		return d.SetNewComputed("estimated_contacts_count")
+	}
+
+	c := m.(*sendgrid.Client).WithContext(ctx)
+	query := d.Get("query_dsl").(string)
+
+	if queryChanged && d.Get("validate_fields").(bool) {
+		// the check is best-effort, Sendgrid still refuses the query at apply.
+		// The definitions are listed once for all the segments, until a custom field is written.
+		custom, customErr := c.Cached().ListFieldDefinitions()
+		reserved, reservedErr := c.Cached().ListReservedFieldDefinitions()
+
+		if customErr.Err == nil && reservedErr.Err == nil {
+			if err := sendgrid.ValidateSegmentQueryFields(query, append(reserved, custom...)); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !dryRun {
+		return d.SetNewComputed("estimated_contacts_count")
+	}
+
+	dryRunQuery, err := segmentDryRunQuery(query, d.Get("parent_list_id").(string))
+	if err != nil {
+		return err
+	}
+
+	count, requestErr := c.CountContacts(dryRunQuery)
+	if requestErr.Err != nil {
+		return segmentDryRunFailed(requestErr)
+	}
+
+	return d.SetNew("estimated_contacts_count", count)
+}
+
+func segmentFromResourceData(d *schema.ResourceData) sendgrid.Segment {
+	return sendgrid.Segment{
+		Name:         d.Get("name").(string),
+		QueryDSL:     d.Get("query_dsl").(string),
+		ParentListID: d.Get("parent_list_id").(string),
+	}
+}
+
+func resourceSendgridSegmentCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	segment, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSegment(segmentFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed creating segment", err)
+	}
+
+	d.SetId(segment.(*sendgrid.Segment).ID)
+
+	return resourceSendgridSegmentRead(ctx, d, m)
+}
+
+func resourceSendgridSegmentRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	segment, requestErr := c.ReadSegment(d.Id())
+	if isNotFound(requestErr) {
+		// the segment was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading segment", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", segment.Name)
+	//nolint:errcheck
+	d.Set("query_dsl", segment.QueryDSL)
+	//nolint:errcheck
+	d.Set("parent_list_id", segment.ParentListID)
+	//nolint:errcheck
+	d.Set("contacts_count", segment.ContactsCount)
+
+	return nil
+}
+
+func resourceSendgridSegmentUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	// validate_fields and dry_run are only used at plan.
+	if d.HasChanges("name", "query_dsl") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateSegment(d.Id(), segmentFromResourceData(d))
+		})
+		if err != nil {
+			return errorToDiags("failed updating segment", err)
+		}
+	}
+
+	return resourceSendgridSegmentRead(ctx, d, m)
+}
+
+func resourceSendgridSegmentDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSegment(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting segment", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSegmentImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("validate_fields", true)
+	//nolint:errcheck
+	d.Set("dry_run", false)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,175 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridSegmentBasic(t *testing.T) {
+	name := "terraform-segment-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSegmentConfig(name, "email LIKE '%@example.org'"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_segment.segment", "name", name),
+					resource.TestCheckResourceAttrSet("sendgrid_segment.segment", "estimated_contacts_count"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSegmentConfig(name, "email LIKE '%@example.com'"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_segment.segment", "query_dsl", "email LIKE '%@example.com'"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_segment.segment",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"dry_run", "estimated_contacts_count"},
+			},
+		},
+	})
+}
+
+func TestAccSendgridSegmentUnknownField(t *testing.T) {
+	name := "terraform-segment-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckSendgridSegmentConfig(name, "terraform_undefined_field = 'value'"),
+				ExpectError: regexp.MustCompile(`unknown field "terraform_undefined_field" at character 1`),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSegmentConfig(name, query string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_segment" "segment" {
+		name      = %q
+		query_dsl = %q
+		dry_run   = true
+	}
+	`, name, query)
+}
+
+func TestSendgridSegmentQueryValidation(t *testing.T) {
+	validate := provider.Provider().ResourcesMap["sendgrid_segment"].Schema["query_dsl"].ValidateFunc
+
+	for query, valid := range map[string]bool{
+		"email LIKE '%@example.org'":                         true,
+		"(email LIKE '%@example.org') AND age > 18":          true,
+		"CONTAINS(list_ids, 'id') AND (first_name = 'John'":  false,
+		"email LIKE '%@example.org' AND first_name = 'John)": false,
+	} {
+		_, errs := validate(query, "query_dsl")
+		if valid != (len(errs) == 0) {
+			t.Errorf("%s: expected valid %t, got errors: %v", query, valid, errs)
+		}
+	}
+}
+
+// TestSendgridSegmentEstimatedContactsCount checks that the count of a previous query isn't kept for a new one,
+// and that the contacts are counted when dry_run is turned on.
+func TestSendgridSegmentEstimatedContactsCount(t *testing.T) {
+	var searches int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/marketing/contacts/search" {
+			w.WriteHeader(http.StatusNotFound)
+
+			return
+		}
+
+		atomic.AddInt32(&searches, 1)
+		fmt.Fprint(w, `{"result": [], "contact_count": 7}`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	r := provider.Provider().ResourcesMap["sendgrid_segment"]
+
+	state := func(parentListID string) *terraform.InstanceState {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"name":            "segment",
+			"query_dsl":       "email LIKE '%@example.org'",
+			"parent_list_id":  parentListID,
+			"validate_fields": false,
+		})
+		d.SetId("id")
+		//nolint:errcheck
+		d.Set("estimated_contacts_count", 5)
+
+		return d.State()
+	}
+
+	config := func(query string, dryRun bool, parentListID string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":            "segment",
+			"query_dsl":       query,
+			"parent_list_id":  parentListID,
+			"validate_fields": false,
+			"dry_run":         dryRun,
+		})
+	}
+
+	diff, err := r.Diff(context.Background(), state(""), config("email LIKE '%@example.com'", false, ""), c)
+	if err != nil {
+		t.Fatalf("failed planning: %v", err)
+	}
+
+	if count := diff.Attributes["estimated_contacts_count"]; count == nil || !count.NewComputed || searches != 0 {
+		t.Errorf("expected the count unknown without a dry run, got %v after %d searches", count, searches)
+	}
+
+	diff, err = r.Diff(context.Background(), state(""), config("email LIKE '%@example.org'", true, ""), c)
+	if err != nil {
+		t.Fatalf("failed planning: %v", err)
+	}
+
+	if count := diff.Attributes["estimated_contacts_count"]; count == nil || count.New != "7" || searches != 1 {
+		t.Errorf("expected the contacts counted when dry_run is turned on, got %v after %d searches", count, searches)
+	}
+
+	_, err = r.Diff(context.Background(), state("id'"), config("email LIKE '%@example.com'", true, "id'"), c)
+	if !errors.Is(err, provider.ErrInvalidParentListID) || searches != 1 {
+		t.Errorf("expected the parent list ID refused before searching, got %v after %d searches", err, searches)
+	}
+}
+
+func testAccCheckSendgridSegmentDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_segment" {
+			continue
+		}
+
+		if _, requestErr := c.ReadSegment(rs.Primary.ID); requestErr.Err == nil {
+			return fmt.Errorf("segment %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,289 @@
+/*
+Provide a resource to manage a legacy marketing sender identity, as referenced by the single sends.
+Sendgrid sends a verification email to the from address on creation.
+Example Usage
+```hcl
+resource "sendgrid_sender_identity" "example" {
+	nickname = "Newsletter"
+	address  = "1 Example Street"
+	city     = "Paris"
+	country  = "France"
+
+	from {
+		email = "newsletter@example.org"
+		name  = "Example Newsletter"
+	}
+
+	reply_to {
+		email = "support@example.org"
+	}
+}
+
+resource "sendgrid_single_send" "example" {
+	name = "newsletter"
+
+	email_config {
+		subject   = "Our news"
+		sender_id = sendgrid_sender_identity.example.id
+	}
+}
+```
+Import
+A sender identity can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_sender_identity.example senderIdentityID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSenderIdentity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSenderIdentityCreate,
+		ReadContext:   resourceSendgridSenderIdentityRead,
+		UpdateContext: resourceSendgridSenderIdentityUpdate,
+		DeleteContext: resourceSendgridSenderIdentityDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nickname": {
+				Type:        schema.TypeString,
+				Description: "A nickname for the sender identity, not used for sending.",
+				Required:    true,
+			},
+			"from": {
+				Type:        schema.TypeList,
+				Description: "The address the emails are sent from.",
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeString,
+							Description: "The email address the emails are sent from.",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name the emails are sent from.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"reply_to": {
+				Type:        schema.TypeList,
+				Description: "The address the replies are sent to, the from address if not set.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"email": {
+							Type:        schema.TypeString,
+							Description: "The email address the replies are sent to.",
+							Required:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The name the replies are sent to.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The physical address of the sender identity.",
+				Required:    true,
+			},
+			"address_2": {
+				Type:        schema.TypeString,
+				Description: "The second line of the physical address of the sender identity.",
+				Optional:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the sender identity.",
+				Required:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The state of the sender identity.",
+				Optional:    true,
+			},
+			"zip": {
+				Type:        schema.TypeString,
+				Description: "The zip code of the sender identity.",
+				Optional:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the sender identity.",
+				Required:    true,
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Description: "Whether the sender identity was verified.",
+				Computed:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Whether the sender identity is locked, because it's used by a single send.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func expandSenderIdentityAddress(v interface{}) *sendgrid.SenderIdentityAddress {
+	addresses := v.([]interface{})
+	if len(addresses) == 0 || addresses[0] == nil {
+		return nil
+	}
+
+	address := addresses[0].(map[string]interface{})
+
+	return &sendgrid.SenderIdentityAddress{
+		Email: address["email"].(string),
+		Name:  address["name"].(string),
+	}
+}
+
+func flattenSenderIdentityAddress(address *sendgrid.SenderIdentityAddress) []interface{} {
+	if address == nil {
+		return nil
+	}
+
+	return []interface{}{map[string]interface{}{
+		"email": address.Email,
+		"name":  address.Name,
+	}}
+}
+
+func senderIdentityFromResourceData(d *schema.ResourceData) sendgrid.SenderIdentity {
+	sender := sendgrid.SenderIdentity{
+		Nickname: d.Get("nickname").(string),
+		ReplyTo:  expandSenderIdentityAddress(d.Get("reply_to")),
+		Address:  d.Get("address").(string),
+		Address2: d.Get("address_2").(string),
+		City:     d.Get("city").(string),
+		State:    d.Get("state").(string),
+		Zip:      d.Get("zip").(string),
+		Country:  d.Get("country").(string),
+	}
+
+	if from := expandSenderIdentityAddress(d.Get("from")); from != nil {
+		sender.From = *from
+	}
+
+	return sender
+}
+
+func resourceSendgridSenderIdentityCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	sender, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSenderIdentity(senderIdentityFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed creating sender identity", err)
+	}
+
+	d.SetId(strconv.FormatInt(sender.(*sendgrid.SenderIdentity).ID, 10))
+
+	return resourceSendgridSenderIdentityRead(ctx, d, m)
+}
+
+func resourceSendgridSenderIdentityRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	sender, requestErr := c.ReadSenderIdentity(d.Id())
+	if isNotFound(requestErr) {
+		// the sender identity was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading sender identity", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("nickname", sender.Nickname)
+	//nolint:errcheck
+	d.Set("from", flattenSenderIdentityAddress(&sender.From))
+	//nolint:errcheck
+	d.Set("reply_to", flattenSenderIdentityAddress(sender.ReplyTo))
+	//nolint:errcheck
+	d.Set("address", sender.Address)
+	//nolint:errcheck
+	d.Set("address_2", sender.Address2)
+	//nolint:errcheck
+	d.Set("city", sender.City)
+	//nolint:errcheck
+	d.Set("state", sender.State)
+	//nolint:errcheck
+	d.Set("zip", sender.Zip)
+	//nolint:errcheck
+	d.Set("country", sender.Country)
+	//nolint:errcheck
+	d.Set("verified", sender.Verified != nil && sender.Verified.Status)
+	//nolint:errcheck
+	d.Set("locked", sender.Locked)
+
+	return nil
+}
+
+func resourceSendgridSenderIdentityUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSenderIdentity(d.Id(), senderIdentityFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed updating sender identity", err)
+	}
+
+	return resourceSendgridSenderIdentityRead(ctx, d, m)
+}
+
+func resourceSendgridSenderIdentityDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSenderIdentity(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting sender identity", err)
+	}
+
+	return nil
+}
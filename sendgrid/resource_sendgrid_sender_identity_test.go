@@ -0,0 +1,66 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSenderIdentityBasic(t *testing.T) {
+	nickname := "terraform-sender-" + acctest.RandString(10)
+	email := nickname + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSenderIdentityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_sender_identity" "sender" {
+					nickname = %q
+					address  = "1 Example Street"
+					city     = "Paris"
+					country  = "France"
+
+					from {
+						email = %q
+						name  = "Terraform"
+					}
+				}
+				`, nickname, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.sender", "from.0.email", email),
+					resource.TestCheckResourceAttr("sendgrid_sender_identity.sender", "verified", "false"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_sender_identity.sender",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSenderIdentityDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_sender_identity" {
+			continue
+		}
+
+		_, requestErr := c.ReadSenderIdentity(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("sender identity %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
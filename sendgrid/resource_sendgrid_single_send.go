@@ -0,0 +1,467 @@
+/*
+Provide a resource to manage a single send, a marketing campaign sent once.
+The single send stays a draft unless `send_at` is set, in which case it is scheduled.
+`send_at` is an RFC3339 date with an explicit offset, e.g. `Z` or `+02:00`, dates without an offset are rejected
+rather than guessing their timezone, or `now` to send the single send right away. Sendgrid stores the date in UTC,
+the date configured is kept as long as it's the same instant.
+Once sent, a single send can't be modified anymore.
+Example Usage
+```hcl
+resource "sendgrid_single_send" "newsletter" {
+	name       = "newsletter"
+	categories = ["newsletter"]
+	send_at    = "2030-01-01T10:00:00Z"
+
+	send_to {
+		list_ids = ["a2a0c4f2-9b04-4a5b-a3d1-2d3b5c8d9e4f"]
+	}
+
+	email_config {
+		subject              = "Our newsletter"
+		html_content         = "<p>Hello {{first_name}}</p>"
+		sender_id            = 123456
+		suppression_group_id = 12345
+	}
+}
+```
+Import
+A single send can be imported, e.g.
+```hcl
+$ terraform import sendgrid_single_send.newsletter singleSendID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSingleSend() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSingleSendCreate,
+		ReadContext:   resourceSendgridSingleSendRead,
+		UpdateContext: resourceSendgridSingleSendUpdate,
+		DeleteContext: resourceSendgridSingleSendDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the single send.",
+				Required:    true,
+			},
+			"categories": {
+				Type:        schema.TypeSet,
+				Description: "The categories associated to the single send.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"send_at": {
+				Type: schema.TypeString,
+				Description: "The date (RFC3339, with an offset) the single send is scheduled at, or now " +
+					"to send it right away, the single send is kept as a draft if not set.",
+				Optional:         true,
+				ValidateFunc:     validateSendAt,
+				DiffSuppressFunc: suppressEquivalentSendAt,
+			},
+			"send_to": {
+				Type:        schema.TypeList,
+				Description: "The recipients of the single send.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"list_ids": {
+							Type:        schema.TypeSet,
+							Description: "The IDs of the contact lists the single send is sent to.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"segment_ids": {
+							Type:        schema.TypeSet,
+							Description: "The IDs of the segments the single send is sent to.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"all": {
+							Type:        schema.TypeBool,
+							Description: "Send the single send to all the contacts.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"email_config": {
+				Type:        schema.TypeList,
+				Description: "The content of the email of the single send.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subject": {
+							Type:        schema.TypeString,
+							Description: "The subject of the email.",
+							Optional:    true,
+						},
+						"html_content": {
+							Type:        schema.TypeString,
+							Description: "The HTML content of the email.",
+							Optional:    true,
+						},
+						"plain_content": {
+							Type:        schema.TypeString,
+							Description: "The plain text content of the email.",
+							Optional:    true,
+							Computed:    true,
+						},
+						"generate_plain_content": {
+							Type:        schema.TypeBool,
+							Description: "Generate the plain text content from the HTML content.",
+							Optional:    true,
+							Default:     true,
+						},
+						"editor": {
+							Type:         schema.TypeString,
+							Description:  "The editor used to build the email: code or design.",
+							Optional:     true,
+							Default:      "code",
+							ValidateFunc: validation.StringInSlice([]string{"code", "design"}, false),
+						},
+						"suppression_group_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the unsubscribe group of the email.",
+							Optional:    true,
+						},
+						"custom_unsubscribe_url": {
+							Type:        schema.TypeString,
+							Description: "The URL of a custom unsubscribe page, instead of an unsubscribe group.",
+							Optional:    true,
+						},
+						"sender_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the verified sender of the email.",
+							Optional:    true,
+						},
+						"ip_pool": {
+							Type:        schema.TypeString,
+							Description: "The name of the IP pool the email is sent from.",
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the single send: draft, scheduled or triggered.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func singleSendFromResourceData(d *schema.ResourceData) sendgrid.SingleSend {
+	s := sendgrid.SingleSend{
+		ID:         d.Id(),
+		Name:       d.Get("name").(string),
+		Categories: stringSetToSlice(d.Get("categories").(*schema.Set)),
+	}
+
+	if v, ok := d.GetOk("send_to"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		sendTo := v.([]interface{})[0].(map[string]interface{})
+		s.SendTo = &sendgrid.SingleSendTo{
+			ListIDs:    stringSetToSlice(sendTo["list_ids"].(*schema.Set)),
+			SegmentIDs: stringSetToSlice(sendTo["segment_ids"].(*schema.Set)),
+			All:        sendTo["all"].(bool),
+		}
+	}
+
+	if v, ok := d.GetOk("email_config"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config := v.([]interface{})[0].(map[string]interface{})
+		s.EmailConfig = &sendgrid.SingleSendEmailConfig{
+			Subject:              config["subject"].(string),
+			HTMLContent:          config["html_content"].(string),
+			PlainContent:         config["plain_content"].(string),
+			GeneratePlainContent: config["generate_plain_content"].(bool),
+			Editor:               config["editor"].(string),
+			SuppressionGroupID:   config["suppression_group_id"].(int),
+			CustomUnsubscribeURL: config["custom_unsubscribe_url"].(string),
+			SenderID:             config["sender_id"].(int),
+			IPPool:               config["ip_pool"].(string),
+		}
+	}
+
+	return s
+}
+
+func stringSetToSlice(set *schema.Set) []string {
+	values := make([]string, 0, set.Len())
+	for _, v := range set.List() {
+		values = append(values, v.(string))
+	}
+
+	return values
+}
+
+func flattenSingleSendTo(sendTo *sendgrid.SingleSendTo) []interface{} {
+	if sendTo == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"list_ids":    sendTo.ListIDs,
+			"segment_ids": sendTo.SegmentIDs,
+			"all":         sendTo.All,
+		},
+	}
+}
+
+func flattenSingleSendEmailConfig(config *sendgrid.SingleSendEmailConfig) []interface{} {
+	if config == nil {
+		return nil
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"subject":                config.Subject,
+			"html_content":           config.HTMLContent,
+			"plain_content":          config.PlainContent,
+			"generate_plain_content": config.GeneratePlainContent,
+			"editor":                 config.Editor,
+			"suppression_group_id":   config.SuppressionGroupID,
+			"custom_unsubscribe_url": config.CustomUnsubscribeURL,
+			"sender_id":              config.SenderID,
+			"ip_pool":                config.IPPool,
+		},
+	}
+}
+
+// singleSendNow is the send_at sending a single send right away.
+const singleSendNow = "now"
+
+// naiveRFC3339 is the layout of the RFC3339 dates without an offset, which are rejected.
+const naiveRFC3339 = "2006-01-02T15:04:05"
+
+// validateSendAt checks that send_at is "now" or an RFC3339 date with an explicit offset:
+// a date without an offset would be sent at the wrong time if its timezone was guessed.
+func validateSendAt(v interface{}, k string) ([]string, []error) {
+	sendAt := v.(string)
+	if sendAt == singleSendNow {
+		return nil, nil
+	}
+
+	if _, err := time.Parse(time.RFC3339, sendAt); err != nil {
+		if _, naiveErr := time.Parse(naiveRFC3339, sendAt); naiveErr == nil {
+			return nil, []error{invalidSendAt(k, sendAt, "it has no offset")}
+		}
+
+		return nil, []error{invalidSendAt(k, sendAt, err.Error())}
+	}
+
+	return nil, nil
+}
+
+// sameSendAt tells whether two dates of send_at schedule the single send at the same time,
+// e.g. the date configured with an offset and the one stored in UTC by Sendgrid.
+func sameSendAt(configured, stored string) bool {
+	if configured == stored {
+		return true
+	}
+
+	configuredTime, err := time.Parse(time.RFC3339, configured)
+	if err != nil {
+		return false
+	}
+
+	storedTime, err := time.Parse(time.RFC3339, stored)
+	if err != nil {
+		return false
+	}
+
+	return configuredTime.Equal(storedTime)
+}
+
+// sentNow tells whether a single send configured to be sent "now" was sent, at the date Sendgrid returns.
+func sentNow(configured, stored, status string) bool {
+	return configured == singleSendNow && stored != "" && status == sendgrid.SingleSendStatusTriggered
+}
+
+// suppressEquivalentSendAt suppresses the diff between two dates of the same instant, and between "now"
+// and a date once the single send was sent: it can't be sent nor scheduled again. The diff between "now"
+// and the date of a single send which wasn't sent yet is kept, so that it's sent or scheduled as configured.
+func suppressEquivalentSendAt(_, old, new string, d *schema.ResourceData) bool {
+	if sameSendAt(new, old) {
+		return true
+	}
+
+	if d == nil {
+		return false
+	}
+
+	status := d.Get("status").(string)
+
+	return sentNow(new, old, status) || sentNow(old, new, status)
+}
+
+// sendAtToUTC converts the date of send_at to UTC, as stored by Sendgrid, so that the offset can't be misread.
+func sendAtToUTC(sendAt string) string {
+	t, err := time.Parse(time.RFC3339, sendAt)
+	if err != nil {
+		return sendAt
+	}
+
+	return t.UTC().Format(time.RFC3339)
+}
+
+func scheduleSingleSend(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	sendAt := d.Get("send_at").(string)
+	if sendAt == "" {
+		return nil
+	}
+
+	sendAt = sendAtToUTC(sendAt)
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.ScheduleSingleSend(d.Id(), sendAt)
+	})
+	if err != nil {
+		return errorToDiags("failed scheduling single send", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSingleSendCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	s := singleSendFromResourceData(d)
+
+	singleSendStruct, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSingleSend(s)
+	})
+	if err != nil {
+		return errorToDiags("failed creating single send", err)
+	}
+
+	singleSend := singleSendStruct.(*sendgrid.SingleSend)
+	d.SetId(singleSend.ID)
+
+	if diags := scheduleSingleSend(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	return resourceSendgridSingleSendRead(ctx, d, m)
+}
+
+func resourceSendgridSingleSendRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	s, requestErr := c.ReadSingleSend(d.Id())
+	if isNotFound(requestErr) {
+		// the single send was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading single send", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", s.Name)
+	//nolint:errcheck
+	d.Set("categories", s.Categories)
+	// the date configured is kept when Sendgrid stores the same instant in UTC, and "now" once it was sent.
+	if configured := d.Get("send_at").(string); !sameSendAt(configured, s.SendAt) &&
+		!sentNow(configured, s.SendAt, s.Status) {
+		//nolint:errcheck
+		d.Set("send_at", s.SendAt)
+	}
+	//nolint:errcheck
+	d.Set("send_to", flattenSingleSendTo(s.SendTo))
+	//nolint:errcheck
+	d.Set("email_config", flattenSingleSendEmailConfig(s.EmailConfig))
+	//nolint:errcheck
+	d.Set("status", s.Status)
+
+	return nil
+}
+
+func resourceSendgridSingleSendUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	current, requestErr := c.ReadSingleSend(d.Id())
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading single send", requestErr)}
+	}
+
+	if current.Status == sendgrid.SingleSendStatusTriggered {
+		return diag.FromErr(ErrSingleSendAlreadySent)
+	}
+
+	// A scheduled single send has to go back to draft before being edited.
+	if current.Status == sendgrid.SingleSendStatusScheduled {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UnscheduleSingleSend(d.Id())
+		})
+		if err != nil {
+			return errorToDiags("failed unscheduling single send", err)
+		}
+	}
+
+	if d.HasChanges("name", "categories", "send_to", "email_config") {
+		s := singleSendFromResourceData(d)
+
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateSingleSend(s)
+		})
+		if err != nil {
+			return errorToDiags("failed updating single send", err)
+		}
+	}
+
+	if diags := scheduleSingleSend(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+		return diags
+	}
+
+	return resourceSendgridSingleSendRead(ctx, d, m)
+}
+
+func resourceSendgridSingleSendDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSingleSend(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting single send", err)
+	}
+
+	return nil
+}
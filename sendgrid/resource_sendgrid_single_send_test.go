@@ -0,0 +1,138 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridSingleSendBasic(t *testing.T) {
+	name := "terraform-single-send-" + acctest.RandString(10)
+	newName := "terraform-single-send-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSingleSendDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSingleSendConfigBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridSingleSendExists("sendgrid_single_send.single_send"),
+					resource.TestCheckResourceAttr("sendgrid_single_send.single_send", "name", name),
+					resource.TestCheckResourceAttr("sendgrid_single_send.single_send", "status", "draft"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSingleSendConfigBasic(newName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_single_send.single_send", "name", newName),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_single_send.single_send",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSendgridSingleSendSendAtValidation(t *testing.T) {
+	validate := provider.Provider().ResourcesMap["sendgrid_single_send"].Schema["send_at"].ValidateFunc
+
+	for sendAt, valid := range map[string]bool{
+		"now":                       true,
+		"2030-01-01T10:00:00Z":      true,
+		"2030-01-01T12:00:00+02:00": true,
+		"2030-01-01T10:00:00":       false,
+		"2030-01-01 10:00:00Z":      false,
+		"2030-01-01":                false,
+		"tomorrow":                  false,
+	} {
+		_, errs := validate(sendAt, "send_at")
+		if valid != (len(errs) == 0) {
+			t.Errorf("%s: expected valid %t, got errors: %v", sendAt, valid, errs)
+		}
+	}
+}
+
+func TestSendgridSingleSendSendAtDiffSuppression(t *testing.T) {
+	r := provider.Provider().ResourcesMap["sendgrid_single_send"]
+
+	for sendAts, suppressed := range map[[3]string]bool{
+		{"2030-01-01T10:00:00Z", "2030-01-01T12:00:00+02:00", "scheduled"}: true,
+		{"2030-01-01T10:00:00Z", "2030-01-01T05:00:00-05:00", "scheduled"}: true,
+		{"2030-01-01T10:00:00Z", "2030-01-01T10:00:00+02:00", "scheduled"}: false,
+		{"2030-01-01T10:00:00Z", "2030-01-01T11:00:00Z", "scheduled"}:      false,
+		// a single send scheduled now is stored with the date it was sent at.
+		{"2030-01-01T10:00:00Z", "now", "triggered"}: true,
+		{"now", "2030-01-01T10:00:00Z", "triggered"}: true,
+		// a single send which wasn't sent yet is sent or scheduled as configured.
+		{"2030-01-01T10:00:00Z", "now", "scheduled"}: false,
+		{"now", "2030-01-01T10:00:00Z", "scheduled"}: false,
+		{"", "now", "draft"}:                         false,
+		{"", "now", "triggered"}:                     false,
+		{"2030-01-01T10:00:00Z", "", "scheduled"}:    false,
+	} {
+		d := r.TestResourceData()
+		//nolint:errcheck
+		d.Set("status", sendAts[2])
+
+		if r.Schema["send_at"].DiffSuppressFunc("send_at", sendAts[0], sendAts[1], d) != suppressed {
+			t.Errorf("%s and %s when %s: expected suppressed %t", sendAts[0], sendAts[1], sendAts[2], suppressed)
+		}
+	}
+}
+
+func testAccCheckSendgridSingleSendDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_single_send" {
+			continue
+		}
+
+		_, requestErr := c.DeleteSingleSend(rs.Primary.ID)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridSingleSendConfigBasic(name string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_single_send" "single_send" {
+		name       = %q
+		categories = ["terraform"]
+
+		email_config {
+			subject      = "Terraform acceptance test"
+			html_content = "<p>Hello</p>"
+		}
+	}
+	`, name)
+}
+
+func testAccCheckSendgridSingleSendExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No single send ID set")
+		}
+
+		return nil
+	}
+}
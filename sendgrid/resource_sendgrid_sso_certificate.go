@@ -0,0 +1,225 @@
+/*
+Provide a resource to manage a certificate of the identity provider of an SSO integration, signing its SAML responses.
+Adding the first certificate completes the integration: unless `wait_for_completion` is false, the creation waits
+until Sendgrid reports the integration complete, for up to `completion_timeout`, so that the resources depending
+on the certificate, e.g. `sendgrid_sso_teammate`, can use the SSO in the same apply.
+See `sendgrid_sso_integration` for the integration itself.
+Example Usage
+```hcl
+resource "sendgrid_sso_certificate" "okta" {
+	integration_id     = sendgrid_sso_integration.okta.id
+	public_certificate = file("okta.pem")
+	completion_timeout = "2m"
+}
+```
+Import
+An SSO certificate can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_sso_certificate.okta certificateID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// defaultSSOCompletionTimeout is the time given to Sendgrid to complete an SSO integration once its certificate
+// is added.
+const defaultSSOCompletionTimeout = 5 * time.Minute
+
+func resourceSendgridSSOCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSSOCertificateCreate,
+		ReadContext:   resourceSendgridSSOCertificateRead,
+		UpdateContext: resourceSendgridSSOCertificateUpdate,
+		DeleteContext: resourceSendgridSSOCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridSSOCertificateImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"integration_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the SSO integration the certificate is added to.",
+				Required:    true,
+			},
+			"public_certificate": {
+				Type:        schema.TypeString,
+				Description: "The certificate of the identity provider, PEM encoded.",
+				Required:    true,
+				// Sendgrid may return the certificate without its trailing new line.
+				DiffSuppressFunc: func(_, old, new string, _ *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the certificate is used to verify the SAML responses.",
+				Optional:    true,
+				Default:     true,
+			},
+			"wait_for_completion": {
+				Type:        schema.TypeBool,
+				Description: "Wait until Sendgrid reports the integration complete when the certificate is added to it.",
+				Optional:    true,
+				Default:     true,
+			},
+			"completion_timeout": {
+				Type:         schema.TypeString,
+				Description:  "How long to wait for the integration to be complete, e.g. 2m.",
+				Optional:     true,
+				Default:      defaultSSOCompletionTimeout.String(),
+				ValidateFunc: validateDuration,
+			},
+			"not_before": {
+				Type:        schema.TypeInt,
+				Description: "The date the certificate is valid from, as a unix timestamp.",
+				Computed:    true,
+			},
+			"not_after": {
+				Type:        schema.TypeInt,
+				Description: "The date the certificate expires at, as a unix timestamp.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ssoCertificateFromResourceData(d *schema.ResourceData) sendgrid.SSOCertificate {
+	return sendgrid.SSOCertificate{
+		PublicCertificate: d.Get("public_certificate").(string),
+		Enabled:           d.Get("enabled").(bool),
+		IntegrationID:     d.Get("integration_id").(string),
+	}
+}
+
+// waitForSSOIntegrationCompletion waits until Sendgrid reports the integration of the certificate complete,
+// if the certificate is configured to wait for it.
+func waitForSSOIntegrationCompletion(ctx context.Context, c *sendgrid.Client, d *schema.ResourceData) error {
+	if !d.Get("wait_for_completion").(bool) {
+		return nil
+	}
+
+	id := d.Get("integration_id").(string)
+	timeout, _ := time.ParseDuration(d.Get("completion_timeout").(string))
+
+	return resource.RetryContext(ctx, timeout, func() *resource.RetryError {
+		integration, err := c.Retry(ctx, timeout, func() (interface{}, sendgrid.RequestError) {
+			return c.ReadSSOIntegration(id)
+		})
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !integration.(*sendgrid.SSOIntegration).CompletedIntegration {
+			return resource.RetryableError(ssoIntegrationNotCompleted(id))
+		}
+
+		return nil
+	})
+}
+
+func resourceSendgridSSOCertificateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	certificate, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSSOCertificate(ssoCertificateFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed creating SSO certificate", err)
+	}
+
+	d.SetId(strconv.FormatInt(certificate.(*sendgrid.SSOCertificate).ID, 10))
+
+	if err := waitForSSOIntegrationCompletion(ctx, c, d); err != nil {
+		return errorToDiags("failed waiting for SSO integration completion", err)
+	}
+
+	return resourceSendgridSSOCertificateRead(ctx, d, m)
+}
+
+func resourceSendgridSSOCertificateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	certificate, requestErr := c.ReadSSOCertificate(d.Id())
+	if isNotFound(requestErr) {
+		// the certificate was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading SSO certificate", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("integration_id", certificate.IntegrationID)
+	//nolint:errcheck
+	d.Set("public_certificate", certificate.PublicCertificate)
+	//nolint:errcheck
+	d.Set("not_before", certificate.NotBefore)
+	//nolint:errcheck
+	d.Set("not_after", certificate.NotAfter)
+
+	return nil
+}
+
+func resourceSendgridSSOCertificateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	// wait_for_completion and completion_timeout are only used when the certificate is added to an integration.
+	if d.HasChanges("integration_id", "public_certificate", "enabled") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateSSOCertificate(d.Id(), ssoCertificateFromResourceData(d))
+		})
+		if err != nil {
+			return errorToDiags("failed updating SSO certificate", err)
+		}
+	}
+
+	if d.HasChange("integration_id") {
+		if err := waitForSSOIntegrationCompletion(ctx, c, d); err != nil {
+			return errorToDiags("failed waiting for SSO integration completion", err)
+		}
+	}
+
+	return resourceSendgridSSOCertificateRead(ctx, d, m)
+}
+
+func resourceSendgridSSOCertificateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSSOCertificate(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting SSO certificate", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSSOCertificateImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("enabled", true)
+	//nolint:errcheck
+	d.Set("wait_for_completion", true)
+	//nolint:errcheck
+	d.Set("completion_timeout", defaultSSOCompletionTimeout.String())
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,54 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestSendgridSSOCertificateWaitsForCompletion(t *testing.T) {
+	var reads int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/sso/certificates":
+			fmt.Fprint(w, `{"id": 42, "public_certificate": "PEM", "intergration_id": "abc"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/sso/integrations/abc":
+			// Sendgrid completes the integration a while after its certificate is added.
+			fmt.Fprintf(w, `{"id": "abc", "completed_integration": %t}`, atomic.AddInt32(&reads, 1) > 1)
+		case r.Method == http.MethodGet && r.URL.Path == "/sso/certificates/42":
+			fmt.Fprint(w, `{"id": 42, "public_certificate": "PEM", "intergration_id": "abc"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_sso_certificate"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"integration_id":     "abc",
+		"public_certificate": "PEM",
+		"completion_timeout": "30s",
+	})
+
+	if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	if d.Id() != "42" {
+		t.Fatalf("unexpected ID: %s", d.Id())
+	}
+
+	if atomic.LoadInt32(&reads) != 2 {
+		t.Fatalf("expected the integration to be read until complete, read %d times", reads)
+	}
+}
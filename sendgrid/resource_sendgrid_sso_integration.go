@@ -0,0 +1,190 @@
+/*
+Provide a resource to manage the integration of an identity provider, e.g. Okta, for the single sign-on (SSO)
+of the teammates.
+An integration is created incomplete: it's only complete, and the teammates can only sign in, once a certificate
+of the identity provider is added with `sendgrid_sso_certificate`. As the certificate references the integration,
+the integration is created first, then the certificate, which waits until Sendgrid completes the integration.
+The resources using the SSO in the same apply, e.g. `sendgrid_sso_teammate`, should depend on the certificate:
+`completed_integration` of the integration is only refreshed at the next plan.
+Example Usage
+```hcl
+resource "sendgrid_sso_integration" "okta" {
+	name        = "Okta"
+	enabled     = true
+	signin_url  = "https://example.okta.com/app/sendgrid/sso/saml"
+	signout_url = "https://example.okta.com/login/signout"
+	entity_id   = "http://www.okta.com/exk1234"
+}
+
+resource "sendgrid_sso_certificate" "okta" {
+	integration_id     = sendgrid_sso_integration.okta.id
+	public_certificate = file("okta.pem")
+}
+
+resource "sendgrid_sso_teammate" "example" {
+	email      = "teammate@example.org"
+	first_name = "Jane"
+	last_name  = "Doe"
+	persona    = "developer"
+
+	depends_on = [sendgrid_sso_certificate.okta]
+}
+```
+Import
+An SSO integration can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_sso_integration.okta integrationID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSSOIntegration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSSOIntegrationCreate,
+		ReadContext:   resourceSendgridSSOIntegrationRead,
+		UpdateContext: resourceSendgridSSOIntegrationUpdate,
+		DeleteContext: resourceSendgridSSOIntegrationDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "The name of the integration.",
+				Required:    true,
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Whether the teammates can sign in with the integration, once it's complete.",
+				Optional:    true,
+				Default:     true,
+			},
+			"signin_url": {
+				Type:        schema.TypeString,
+				Description: "The URL of the identity provider the teammates sign in at, i.e. its SAML SSO URL.",
+				Required:    true,
+			},
+			"signout_url": {
+				Type:        schema.TypeString,
+				Description: "The URL the teammates are redirected to when they sign out.",
+				Required:    true,
+			},
+			"entity_id": {
+				Type:        schema.TypeString,
+				Description: "The entity ID of the identity provider, i.e. its issuer.",
+				Required:    true,
+			},
+			"completed_integration": {
+				Type:        schema.TypeBool,
+				Description: "Whether the integration is complete, i.e. a certificate was added.",
+				Computed:    true,
+			},
+			"single_signon_url": {
+				Type:        schema.TypeString,
+				Description: "The URL of Sendgrid the identity provider posts the SAML responses to.",
+				Computed:    true,
+			},
+			"audience_url": {
+				Type:        schema.TypeString,
+				Description: "The audience of the SAML responses, to configure in the identity provider.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ssoIntegrationFromResourceData(d *schema.ResourceData) sendgrid.SSOIntegration {
+	return sendgrid.SSOIntegration{
+		Name:       d.Get("name").(string),
+		Enabled:    d.Get("enabled").(bool),
+		SigninURL:  d.Get("signin_url").(string),
+		SignoutURL: d.Get("signout_url").(string),
+		EntityID:   d.Get("entity_id").(string),
+	}
+}
+
+func resourceSendgridSSOIntegrationCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	integration, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSSOIntegration(ssoIntegrationFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed creating SSO integration", err)
+	}
+
+	// the integration is incomplete until its certificate is added, which needs its ID: it isn't waited for here.
+	d.SetId(integration.(*sendgrid.SSOIntegration).ID)
+
+	return resourceSendgridSSOIntegrationRead(ctx, d, m)
+}
+
+func resourceSendgridSSOIntegrationRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	integration, requestErr := c.ReadSSOIntegration(d.Id())
+	if isNotFound(requestErr) {
+		// the integration was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading SSO integration", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("name", integration.Name)
+	//nolint:errcheck
+	d.Set("enabled", integration.Enabled)
+	//nolint:errcheck
+	d.Set("signin_url", integration.SigninURL)
+	//nolint:errcheck
+	d.Set("signout_url", integration.SignoutURL)
+	//nolint:errcheck
+	d.Set("entity_id", integration.EntityID)
+	//nolint:errcheck
+	d.Set("completed_integration", integration.CompletedIntegration)
+	//nolint:errcheck
+	d.Set("single_signon_url", integration.SingleSignonURL)
+	//nolint:errcheck
+	d.Set("audience_url", integration.AudienceURL)
+
+	return nil
+}
+
+func resourceSendgridSSOIntegrationUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSSOIntegration(d.Id(), ssoIntegrationFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed updating SSO integration", err)
+	}
+
+	return resourceSendgridSSOIntegrationRead(ctx, d, m)
+}
+
+func resourceSendgridSSOIntegrationDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSSOIntegration(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting SSO integration", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,191 @@
+package sendgrid_test
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridSSOIntegrationCertificate(t *testing.T) {
+	certificateFile := os.Getenv("SENDGRID_TEST_SSO_CERTIFICATE")
+	if certificateFile == "" {
+		t.Skip("SENDGRID_TEST_SSO_CERTIFICATE must be set to a PEM certificate of an identity provider")
+	}
+
+	certificate, err := ioutil.ReadFile(certificateFile)
+	if err != nil {
+		t.Fatalf("failed reading the certificate: %s", err)
+	}
+
+	name := "terraform-sso-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSSOIntegrationConfig(name, string(certificate)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_sso_integration.integration", "audience_url"),
+					resource.TestCheckResourceAttrSet("sendgrid_sso_certificate.certificate", "not_after"),
+				),
+			},
+			{
+				// the integration is refreshed complete once its certificate was added.
+				Config: testAccCheckSendgridSSOIntegrationConfig(name, string(certificate)),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sso_integration.integration", "completed_integration", "true"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_sso_integration.integration",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSSOIntegrationConfig(name, certificate string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_sso_integration" "integration" {
+		name        = %q
+		signin_url  = "https://idp.example.org/sso/saml"
+		signout_url = "https://idp.example.org/signout"
+		entity_id   = "https://idp.example.org/%s"
+	}
+
+	resource "sendgrid_sso_certificate" "certificate" {
+		integration_id     = sendgrid_sso_integration.integration.id
+		public_certificate = %q
+	}
+	`, name, name, certificate)
+}
+
+// testSSOServer returns a server serving the integration abc, which Sendgrid completes once its certificate 42
+// is added.
+func testSSOServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	var completed int32
+
+	integration := func(w http.ResponseWriter) {
+		fmt.Fprintf(w, `{"id": "abc", "name": "Okta", "enabled": true, `+
+			`"signin_url": "https://idp.example.org/sso/saml", "signout_url": "https://idp.example.org/signout", `+
+			`"entity_id": "https://idp.example.org/abc", "completed_integration": %t, `+
+			`"single_signon_url": "https://api.sendgrid.com/v3/sso/saml/abc", "audience_url": "https://sendgrid.com/abc"}`,
+			atomic.LoadInt32(&completed) == 1)
+	}
+
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/sso/integrations":
+			integration(w)
+		case r.Method == http.MethodGet && r.URL.Path == "/sso/integrations/abc":
+			integration(w)
+		case r.Method == http.MethodPost && r.URL.Path == "/sso/certificates":
+			atomic.StoreInt32(&completed, 1)
+			fmt.Fprint(w, `{"id": 42, "public_certificate": "PEM", "intergration_id": "abc"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/sso/certificates/42":
+			fmt.Fprint(w, `{"id": 42, "public_certificate": "PEM", "intergration_id": "abc"}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+}
+
+// TestSendgridSSOIntegrationCompletedByCertificate checks that the integration is created incomplete,
+// and read complete once its certificate was added.
+func TestSendgridSSOIntegrationCompletedByCertificate(t *testing.T) {
+	server := testSSOServer(t)
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	integration := provider.Provider().ResourcesMap["sendgrid_sso_integration"]
+	integrationData := schema.TestResourceDataRaw(t, integration.Schema, map[string]interface{}{
+		"name":        "Okta",
+		"signin_url":  "https://idp.example.org/sso/saml",
+		"signout_url": "https://idp.example.org/signout",
+		"entity_id":   "https://idp.example.org/abc",
+	})
+
+	if diags := integration.CreateContext(context.Background(), integrationData, c); diags.HasError() {
+		t.Fatalf("failed creating SSO integration: %v", diags)
+	}
+
+	if integrationData.Id() != "abc" || integrationData.Get("completed_integration").(bool) {
+		t.Fatalf("expected the integration abc created incomplete, got %q complete %t",
+			integrationData.Id(), integrationData.Get("completed_integration"))
+	}
+
+	certificate := provider.Provider().ResourcesMap["sendgrid_sso_certificate"]
+	certificateData := schema.TestResourceDataRaw(t, certificate.Schema, map[string]interface{}{
+		"integration_id":     integrationData.Id(),
+		"public_certificate": "PEM",
+		"completion_timeout": "30s",
+	})
+
+	if diags := certificate.CreateContext(context.Background(), certificateData, c); diags.HasError() {
+		t.Fatalf("failed creating SSO certificate: %v", diags)
+	}
+
+	// the integration is only refreshed complete at the next plan.
+	if diags := integration.ReadContext(context.Background(), integrationData, c); diags.HasError() {
+		t.Fatalf("failed reading SSO integration: %v", diags)
+	}
+
+	if !integrationData.Get("completed_integration").(bool) {
+		t.Fatal("expected the integration to be read complete once its certificate was added")
+	}
+}
+
+func TestSendgridSSOIntegrationImport(t *testing.T) {
+	server := testSSOServer(t)
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_sso_integration"]
+	d := r.TestResourceData()
+	d.SetId("abc")
+
+	imported, err := r.Importer.StateContext(context.Background(), d, c)
+	if err != nil || len(imported) != 1 {
+		t.Fatalf("failed importing SSO integration: %v", err)
+	}
+
+	if diags := r.ReadContext(context.Background(), imported[0], c); diags.HasError() {
+		t.Fatalf("failed reading SSO integration: %v", diags)
+	}
+
+	for key, expected := range map[string]interface{}{
+		"name":                  "Okta",
+		"enabled":               true,
+		"signin_url":            "https://idp.example.org/sso/saml",
+		"signout_url":           "https://idp.example.org/signout",
+		"entity_id":             "https://idp.example.org/abc",
+		"completed_integration": false,
+		"single_signon_url":     "https://api.sendgrid.com/v3/sso/saml/abc",
+		"audience_url":          "https://sendgrid.com/abc",
+	} {
+		if value := imported[0].Get(key); value != expected {
+			t.Errorf("%s: expected %v, got %v", key, expected, value)
+		}
+	}
+}
+
+func TestSendgridSSOIntegrationReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_sso_integration", "abc", map[string]interface{}{})
+}
@@ -0,0 +1,186 @@
+/*
+Provide a resource to manage a teammate signing in with the single sign-on (SSO) of the account.
+Unlike `sendgrid_teammate`, no invite is sent and the teammate has no password, it's created right away.
+Its permissions are either its `scopes`, or the ones Sendgrid derives from its `persona`.
+Example Usage
+```hcl
+resource "sendgrid_sso_teammate" "example" {
+	email      = "teammate@example.org"
+	first_name = "Jane"
+	last_name  = "Doe"
+	scopes     = ["mail.send", "stats.read"]
+}
+```
+Import
+An SSO teammate can be imported by email, e.g.
+```hcl
+$ terraform import sendgrid_sso_teammate.example teammate@example.org
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSSOTeammate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSSOTeammateCreate,
+		ReadContext:   resourceSendgridSSOTeammateRead,
+		UpdateContext: resourceSendgridSSOTeammateUpdate,
+		DeleteContext: resourceSendgridSSOTeammateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address of the teammate, as known by the identity provider.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"first_name": {
+				Type:        schema.TypeString,
+				Description: "The first name of the teammate.",
+				Required:    true,
+			},
+			"last_name": {
+				Type:        schema.TypeString,
+				Description: "The last name of the teammate.",
+				Required:    true,
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Description: "Whether the teammate is an admin, with all the scopes.",
+				Optional:    true,
+				Default:     false,
+			},
+			"scopes": {
+				Type:          schema.TypeSet,
+				Description:   "The scopes of the teammate, ignored for an admin, derived from the persona if it's set.",
+				Optional:      true,
+				Computed:      true,
+				Elem:          &schema.Schema{Type: schema.TypeString},
+				ConflictsWith: []string{"persona"},
+			},
+			"persona": {
+				Type: schema.TypeString,
+				Description: "The persona the scopes of the teammate are derived from: " +
+					"accountant, developer, marketer or observer.",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accountant",
+					"developer",
+					"marketer",
+					"observer",
+				}, false),
+				ConflictsWith: []string{"scopes"},
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the teammate.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func ssoTeammateFromResourceData(d *schema.ResourceData) sendgrid.SSOTeammate {
+	teammate := sendgrid.SSOTeammate{
+		Email:     d.Get("email").(string),
+		FirstName: d.Get("first_name").(string),
+		LastName:  d.Get("last_name").(string),
+		IsAdmin:   d.Get("is_admin").(bool),
+		Persona:   d.Get("persona").(string),
+	}
+
+	// the scopes derived from the persona aren't sent back.
+	if teammate.Persona == "" {
+		teammate.Scopes = stringSetToSlice(d.Get("scopes").(*schema.Set))
+	}
+
+	return teammate
+}
+
+func resourceSendgridSSOTeammateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	teammate := ssoTeammateFromResourceData(d)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSSOTeammate(teammate)
+	})
+	if err != nil {
+		return errorToDiags("failed creating SSO teammate", err)
+	}
+
+	d.SetId(teammate.Email)
+
+	return resourceSendgridSSOTeammateRead(ctx, d, m)
+}
+
+func resourceSendgridSSOTeammateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	teammate, requestErr := c.ReadTeammateByEmail(d.Id())
+	if isNotFound(requestErr) {
+		// the teammate was removed outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading SSO teammate", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("email", teammate.Email)
+	//nolint:errcheck
+	d.Set("first_name", teammate.FirstName)
+	//nolint:errcheck
+	d.Set("last_name", teammate.LastName)
+	//nolint:errcheck
+	d.Set("is_admin", teammate.IsAdmin)
+	//nolint:errcheck
+	d.Set("username", teammate.Username)
+
+	if !teammate.IsAdmin {
+		//nolint:errcheck
+		d.Set("scopes", teammateScopes(teammate.Scopes))
+	}
+
+	return nil
+}
+
+func resourceSendgridSSOTeammateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSSOTeammate(d.Get("username").(string), ssoTeammateFromResourceData(d))
+	})
+	if err != nil {
+		return errorToDiags("failed updating SSO teammate", err)
+	}
+
+	return resourceSendgridSSOTeammateRead(ctx, d, m)
+}
+
+func resourceSendgridSSOTeammateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteTeammate(d.Get("username").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed deleting SSO teammate", err)
+	}
+
+	return nil
+}
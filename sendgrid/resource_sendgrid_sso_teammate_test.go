@@ -0,0 +1,78 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSSOTeammateBasic(t *testing.T) {
+	domain := os.Getenv("SENDGRID_TEST_SSO_DOMAIN")
+	if domain == "" {
+		t.Skip("SENDGRID_TEST_SSO_DOMAIN must be set to the domain of the single sign-on of the account")
+	}
+
+	email := "terraform-" + acctest.RandString(10) + "@" + domain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSSOTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSSOTeammateConfigBasic(email, "Jane", "stats.read"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sso_teammate.teammate", "email", email),
+					resource.TestCheckResourceAttr("sendgrid_sso_teammate.teammate", "first_name", "Jane"),
+					resource.TestCheckResourceAttrSet("sendgrid_sso_teammate.teammate", "username"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSSOTeammateConfigBasic(email, "John", "mail.send"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_sso_teammate.teammate", "first_name", "John"),
+					resource.TestCheckResourceAttr("sendgrid_sso_teammate.teammate", "scopes.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_sso_teammate.teammate",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSSOTeammateDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_sso_teammate" {
+			continue
+		}
+
+		_, requestErr := c.ReadTeammateByEmail(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("SSO teammate %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridSSOTeammateConfigBasic(email, firstName, scope string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_sso_teammate" "teammate" {
+		email      = %q
+		first_name = %q
+		last_name  = "Doe"
+		scopes     = [%q]
+	}
+	`, email, firstName, scope)
+}
@@ -1,5 +1,16 @@
 /*
 Provide a resource to manage a subuser.
+A subuser associated with an authenticated domain can't be destroyed, unless `force_destroy` removes the association
+first. The IP addresses only assigned to a destroyed subuser are reported, as they are left unassigned.
+On the plans without dedicated IP addresses, `ips` can be omitted: the subuser sends from the shared IP addresses.
+The IP addresses Sendgrid actually assigned are kept in the state when the subuser is created,
+the requested ones it didn't assign are reported.
+The profile of the subuser (company, website, phone, city and country) is managed on behalf of it,
+the profile fields which aren't set aren't managed.
+When `disabled` is set, it's enforced: a subuser enabled, or disabled, outside of Terraform, e.g. in the UI,
+shows as a change at the next plan and is set back to the configured value by the apply.
+Sendgrid doesn't limit the sending rate of a subuser, its `credits` cap the number of emails it can send instead:
+once the credits are used, the emails of the subuser are dropped until they are reset, e.g. every month.
 Example Usage
 ```hcl
 resource "sendgrid_subuser" "subuser" {
@@ -9,21 +20,46 @@ resource "sendgrid_subuser" "subuser" {
 	ips      = [
 		"127.0.0.1"
 	]
+	company  = "Example"
+	website  = "https://example.org"
+
+	credits {
+		type            = "recurring"
+		total           = 10000
+		reset_frequency = "monthly"
+	}
 }
 ```
+The state of the subusers created by the provider before `region`, `adopt_existing` and `force_destroy` existed
+is upgraded with their defaults, so that upgrading the provider doesn't plan any change.
 Import
-A subuser can be imported, e.g.
+A subuser can be imported by username, with the IP addresses assigned to it.
+Its password can't be imported, as Sendgrid never returns it, the one configured is ignored, e.g.
 ```hcl
 $ terraform import sendgrid_subuser.subuser userName
 ```
+A subuser can also be imported by its numeric user ID, resolved to its username, e.g.
+```hcl
+$ terraform import sendgrid_subuser.subuser 1234567
+```
 */
 package sendgrid
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
 )
 
@@ -34,59 +70,317 @@ func resourceSendgridSubuser() *schema.Resource {
 		UpdateContext: resourceSendgridSubuserUpdate,
 		DeleteContext: resourceSendgridSubuserDelete,
 		Importer: &schema.ResourceImporter{
-			StateContext: schema.ImportStatePassthroughContext,
+			StateContext: resourceSendgridSubuserImport,
 		},
+		SchemaVersion: 1,
+		StateUpgraders: []schema.StateUpgrader{{
+			Version: 0,
+			Type:    resourceSendgridSubuserV0().CoreConfigSchema().ImpliedType(),
+			Upgrade: resourceSendgridSubuserStateUpgradeV0,
+		}},
 
 		Schema: map[string]*schema.Schema{
+			"adopt_existing": {
+				Type: schema.TypeBool,
+				Description: "Adopt the subuser if its username already exists with the same email, " +
+					"e.g. when an apply was interrupted, instead of failing. The password isn't checked nor changed.",
+				Optional: true,
+				Default:  false,
+			},
 			"username": {
 				Type:        schema.TypeString,
 				Description: "The name of the subuser.",
 				Required:    true,
 			},
 			"password": {
-				Type:        schema.TypeString,
-				Description: "The password the subuser will use when logging into SendGrid.",
-				Sensitive:   true,
-				Required:    true,
+				Type: schema.TypeString,
+				Description: "The password the subuser will use when logging into SendGrid, " +
+					"only set when the subuser is created: it's never returned by Sendgrid, nor imported. " +
+					"It must have 8 to 128 characters, with lowercase and uppercase letters, a number and a symbol.",
+				Sensitive:        true,
+				Required:         true,
+				DiffSuppressFunc: suppressImportedSubuserPassword,
+				ValidateDiagFunc: validateSubuserPassword,
 			},
 			"email": {
 				Type:        schema.TypeString,
-				Description: "The email of the subuser.",
+				Description: "The email of the subuser, it can be changed without recreating the subuser.",
 				Required:    true,
 			},
 			"ips": {
-				Type:        schema.TypeSet,
-				Description: "The IP addresses that should be assigned to this subuser.",
-				Required:    true,
-				Elem:        &schema.Schema{Type: schema.TypeString},
+				Type: schema.TypeSet,
+				Description: "The IP addresses that should be assigned to this subuser, required when the account " +
+					"has dedicated IP addresses. Without them, the subuser sends from the shared IP addresses.",
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"region": {
+				Type:         schema.TypeString,
+				Description:  "The region the data of the subuser is kept in, allowed values: global, eu.",
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"global", "eu"}, false),
 			},
 			"user_id": {
 				Type:     schema.TypeInt,
 				Computed: true,
 			},
 			"disabled": {
-				Type:     schema.TypeBool,
+				Type: schema.TypeBool,
+				Description: "Whether the subuser is disabled. When set, it's enforced: the subuser enabled or " +
+					"disabled outside of Terraform is set back at the next apply. When not set, it isn't managed.",
 				Optional: true,
 				Computed: true,
 			},
+			"force_destroy": {
+				Type: schema.TypeBool,
+				Description: "Remove the authenticated domain association of the subuser when destroying it, " +
+					"otherwise the destruction fails while the association exists.",
+				Optional: true,
+				Default:  false,
+			},
+			"company": {
+				Type:        schema.TypeString,
+				Description: "The company of the profile of the subuser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"website": {
+				Type:        schema.TypeString,
+				Description: "The website of the profile of the subuser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"phone": {
+				Type:        schema.TypeString,
+				Description: "The phone number of the profile of the subuser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the profile of the subuser.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the profile of the subuser.",
+				Optional:    true,
+				Computed:    true,
+			},
 			"signup_session_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "The token completing the signup of the subuser, only returned when the subuser is created.",
+				Computed:    true,
+				Sensitive:   true,
 			},
 			"authorization_token": {
-				Type:     schema.TypeString,
-				Computed: true,
+				Type:        schema.TypeString,
+				Description: "The authorization token of the subuser, only returned when the subuser is created.",
+				Computed:    true,
+				Sensitive:   true,
 			},
 			"credit_allocation_type": {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"credits": {
+				Type:        schema.TypeList,
+				Description: "The credit allocation of the subuser: the number of emails it can send.",
+				Optional:    true,
+				Computed:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type: schema.TypeString,
+							Description: "The type of credits, allowed values: unlimited, " +
+								"recurring (reset every reset_frequency), nonrecurring.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"unlimited", "recurring", "nonrecurring"}, false),
+						},
+						"total": {
+							Type:        schema.TypeInt,
+							Description: "The number of credits allocated to the subuser, not allowed for unlimited credits.",
+							Optional:    true,
+						},
+						"reset_frequency": {
+							Type:         schema.TypeString,
+							Description:  "The frequency of reset of recurring credits, allowed values: daily, weekly, monthly.",
+							Optional:     true,
+							ValidateFunc: validation.StringInSlice([]string{"daily", "weekly", "monthly"}, false),
+						},
+						"remaining": {
+							Type:        schema.TypeInt,
+							Description: "The number of credits the subuser has left.",
+							Computed:    true,
+						},
+						"used": {
+							Type:        schema.TypeInt,
+							Description: "The number of credits the subuser used since the last reset.",
+							Computed:    true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
 
+// The rules of Sendgrid for the passwords, besides mixing lowercase and uppercase letters, numbers and symbols.
+const (
+	subuserPasswordMinLength = 8
+	subuserPasswordMaxLength = 128
+)
+
+// createdReadTimeout is the time allowed for a resource Sendgrid just created to be readable.
+const createdReadTimeout = 30 * time.Second
+
+// waitUntilReadable retries the read of a resource which was just created until it's found,
+// as Sendgrid is eventually consistent: a resource may not be found right after its creation.
+func waitUntilReadable(ctx context.Context, read func() (bool, error)) error {
+	return resource.RetryContext(ctx, createdReadTimeout, func() *resource.RetryError {
+		found, err := read()
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+
+		if !found {
+			return resource.RetryableError(ErrNotReadableYet)
+		}
+
+		return nil
+	})
+}
+
+// suppressImportedSubuserPassword ignores the password of an imported subuser, which isn't in its state
+// as Sendgrid never returns it, instead of planning a change.
+func suppressImportedSubuserPassword(_, old, _ string, d *schema.ResourceData) bool {
+	return old == "" && d.Id() != ""
+}
+
+// validateSubuserPassword checks the password against the rules of Sendgrid at plan time,
+// since Sendgrid only rejects a weak password when the subuser is created.
+// The password isn't part of the message, it's sensitive.
+func validateSubuserPassword(v interface{}, path cty.Path) diag.Diagnostics {
+	password := v.(string)
+
+	var unmet []string
+
+	switch length := utf8.RuneCountInString(password); {
+	case length < subuserPasswordMinLength:
+		unmet = append(unmet, fmt.Sprintf("at least %d characters", subuserPasswordMinLength))
+	case length > subuserPasswordMaxLength:
+		unmet = append(unmet, fmt.Sprintf("at most %d characters", subuserPasswordMaxLength))
+	}
+
+	var lower, upper, digit, symbol bool
+
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsLetter(r) && !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	for _, class := range []struct {
+		found bool
+		name  string
+	}{
+		{lower, "a lowercase letter"},
+		{upper, "an uppercase letter"},
+		{digit, "a number"},
+		{symbol, "a symbol"},
+	} {
+		if !class.found {
+			unmet = append(unmet, class.name)
+		}
+	}
+
+	if len(unmet) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity:      diag.Error,
+		Summary:       "Sendgrid subuser password is too weak",
+		Detail:        "Sendgrid would reject the password, it lacks: " + strings.Join(unmet, ", ") + ".",
+		AttributePath: path,
+	}}
+}
+
+// adoptSubuser checks that the existing subuser matches the configuration before adopting it.
+func adoptSubuser(c *sendgrid.Client, username, email string) diag.Diagnostics {
+	subUser, requestErr := c.ReadSubUser(username)
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading existing subuser", requestErr)}
+	}
+
+	if len(subUser) == 0 {
+		return diag.FromErr(subUserNotFound(username))
+	}
+
+	if subUser[0].Email != email {
+		return diag.FromErr(subUserConflict(username, subUser[0].Email))
+	}
+
+	return nil
+}
+
+// checkSubuserIPs checks that the IP addresses of a subuser belong to the account, and to the region of the subuser,
+// before creating it, instead of the opaque error of Sendgrid. A subuser can only go without IP addresses,
+// and use the shared ones, if the account has no dedicated IP addresses.
+func checkSubuserIPs(c *sendgrid.Client, username, region string, ips []string) diag.Diagnostics {
+	// the IP addresses of the account rarely change, they are listed once for all the subusers.
+	accountIPs, requestErr := c.Cached().ListIPs()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing IPs", requestErr)}
+	}
+
+	if len(ips) == 0 && len(accountIPs) > 0 {
+		return diag.FromErr(subUserIPsRequired(username))
+	}
+
+	regions := make(map[string]string, len(accountIPs))
+	for _, ip := range accountIPs {
+		regions[ip.IP] = ip.Region
+	}
+
+	var unknown, otherRegion []string
+
+	for _, ip := range ips {
+		ipRegion, ok := regions[ip]
+
+		switch {
+		case !ok:
+			unknown = append(unknown, ip)
+		// the IP addresses of the EU region can only be assigned to the subusers of the EU region, and vice versa.
+		case ipRegion != "" && (ipRegion == "eu") != (region == "eu"):
+			otherRegion = append(otherRegion, ip)
+		}
+	}
+
+	if len(unknown) > 0 || len(otherRegion) > 0 {
+		if region == "" {
+			region = "global"
+		}
+
+		return diag.FromErr(subUserInvalidIPs(username, region, unknown, otherRegion))
+	}
+
+	return nil
+}
+
 func resourceSendgridSubuserCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	c := parentClient(m).WithContext(ctx)
 
 	username := d.Get("username").(string)
 	password := d.Get("password").(string)
@@ -99,36 +393,153 @@ func resourceSendgridSubuserCreate(ctx context.Context, d *schema.ResourceData,
 		ips = append(ips, ip.(string))
 	}
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
-		return c.CreateSubuser(username, email, password, ips)
+	if diags := checkSubuserIPs(c, username, d.Get("region").(string), ips); diags.HasError() {
+		return diags
+	}
+
+	subUserStruct, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSubuser(username, email, password, ips, d.Get("region").(string))
 	})
+	adopted := false
+
 	if err != nil {
-		return diag.FromErr(err)
+		if !d.Get("adopt_existing").(bool) || !errors.Is(err, sendgrid.ErrSubUserAlreadyExists) {
+			return errorToDiags("failed creating subuser", err)
+		}
+
+		if diags := adoptSubuser(c, username, email); diags.HasError() {
+			return diags
+		}
+
+		adopted = true
 	}
 
 	d.SetId(username)
 
-	if d.Get("disabled").(bool) {
-		return resourceSendgridSubuserUpdate(ctx, d, m)
+	// the tokens are only returned when the subuser is created, keep them in the state.
+	if subUser, ok := subUserStruct.(*sendgrid.SubUser); ok && subUser != nil {
+		//nolint:errcheck
+		d.Set("signup_session_token", subUser.SignupSessionToken)
+		//nolint:errcheck
+		d.Set("authorization_token", subUser.AuthorizationToken)
 	}
 
-	return resourceSendgridSubuserRead(ctx, d, m)
+	err = waitUntilReadable(ctx, func() (bool, error) {
+		subUsers, requestErr := c.ReadSubUser(username)
+		if requestErr.Err != nil {
+			return false, requestErr
+		}
+
+		return len(subUsers) > 0, nil
+	})
+	if err != nil {
+		return errorToDiags("failed waiting for the subuser to be readable", err)
+	}
+
+	diags := setSubuserAssignedIPs(c, d, subUserStruct, ips)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, ok := d.GetOk("credits"); ok {
+		if diags := updateSubuserCredits(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+			return diags
+		}
+	}
+
+	if subuserProfileConfigured(d) {
+		if diags := updateSubuserProfile(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+			return diags
+		}
+	}
+
+	// a subuser is created enabled, disable it within the same apply. An adopted subuser may be disabled or not,
+	// the configured value is applied either way.
+	//nolint:staticcheck
+	_, disabledConfigured := d.GetOkExists("disabled")
+	if d.Get("disabled").(bool) || (adopted && disabledConfigured) {
+		if diags := updateSubuserDisabled(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+			return diags
+		}
+	}
+
+	return append(diags, resourceSendgridSubuserRead(ctx, d, m)...)
+}
+
+// setSubuserAssignedIPs keeps the IP addresses Sendgrid actually assigned to the subuser created, or adopted,
+// in the state, from the creation response or else from the IP addresses of the account, so that the state
+// reflects them. The requested IP addresses which weren't assigned are reported with a warning.
+func setSubuserAssignedIPs(
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	subUserStruct interface{},
+	requested []string,
+) diag.Diagnostics {
+	var assigned []string
+
+	if subUser, ok := subUserStruct.(*sendgrid.SubUser); ok && subUser != nil && len(subUser.IPs) > 0 {
+		assigned = subUser.IPs
+	} else {
+		accountIPs, requestErr := c.ListIPs()
+		if requestErr.Err != nil {
+			return diag.Diagnostics{requestErrorToDiag("failed listing IPs", requestErr)}
+		}
+
+		assigned = subuserAssignedIPs(accountIPs, d.Id())
+	}
+
+	//nolint:errcheck
+	d.Set("ips", assigned)
+
+	var unassigned []string
+
+	for _, ip := range requested {
+		if !ipInIPs(assigned, ip) {
+			unassigned = append(unassigned, ip)
+		}
+	}
+
+	if len(unassigned) == 0 {
+		return nil
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "IP addresses not assigned",
+		Detail: "Sendgrid didn't assign the IP addresses to the subuser " + d.Id() + ": " +
+			strings.Join(unassigned, ", ") + ", they are missing from the state.",
+		AttributePath: cty.GetAttrPath("ips"),
+	}}
 }
 
-func resourceSendgridSubuserRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+// subuserAssignedIPs returns the IP addresses of the account assigned to the subuser.
+func subuserAssignedIPs(accountIPs []sendgrid.IP, username string) []string {
+	var assigned []string
+
+	for _, ip := range accountIPs {
+		for _, subuser := range ip.Subusers {
+			if subuser == username {
+				assigned = append(assigned, ip.IP)
+			}
+		}
+	}
+
+	return assigned
+}
 
-	// hack to clear any on behalf of set in create sub user
-	// to fix this properly I think we need to pass this down rather than setting global state
-	c.OnBehalfOf = ""
+func resourceSendgridSubuserRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
 
 	subUser, requestErr := c.ReadSubUser(d.Id())
-	if requestErr.Err != nil {
-		return diag.FromErr(requestErr.Err)
+	if isNotFound(requestErr) || (requestErr.Err == nil && len(subUser) == 0) {
+		// the subuser was deleted outside of Terraform, Sendgrid lists no subuser with its username.
+		d.SetId("")
+
+		return nil
 	}
 
-	if len(subUser) == 0 {
-		return diag.FromErr(subUserNotFound(d.Id()))
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser", requestErr)}
 	}
 
 	//nolint:errcheck
@@ -138,31 +549,344 @@ func resourceSendgridSubuserRead(_ context.Context, d *schema.ResourceData, m in
 	//nolint:errcheck
 	d.Set("email", subUser[0].Email)
 
+	if subUser[0].Region != "" {
+		//nolint:errcheck
+		d.Set("region", subUser[0].Region)
+	}
+
+	// the profile of a subuser is the profile of its user, read on behalf of it.
+	profile, requestErr := c.WithOnBehalfOf(d.Id()).ReadProfile()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser profile", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("company", profile.Company)
+	//nolint:errcheck
+	d.Set("website", profile.Website)
+	//nolint:errcheck
+	d.Set("phone", profile.Phone)
+	//nolint:errcheck
+	d.Set("city", profile.City)
+	//nolint:errcheck
+	d.Set("country", profile.Country)
+
+	credits, requestErr := c.ReadSubUserCredits(d.Id())
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser credits", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("credit_allocation_type", credits.Type)
+	//nolint:errcheck
+	d.Set("credits", []interface{}{
+		map[string]interface{}{
+			"type":            credits.Type,
+			"total":           credits.Total,
+			"reset_frequency": credits.ResetFrequency,
+			"remaining":       credits.Remaining,
+			"used":            credits.Used,
+		},
+	})
+
+	return nil
+}
+
+func updateSubuserDisabled(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSubuser(d.Id(), d.Get("disabled").(bool))
+	})
+	if err != nil {
+		return errorToDiags("failed updating subuser", err)
+	}
+
+	return nil
+}
+
+// subuserProfileFields are the fields of the profile of a subuser managed by the resource.
+var subuserProfileFields = []string{"company", "website", "phone", "city", "country"}
+
+func subuserProfileConfigured(d *schema.ResourceData) bool {
+	for _, field := range subuserProfileFields {
+		if _, ok := d.GetOk(field); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func updateSubuserProfile(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.WithOnBehalfOf(d.Id()).UpdateProfile(sendgrid.Profile{
+			Company: d.Get("company").(string),
+			Website: d.Get("website").(string),
+			Phone:   d.Get("phone").(string),
+			City:    d.Get("city").(string),
+			Country: d.Get("country").(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed updating subuser profile", err)
+	}
+
+	return nil
+}
+
+func updateSubuserCredits(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	credits := d.Get("credits").([]interface{})[0].(map[string]interface{})
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSubUserCredits(d.Id(), sendgrid.SubUserCredits{
+			Type:           credits["type"].(string),
+			Total:          credits["total"].(int),
+			ResetFrequency: credits["reset_frequency"].(string),
+		})
+	})
+	if err != nil {
+		return errorToDiags("failed updating subuser credits", err)
+	}
+
 	return nil
 }
 
 func resourceSendgridSubuserUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	c := parentClient(m).WithContext(ctx)
 
+	if d.HasChange("email") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateSubuserEmail(d.Id(), d.Get("email").(string))
+		})
+		if err != nil {
+			return errorToDiags("failed updating subuser email", err)
+		}
+	}
+
+	// the drift of disabled, read from Sendgrid, shows as a change: the configured value is set back.
 	if d.HasChange("disabled") {
-		_, requestErr := c.UpdateSubuser(d.Id(), d.Get("disabled").(bool))
-		if requestErr.Err != nil {
-			return diag.FromErr(requestErr.Err)
+		if diags := updateSubuserDisabled(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChanges(subuserProfileFields...) {
+		if diags := updateSubuserProfile(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+			return diags
+		}
+	}
+
+	if d.HasChange("credits") {
+		if diags := updateSubuserCredits(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+			return diags
 		}
 	}
 
 	return resourceSendgridSubuserRead(ctx, d, m)
 }
 
+// checkSubuserDependents reports the resources depending on a subuser before its deletion, instead of the error
+// of Sendgrid: the authenticated domain association fails the deletion, unless force_destroy removes it,
+// the IP addresses only assigned to the subuser are left unassigned with a warning.
+func checkSubuserDependents(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	domain, requestErr := c.ReadSubuserDomainAuthentication(d.Id())
+	if requestErr.Err != nil && !isNotFound(requestErr) {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser domain authentication", requestErr)}
+	}
+
+	if requestErr.Err == nil && domain.ID != 0 {
+		if !d.Get("force_destroy").(bool) {
+			return diag.FromErr(subUserHasDomainAuthentication(d.Id(), domain.Domain))
+		}
+
+		_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+			return c.DisassociateDomainAuthentication(d.Id())
+		})
+		if err != nil {
+			return errorToDiags("failed disassociating subuser domain authentication", err)
+		}
+	}
+
+	ips, requestErr := c.ListIPs()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing IPs", requestErr)}
+	}
+
+	var dedicated []string
+
+	for _, ip := range ips {
+		if len(ip.Subusers) == 1 && ip.Subusers[0] == d.Id() {
+			dedicated = append(dedicated, ip.IP)
+		}
+	}
+
+	if len(dedicated) > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  "IP addresses left unassigned",
+			Detail: "The IP addresses only assigned to the subuser " + d.Id() + " aren't assigned to any subuser " +
+				"anymore: " + strings.Join(dedicated, ", "),
+		})
+	}
+
+	return diags
+}
+
 func resourceSendgridSubuserDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	c := parentClient(m).WithContext(ctx)
 
-	_, err := sendgrid.RetryOnRateLimit(ctx, d, func() (interface{}, sendgrid.RequestError) {
+	diags := checkSubuserDependents(ctx, c, d, schema.TimeoutDelete)
+	if diags.HasError() {
+		return diags
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
 		return c.DeleteSubuser(d.Id())
 	})
 	if err != nil {
-		return diag.FromErr(err)
+		return append(diags, errorToDiags("failed deleting subuser", err)...)
 	}
 
-	return nil
+	return diags
+}
+
+// subuserUsernameByID returns the username of the subuser with the user ID, if it's numeric and one of the subusers
+// has it. A numeric ID which isn't the user ID of a subuser is a username made of digits.
+func subuserUsernameByID(subusers []sendgrid.SubUser, id string) (string, bool) {
+	userID, err := strconv.Atoi(id)
+	if err != nil {
+		return "", false
+	}
+
+	for _, subuser := range subusers {
+		if subuser.ID == userID {
+			return subuser.UserName, true
+		}
+	}
+
+	return "", false
+}
+
+// resourceSendgridSubuserImport imports a subuser by username or user ID, with the IP addresses assigned to it,
+// which aren't read otherwise, so that the plan following the import is clean.
+func resourceSendgridSubuserImport(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) ([]*schema.ResourceData, error) {
+	c := parentClient(m).WithContext(ctx)
+
+	if _, err := strconv.Atoi(d.Id()); err == nil {
+		subusers, requestErr := c.ListSubusers()
+		if requestErr.Err != nil {
+			return nil, fmt.Errorf("failed listing subusers: %w", requestErr)
+		}
+
+		// the subuser is identified by its username.
+		if username, ok := subuserUsernameByID(subusers, d.Id()); ok {
+			d.SetId(username)
+		}
+	}
+
+	ips, requestErr := c.ListIPs()
+	if requestErr.Err != nil {
+		return nil, fmt.Errorf("failed listing IPs: %w", requestErr)
+	}
+
+	//nolint:errcheck
+	d.Set("username", d.Id())
+	//nolint:errcheck
+	d.Set("ips", subuserAssignedIPs(ips, d.Id()))
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("adopt_existing", false)
+	//nolint:errcheck
+	d.Set("force_destroy", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// resourceSendgridSubuserV0 is the schema of the subusers before the version 1,
+// which added region, adopt_existing and force_destroy.
+func resourceSendgridSubuserV0() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"password": {
+				Type:      schema.TypeString,
+				Sensitive: true,
+				Required:  true,
+			},
+			"email": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"ips": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"user_id": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"disabled": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"signup_session_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"authorization_token": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"credit_allocation_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// resourceSendgridSubuserStateUpgradeV0 sets the defaults of the attributes added by the version 1,
+// which would otherwise be planned as changes. The region is read by the next refresh.
+func resourceSendgridSubuserStateUpgradeV0(
+	_ context.Context,
+	rawState map[string]interface{},
+	_ interface{},
+) (map[string]interface{}, error) {
+	for _, key := range []string{"adopt_existing", "force_destroy"} {
+		if _, ok := rawState[key]; !ok {
+			rawState[key] = false
+		}
+	}
+
+	return rawState, nil
 }
@@ -0,0 +1,158 @@
+/*
+Provide a resource to manage the monitor of a subuser:
+a sample of the emails sent by the subuser is copied to the monitor email.
+Example Usage
+```hcl
+resource "sendgrid_subuser_monitor" "monitor" {
+	username  = sendgrid_subuser.subuser.username
+	email     = "monitor@example.org"
+	frequency = 500
+}
+```
+Import
+A subuser monitor can be imported, e.g.
+```hcl
+$ terraform import sendgrid_subuser_monitor.monitor userName
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSubuserMonitor() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSubuserMonitorCreate,
+		ReadContext:   resourceSendgridSubuserMonitorRead,
+		UpdateContext: resourceSendgridSubuserMonitorUpdate,
+		DeleteContext: resourceSendgridSubuserMonitorDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridSubuserMonitorImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The name of the monitored subuser.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address the sample messages are sent to.",
+				Required:    true,
+			},
+			"frequency": {
+				Type:         schema.TypeInt,
+				Description:  "The frequency of the sample: one message is copied every `frequency` messages sent.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func subUserMonitorFromResourceData(d *schema.ResourceData) sendgrid.SubUserMonitor {
+	return sendgrid.SubUserMonitor{
+		Email:     d.Get("email").(string),
+		Frequency: d.Get("frequency").(int),
+	}
+}
+
+func resourceSendgridSubuserMonitorCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	username := d.Get("username").(string)
+	monitor := subUserMonitorFromResourceData(d)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateSubUserMonitor(username, monitor)
+	})
+	if err != nil {
+		return errorToDiags("failed creating subuser monitor", err)
+	}
+
+	d.SetId(username)
+
+	return resourceSendgridSubuserMonitorRead(ctx, d, m)
+}
+
+func resourceSendgridSubuserMonitorRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	monitor, requestErr := c.ReadSubUserMonitor(d.Id())
+	if isNotFound(requestErr) {
+		// the monitor, or its subuser, was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading subuser monitor", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("email", monitor.Email)
+	//nolint:errcheck
+	d.Set("frequency", monitor.Frequency)
+
+	return nil
+}
+
+func resourceSendgridSubuserMonitorUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	monitor := subUserMonitorFromResourceData(d)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateSubUserMonitor(d.Id(), monitor)
+	})
+	if err != nil {
+		return errorToDiags("failed updating subuser monitor", err)
+	}
+
+	return resourceSendgridSubuserMonitorRead(ctx, d, m)
+}
+
+func resourceSendgridSubuserMonitorDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSubUserMonitor(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting subuser monitor", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSubuserMonitorImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("username", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
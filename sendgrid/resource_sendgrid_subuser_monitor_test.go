@@ -0,0 +1,95 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSubuserMonitorBasic(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	email := username + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserMonitorDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserMonitorConfigBasic(username, email, 100),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridSubuserMonitorExists("sendgrid_subuser_monitor.monitor"),
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.monitor", "frequency", "100"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserMonitorConfigBasic(username, email, 500),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser_monitor.monitor", "frequency", "500"),
+				),
+			},
+		},
+	})
+}
+
+func TestSendgridSubuserMonitorReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_subuser_monitor", "subuser", map[string]interface{}{
+		"username":  "subuser",
+		"email":     "monitor@example.org",
+		"frequency": 10,
+	})
+}
+
+func testAccCheckSendgridSubuserMonitorDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_subuser_monitor" {
+			continue
+		}
+
+		_, requestErr := c.DeleteSubUserMonitor(rs.Primary.ID)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridSubuserMonitorConfigBasic(username, email string, frequency int) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = "Passw0rd!%s"
+		email    = %q
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_subuser_monitor" "monitor" {
+		username  = sendgrid_subuser.subuser.username
+		email     = %q
+		frequency = %d
+	}
+	`, username, username, email, email, frequency)
+}
+
+func testAccCheckSendgridSubuserMonitorExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No subuser monitor ID set")
+		}
+
+		return nil
+	}
+}
@@ -1,18 +1,28 @@
 package sendgrid_test
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
 	"testing"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
 )
 
 func TestAccSendgridSubuserBasic(t *testing.T) {
 	username := "terraform-subuser-" + acctest.RandString(10)
-	password := acctest.RandString(10)
+	password := "Passw0rd!" + acctest.RandString(10)
 	email := username + "@example.org"
 	ips := []string{"127.0.0.1", "255.255.255.255"}
 
@@ -24,13 +34,585 @@ func TestAccSendgridSubuserBasic(t *testing.T) {
 			{
 				Config: testAccCheckSendgridSubuserConfigBasic(username, password, email, ips),
 				Check: resource.ComposeTestCheckFunc(
-					testAccCheckSendgridSubuserExists("sendgrid_subuser.new"),
+					testAccCheckSendgridSubuserExists("sendgrid_subuser.subuser"),
+					resource.TestCheckResourceAttrSet("sendgrid_subuser.subuser", "signup_session_token"),
+					resource.TestCheckResourceAttrSet("sendgrid_subuser.subuser", "authorization_token"),
+				),
+			},
+			{
+				// the email is updated in place, the tokens of the creation are kept.
+				Config: testAccCheckSendgridSubuserConfigBasic(username, password, "updated-"+email, ips),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "email", "updated-"+email),
+					resource.TestCheckResourceAttrSet("sendgrid_subuser.subuser", "signup_session_token"),
+				),
+			},
+			{
+				// the IPs are imported, only the password and the tokens of the creation can't be.
+				ResourceName:      "sendgrid_subuser.subuser",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"password", "signup_session_token", "authorization_token",
+				},
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserCredits(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := "Passw0rd!" + acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserConfigCredits(username, password, email, ips, `
+					type            = "recurring"
+					total           = 1000
+					reset_frequency = "monthly"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "credits.0.type", "recurring"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "credits.0.total", "1000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "credits.0.remaining", "1000"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "credits.0.used", "0"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserConfigCredits(username, password, email, ips, `
+					type = "unlimited"
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "credits.0.type", "unlimited"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserProfile(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := "Passw0rd!" + acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserConfigProfile(username, password, email, ips, "Example"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "company", "Example"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "website", "https://example.org"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "country", "France"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSubuserConfigProfile(username, password, email, ips, "Example Inc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "company", "Example Inc"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserAdoptExisting(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := "Passw0rd!" + acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				// simulate an apply interrupted after the subuser was created.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+					if _, requestErr := c.CreateSubuser(username, email, password, ips, ""); requestErr.Err != nil {
+						t.Fatalf("failed creating subuser: %s", requestErr.Err)
+					}
+				},
+				Config: testAccCheckSendgridSubuserConfigAdoptExisting(username, password, email, ips),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridSubuserExists("sendgrid_subuser.subuser"),
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "email", email),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridSubuserDisabledEnforced(t *testing.T) {
+	username := "terraform-subuser-" + acctest.RandString(10)
+	password := "Passw0rd!" + acctest.RandString(10)
+	email := username + "@example.org"
+	ips := []string{"127.0.0.1"}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSubuserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSubuserConfigDisabled(username, password, email, ips),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "disabled", "true"),
+					testAccCheckSendgridSubuserDisabled(username, true),
+				),
+			},
+			{
+				// the subuser enabled outside of Terraform is disabled again.
+				PreConfig: func() {
+					c := testAccProvider.Meta().(*sendgrid.Client)
+					if _, requestErr := c.UpdateSubuser(username, false); requestErr.Err != nil {
+						t.Fatalf("failed enabling subuser: %s", requestErr.Err)
+					}
+				},
+				Config: testAccCheckSendgridSubuserConfigDisabled(username, password, email, ips),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_subuser.subuser", "disabled", "true"),
+					testAccCheckSendgridSubuserDisabled(username, true),
 				),
 			},
 		},
 	})
 }
 
+func TestSendgridSubuserDisabledOnCreateRateLimited(t *testing.T) {
+	var rateLimited, disabled int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1", "subusers": ["subuser"]}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/subusers":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"username": "subuser", "user_id": 1, "email": "subuser@example.org"}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/subusers/subuser":
+			// rate limit the first attempt to disable the subuser.
+			if atomic.CompareAndSwapInt32(&rateLimited, 0, 1) {
+				w.WriteHeader(http.StatusTooManyRequests)
+
+				return
+			}
+
+			atomic.StoreInt32(&disabled, 1)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers":
+			fmt.Fprintf(w, `[{"username": "subuser", "id": 1, "email": "subuser@example.org", "disabled": %t}]`,
+				atomic.LoadInt32(&disabled) == 1)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers/subuser/credits":
+			fmt.Fprint(w, `{"type": "unlimited"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/profile":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+	c.Backoff = sendgrid.Backoff{BaseDelay: time.Millisecond, MaxDelay: 10 * time.Millisecond}
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+		"ips":      []interface{}{"127.0.0.1"},
+		"disabled": true,
+	})
+
+	if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed creating subuser: %v", diags)
+	}
+
+	if atomic.LoadInt32(&rateLimited) != 1 || atomic.LoadInt32(&disabled) != 1 {
+		t.Fatal("expected the subuser to be disabled after being rate limited")
+	}
+
+	if !d.Get("disabled").(bool) {
+		t.Error("expected the subuser to be disabled in the state")
+	}
+}
+
+func TestSendgridSubuserUpdateReassertsDisabled(t *testing.T) {
+	var disabled int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/subusers/subuser":
+			atomic.StoreInt32(&disabled, 1)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers":
+			fmt.Fprintf(w, `[{"username": "subuser", "id": 1, "email": "subuser@example.org", "disabled": %t}]`,
+				atomic.LoadInt32(&disabled) == 1)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers/subuser/credits":
+			fmt.Fprint(w, `{"type": "unlimited"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/profile":
+			fmt.Fprint(w, `{}`)
+		default:
+			t.Errorf("unexpected request: %s %s", r.Method, r.URL)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	// the subuser was enabled outside of Terraform: disabled is planned as a change.
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"disabled": true,
+	})
+	d.SetId("subuser")
+
+	if diags := r.UpdateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed updating subuser: %v", diags)
+	}
+
+	if atomic.LoadInt32(&disabled) != 1 {
+		t.Fatal("expected the subuser to be disabled again")
+	}
+
+	if !d.Get("disabled").(bool) {
+		t.Error("expected the subuser to be disabled in the state")
+	}
+}
+
+func TestSendgridSubuserCreateEventuallyConsistent(t *testing.T) {
+	var reads int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1", "subusers": ["subuser"]}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/subusers":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"username": "subuser", "user_id": 1, "email": "subuser@example.org"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers":
+			// the subuser isn't readable right after its creation.
+			if atomic.AddInt32(&reads, 1) == 1 {
+				fmt.Fprint(w, `[]`)
+
+				return
+			}
+
+			fmt.Fprint(w, `[{"username": "subuser", "id": 1, "email": "subuser@example.org"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers/subuser/credits":
+			fmt.Fprint(w, `{"type": "unlimited"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/profile":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+		"ips":      []interface{}{"127.0.0.1"},
+	})
+
+	if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed creating subuser: %v", diags)
+	}
+
+	if atomic.LoadInt32(&reads) < 2 || d.Id() != "subuser" {
+		t.Fatalf("expected the subuser to be read again, got %d reads", atomic.LoadInt32(&reads))
+	}
+}
+
+func TestSendgridSubuserCreatePartiallyAssignedIPs(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1"}, {"ip": "127.0.0.2"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/subusers":
+			// Sendgrid only assigned one of the IP addresses requested.
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"username": "subuser", "user_id": 1, "email": "subuser@example.org", "ips": ["127.0.0.1"]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers":
+			fmt.Fprint(w, `[{"username": "subuser", "id": 1, "email": "subuser@example.org"}]`)
+		case r.Method == http.MethodGet && r.URL.Path == "/subusers/subuser/credits":
+			fmt.Fprint(w, `{"type": "unlimited"}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/user/profile":
+			fmt.Fprint(w, `{}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+		"ips":      []interface{}{"127.0.0.1", "127.0.0.2"},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	if diags.HasError() {
+		t.Fatalf("failed creating subuser: %v", diags)
+	}
+
+	if len(diags) != 1 || diags[0].Severity != diag.Warning || !strings.Contains(diags[0].Detail, "127.0.0.2") {
+		t.Fatalf("expected a warning about the IP address not assigned, got: %v", diags)
+	}
+
+	if ips := d.Get("ips").(*schema.Set); ips.Len() != 1 || !ips.Contains("127.0.0.1") {
+		t.Errorf("expected the assigned IP address in the state, got %v", ips.List())
+	}
+}
+
+func TestSendgridSubuserCreateWithInvalidIPs(t *testing.T) {
+	var created int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1", "region": "us"}, {"ip": "127.0.0.2", "region": "eu"}]`)
+		case r.Method == http.MethodPost && r.URL.Path == "/subusers":
+			atomic.StoreInt32(&created, 1)
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+		"ips":      []interface{}{"127.0.0.1", "127.0.0.2", "127.0.0.3"},
+	})
+
+	diags := r.CreateContext(context.Background(), d, c)
+	if !diags.HasError() || atomic.LoadInt32(&created) != 0 {
+		t.Fatalf("expected the subuser not to be created, got: %v", diags)
+	}
+
+	// the error names the IPs which can't be assigned, and why.
+	detail := diags[0].Detail
+	if !strings.Contains(detail, "account: 127.0.0.3") || !strings.Contains(detail, "global: 127.0.0.2") {
+		t.Fatalf("unexpected error: %s", detail)
+	}
+}
+
+func TestSendgridSubuserDeleteWithDomainAuthentication(t *testing.T) {
+	var disassociated, deleted int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/whitelabel/domains/subuser":
+			fmt.Fprint(w, `{"id": 1, "domain": "example.org"}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/whitelabel/domains/subuser":
+			atomic.StoreInt32(&disassociated, 1)
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodGet && r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1", "subusers": ["subuser"]}]`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/subusers/subuser":
+			atomic.StoreInt32(&deleted, 1)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+
+	for _, forceDestroy := range []bool{false, true} {
+		d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+			"username":      "subuser",
+			"password":      "Passw0rd!",
+			"email":         "subuser@example.org",
+			"ips":           []interface{}{"127.0.0.1"},
+			"force_destroy": forceDestroy,
+		})
+		d.SetId("subuser")
+
+		diags := r.DeleteContext(context.Background(), d, c)
+
+		// the association fails the deletion, unless force_destroy removes it.
+		if diags.HasError() == forceDestroy || atomic.LoadInt32(&deleted) != atomic.LoadInt32(&disassociated) {
+			t.Fatalf("unexpected deletion with force_destroy %t: %v", forceDestroy, diags)
+		}
+	}
+
+	if atomic.LoadInt32(&deleted) != 1 {
+		t.Fatal("expected the subuser to be deleted with force_destroy")
+	}
+}
+
+func TestSendgridSubuserPasswordValidation(t *testing.T) {
+	validate := provider.Provider().ResourcesMap["sendgrid_subuser"].Schema["password"].ValidateDiagFunc
+
+	tests := []struct {
+		password string
+		unmet    string
+	}{
+		{"Passw0rd!", ""},
+		{"Pa0!", "at least 8 characters"},
+		{"Passw0rd!" + strings.Repeat("a", 128), "at most 128 characters"},
+		{"PASSW0RD!", "a lowercase letter"},
+		{"passw0rd!", "an uppercase letter"},
+		{"Password!", "a number"},
+		{"Passw0rds", "a symbol"},
+	}
+
+	for _, test := range tests {
+		diags := validate(test.password, cty.Path{cty.GetAttrStep{Name: "password"}})
+
+		if test.unmet == "" {
+			if len(diags) != 0 {
+				t.Errorf("%s: expected no error, got: %v", test.password, diags)
+			}
+
+			continue
+		}
+
+		if len(diags) != 1 || !strings.Contains(diags[0].Detail, test.unmet) {
+			t.Errorf("%s: expected an error about %s, got: %v", test.password, test.unmet, diags)
+		}
+	}
+}
+
+func TestSendgridSubuserStateUpgradeV0(t *testing.T) {
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+
+	if r.SchemaVersion != 1 || len(r.StateUpgraders) != 1 || r.StateUpgraders[0].Version != 0 {
+		t.Fatalf("expected a state upgrader from the version 0, got version %d", r.SchemaVersion)
+	}
+
+	state, err := r.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{
+		"id":       "subuser",
+		"username": "subuser",
+		"email":    "subuser@example.org",
+		"ips":      []interface{}{"127.0.0.1"},
+		"disabled": false,
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed upgrading state: %v", err)
+	}
+
+	if state["adopt_existing"] != false || state["force_destroy"] != false {
+		t.Errorf("expected the defaults of adopt_existing and force_destroy, got %v", state)
+	}
+
+	if ips, ok := state["ips"].([]interface{}); !ok || len(ips) != 1 || ips[0] != "127.0.0.1" {
+		t.Errorf("expected the IPs to be kept, got %v", state["ips"])
+	}
+
+	// the attributes already set aren't overwritten.
+	state, err = r.StateUpgraders[0].Upgrade(context.Background(), map[string]interface{}{
+		"id":            "subuser",
+		"force_destroy": true,
+	}, nil)
+	if err != nil {
+		t.Fatalf("failed upgrading state: %v", err)
+	}
+
+	if state["force_destroy"] != true {
+		t.Errorf("expected force_destroy to be kept, got %v", state["force_destroy"])
+	}
+}
+
+func TestSendgridSubuserReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_subuser", "subuser", map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+	})
+}
+
+func TestSendgridSubuserReadNotListed(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Sendgrid lists no subuser with the username of a deleted subuser.
+		fmt.Fprint(w, `[]`)
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+	d := schema.TestResourceDataRaw(t, r.Schema, map[string]interface{}{
+		"username": "subuser",
+		"password": "Passw0rd!",
+		"email":    "subuser@example.org",
+	})
+	d.SetId("subuser")
+
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() || d.Id() != "" {
+		t.Fatalf("expected the subuser to be removed from the state, got ID %q: %v", d.Id(), diags)
+	}
+}
+
+func TestSendgridSubuserImportByUserID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/subusers" && r.URL.Query().Get("offset") == "0":
+			fmt.Fprint(w, `[{"username": "subuser", "id": 1234567}, {"username": "42", "id": 7654321}]`)
+		case r.URL.Path == "/subusers":
+			fmt.Fprint(w, `[]`)
+		case r.URL.Path == "/ips":
+			fmt.Fprint(w, `[{"ip": "127.0.0.1", "subusers": ["subuser"]}]`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	r := provider.Provider().ResourcesMap["sendgrid_subuser"]
+
+	for id, username := range map[string]string{
+		"1234567": "subuser",
+		"subuser": "subuser",
+		// a username made of digits which isn't a user ID is kept.
+		"42": "42",
+	} {
+		d := r.Data(nil)
+		d.SetId(id)
+
+		imported, err := r.Importer.StateContext(context.Background(), d, c)
+		if err != nil {
+			t.Fatalf("%s: failed importing subuser: %s", id, err)
+		}
+
+		if imported[0].Id() != username || imported[0].Get("username").(string) != username {
+			t.Errorf("%s: expected the subuser %s to be imported, got %q", id, username, imported[0].Id())
+		}
+	}
+}
+
 func testAccCheckSendgridSubuserDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
@@ -53,12 +635,83 @@ func testAccCheckSendgridSubuserDestroy(s *terraform.State) error {
 func testAccCheckSendgridSubuserConfigBasic(username, password, email string, ips []string) string {
 	return fmt.Sprintf(`
 	resource "sendgrid_subuser" "subuser" {
-		username = %s
-		password = %s
-		email    = %s
-		ips      = %s
+		username = %q
+		password = %q
+		email    = %q
+		ips      = ["%s"]
+	}
+	`, username, password, email, strings.Join(ips, `", "`))
+}
+
+func testAccCheckSendgridSubuserConfigDisabled(username, password, email string, ips []string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = %q
+		email    = %q
+		ips      = ["%s"]
+		disabled = true
+	}
+	`, username, password, email, strings.Join(ips, `", "`))
+}
+
+func testAccCheckSendgridSubuserDisabled(username string, disabled bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		c := testAccProvider.Meta().(*sendgrid.Client)
+
+		subUsers, requestErr := c.ReadSubUser(username)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if len(subUsers) == 0 || subUsers[0].Disabled != disabled {
+			return fmt.Errorf("expected subuser %s to have disabled %t in Sendgrid", username, disabled)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSendgridSubuserConfigAdoptExisting(username, password, email string, ips []string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username       = %q
+		password       = %q
+		email          = %q
+		ips            = ["%s"]
+		adopt_existing = true
+	}
+	`, username, password, email, strings.Join(ips, `", "`))
+}
+
+func testAccCheckSendgridSubuserConfigCredits(username, password, email string, ips []string, credits string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = %q
+		email    = %q
+		ips      = ["%s"]
+
+		credits {
+			%s
+		}
+	}
+	`, username, password, email, strings.Join(ips, `", "`), credits)
+}
+
+func testAccCheckSendgridSubuserConfigProfile(username, password, email string, ips []string, company string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = %q
+		email    = %q
+		ips      = ["%s"]
+		company  = %q
+		website  = "https://example.org"
+		city     = "Paris"
+		country  = "France"
 	}
-	`, username, password, email, ips)
+	`, username, password, email, strings.Join(ips, `", "`), company)
 }
 
 func testAccCheckSendgridSubuserExists(n string) resource.TestCheckFunc {
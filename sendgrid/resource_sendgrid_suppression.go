@@ -0,0 +1,158 @@
+/*
+Provide a resource to manage an address of a suppression list.
+The `kind` is one of `bounces`, `blocks`, `spam_reports`, `invalid_emails` or `global`.
+Only global suppressions (unsubscribes) can be added: the other lists are filled by Sendgrid itself,
+so the resource can only take over an address already in them, and remove it when destroyed
+so emails are delivered to it again.
+Example Usage
+```hcl
+resource "sendgrid_suppression" "unsubscribe" {
+	kind  = "global"
+	email = "unsubscribed@example.org"
+}
+```
+Import
+A suppression can be imported using its kind and email, e.g.
+```hcl
+$ terraform import sendgrid_suppression.unsubscribe global/unsubscribed@example.org
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSuppression() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSuppressionCreate,
+		ReadContext:   resourceSendgridSuppressionRead,
+		DeleteContext: resourceSendgridSuppressionDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridSuppressionImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"kind": {
+				Type:         schema.TypeString,
+				Description:  "The suppression list: bounces, blocks, spam_reports, invalid_emails or global.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(sendgrid.SuppressionKinds(), false),
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The suppressed email address.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"reason": {
+				Type:        schema.TypeString,
+				Description: "The reason given by Sendgrid for the suppression, if any.",
+				Computed:    true,
+			},
+			"created": {
+				Type:        schema.TypeInt,
+				Description: "The Unix timestamp of the suppression, if known.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridSuppressionCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	kind := d.Get("kind").(string)
+	email := d.Get("email").(string)
+
+	if kind == sendgrid.SuppressionGlobal {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+			return c.CreateGlobalSuppression(email)
+		})
+		if err != nil {
+			return errorToDiags("failed creating suppression", err)
+		}
+	} else {
+		_, requestErr := c.ReadSuppression(kind, email)
+		if isNotFound(requestErr) {
+			return diag.FromErr(ErrSuppressionNotCreatable)
+		}
+
+		if requestErr.Err != nil {
+			return diag.Diagnostics{requestErrorToDiag("failed reading suppression", requestErr)}
+		}
+	}
+
+	d.SetId(kind + "/" + email)
+
+	return resourceSendgridSuppressionRead(ctx, d, m)
+}
+
+func resourceSendgridSuppressionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	suppression, requestErr := c.ReadSuppression(d.Get("kind").(string), d.Get("email").(string))
+	if isNotFound(requestErr) {
+		// the address was removed outside of Terraform, it has to be suppressed again.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading suppression", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("reason", suppression.Reason)
+	//nolint:errcheck
+	d.Set("created", suppression.Created)
+
+	return nil
+}
+
+func resourceSendgridSuppressionDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteSuppression(d.Get("kind").(string), d.Get("email").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed deleting suppression", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSuppressionImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", ImportSplitParts)
+	if len(parts) != ImportSplitParts || parts[0] == "" || parts[1] == "" {
+		return nil, ErrInvalidSuppressionImportFormat
+	}
+
+	//nolint:errcheck
+	d.Set("kind", parts[0])
+	//nolint:errcheck
+	d.Set("email", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
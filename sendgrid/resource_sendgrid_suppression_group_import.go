@@ -0,0 +1,222 @@
+/*
+Provide a resource to add a batch of addresses to a suppression group in a single request,
+e.g. to migrate the unsubscribes of a legacy ESP, instead of one sendgrid_suppression per address.
+Only the addresses added by the resource are removed from the group when it's destroyed,
+the ones which were already in the group are left untouched.
+Example Usage
+```hcl
+resource "sendgrid_suppression_group_import" "legacy" {
+	group_id = 12345
+	emails   = split("\n", trimspace(file("${path.module}/unsubscribes.txt")))
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSuppressionGroupImport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSuppressionGroupImportCreate,
+		ReadContext:   resourceSendgridSuppressionGroupImportRead,
+		UpdateContext: resourceSendgridSuppressionGroupImportUpdate,
+		DeleteContext: resourceSendgridSuppressionGroupImportDelete,
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the suppression group to add the addresses to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"emails": {
+				Type:        schema.TypeSet,
+				Description: "The addresses to add to the suppression group.",
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"added_emails": {
+				Type: schema.TypeSet,
+				Description: "The addresses added to the suppression group by the resource, " +
+					"which excludes the ones already in it and the ones Sendgrid rejected.",
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func stringSliceToSet(s []string) *schema.Set {
+	items := make([]interface{}, 0, len(s))
+	for _, item := range s {
+		items = append(items, item)
+	}
+
+	return schema.NewSet(schema.HashString, items)
+}
+
+// addGroupSuppressions adds the addresses which aren't in the suppression group yet, and returns the ones
+// Sendgrid accepted, with a warning listing the ones it rejected.
+func addGroupSuppressions(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	groupID string,
+	emails []string,
+) ([]string, diag.Diagnostics) {
+	if len(emails) == 0 {
+		return nil, nil
+	}
+
+	existing, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.SearchGroupSuppressions(groupID, emails)
+	})
+	if err != nil {
+		return nil, errorToDiags("failed searching group suppressions", err)
+	}
+
+	toAdd := stringSliceToSet(emails).Difference(stringSliceToSet(existing.([]string)))
+	if toAdd.Len() == 0 {
+		return nil, nil
+	}
+
+	accepted, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.AddGroupSuppressions(groupID, stringSetToSlice(toAdd))
+	})
+	if err != nil {
+		return nil, errorToDiags("failed adding group suppressions", err)
+	}
+
+	added := accepted.([]string)
+
+	rejected := stringSetToSlice(toAdd.Difference(stringSliceToSet(added)))
+	if len(rejected) == 0 {
+		return added, nil
+	}
+
+	return added, diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "Sendgrid rejected some addresses of the suppression import",
+		Detail:   "The addresses weren't added to the suppression group: " + strings.Join(rejected, ", "),
+	}}
+}
+
+func resourceSendgridSuppressionGroupImportCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	groupID := strconv.Itoa(d.Get("group_id").(int))
+
+	added, diags := addGroupSuppressions(
+		ctx, c, d, schema.TimeoutCreate, groupID, stringSetToSlice(d.Get("emails").(*schema.Set)),
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	d.SetId(groupID)
+	//nolint:errcheck
+	d.Set("added_emails", added)
+
+	return append(diags, resourceSendgridSuppressionGroupImportRead(ctx, d, m)...)
+}
+
+func resourceSendgridSuppressionGroupImportRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	added := stringSetToSlice(d.Get("added_emails").(*schema.Set))
+	if len(added) == 0 {
+		return nil
+	}
+
+	// the addresses removed from the group outside of Terraform aren't managed anymore.
+	remaining, requestErr := c.SearchGroupSuppressions(d.Id(), added)
+	if isNotFound(requestErr) {
+		// the suppression group was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed searching group suppressions", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("added_emails", remaining)
+
+	return nil
+}
+
+func resourceSendgridSuppressionGroupImportUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	o, n := d.GetChange("emails")
+	oldEmails, newEmails := o.(*schema.Set), n.(*schema.Set)
+	added := d.Get("added_emails").(*schema.Set)
+	removed := oldEmails.Difference(newEmails).Intersection(added)
+
+	for _, email := range stringSetToSlice(removed) {
+		email := email
+
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteGroupSuppression(d.Id(), email)
+		})
+		if err != nil {
+			return errorToDiags("failed deleting group suppression", err)
+		}
+	}
+
+	newlyAdded, diags := addGroupSuppressions(
+		ctx, c, d, schema.TimeoutUpdate, d.Id(), stringSetToSlice(newEmails.Difference(oldEmails)),
+	)
+	if diags.HasError() {
+		return diags
+	}
+
+	//nolint:errcheck
+	d.Set("added_emails", added.Difference(removed).Union(stringSliceToSet(newlyAdded)))
+
+	return append(diags, resourceSendgridSuppressionGroupImportRead(ctx, d, m)...)
+}
+
+func resourceSendgridSuppressionGroupImportDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	for _, email := range stringSetToSlice(d.Get("added_emails").(*schema.Set)) {
+		email := email
+
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+			return c.DeleteGroupSuppression(d.Id(), email)
+		})
+		if err != nil {
+			return errorToDiags("failed deleting group suppression", err)
+		}
+	}
+
+	return nil
+}
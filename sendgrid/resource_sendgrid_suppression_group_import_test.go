@@ -0,0 +1,49 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridSuppressionGroupImportBasic(t *testing.T) {
+	groupID := os.Getenv("SENDGRID_TEST_SUPPRESSION_GROUP")
+	if groupID == "" {
+		t.Skip("SENDGRID_TEST_SUPPRESSION_GROUP must be set to the ID of a suppression group of the account")
+	}
+
+	first := "terraform-" + acctest.RandString(10) + "@example.org"
+	second := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSuppressionGroupImportConfig(groupID, first, second),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_suppression_group_import.import", "added_emails.#", "2"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridSuppressionGroupImportConfig(groupID, first),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_suppression_group_import.import", "added_emails.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSuppressionGroupImportConfig(groupID string, emails ...string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_suppression_group_import" "import" {
+		group_id = %s
+		emails   = ["%s"]
+	}
+	`, groupID, strings.Join(emails, `", "`))
+}
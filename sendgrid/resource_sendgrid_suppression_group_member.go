@@ -0,0 +1,161 @@
+/*
+Provide a resource to manage an address of a suppression group, i.e. an address unsubscribed from the group only,
+unlike the global suppressions of sendgrid_suppression. Destroying the resource removes the address from the group,
+so the emails of the group are delivered to it again. An address removed from the group outside of Terraform
+is added again by the next apply.
+Example Usage
+```hcl
+resource "sendgrid_suppression_group_member" "newsletter" {
+	group_id = 12345
+	email    = "unsubscribed@example.org"
+}
+```
+Import
+An address of a suppression group can be imported using the group ID and the email, e.g.
+```hcl
+$ terraform import sendgrid_suppression_group_member.newsletter 12345/unsubscribed@example.org
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridSuppressionGroupMember() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridSuppressionGroupMemberCreate,
+		ReadContext:   resourceSendgridSuppressionGroupMemberRead,
+		DeleteContext: resourceSendgridSuppressionGroupMemberDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridSuppressionGroupMemberImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"group_id": {
+				Type:        schema.TypeInt,
+				Description: "The ID of the suppression group.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The address unsubscribed from the suppression group.",
+				Required:    true,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridSuppressionGroupMemberCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	groupID := strconv.Itoa(d.Get("group_id").(int))
+	email := d.Get("email").(string)
+
+	added, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.AddGroupSuppressions(groupID, []string{email})
+	})
+	if err != nil {
+		return errorToDiags("failed adding address to suppression group", err)
+	}
+
+	if !emailInEmails(added.([]string), email) {
+		return diag.FromErr(ErrSuppressionGroupMemberRejected)
+	}
+
+	d.SetId(groupID + "/" + email)
+
+	return resourceSendgridSuppressionGroupMemberRead(ctx, d, m)
+}
+
+func resourceSendgridSuppressionGroupMemberRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	email := d.Get("email").(string)
+
+	found, requestErr := c.SearchGroupSuppressions(strconv.Itoa(d.Get("group_id").(int)), []string{email})
+	if isNotFound(requestErr) {
+		// the suppression group was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed searching group suppressions", requestErr)}
+	}
+
+	if !emailInEmails(found, email) {
+		// the address was removed outside of Terraform, it has to be added again.
+		d.SetId("")
+	}
+
+	return nil
+}
+
+func resourceSendgridSuppressionGroupMemberDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteGroupSuppression(strconv.Itoa(d.Get("group_id").(int)), d.Get("email").(string))
+	})
+	if err != nil {
+		return errorToDiags("failed removing address from suppression group", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridSuppressionGroupMemberImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(d.Id(), "/", ImportSplitParts)
+	if len(parts) != ImportSplitParts || parts[1] == "" {
+		return nil, ErrInvalidSuppressionGroupMemberImportFormat
+	}
+
+	groupID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, ErrInvalidSuppressionGroupMemberImportFormat
+	}
+
+	//nolint:errcheck
+	d.Set("group_id", groupID)
+	//nolint:errcheck
+	d.Set("email", parts[1])
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// emailInEmails returns whether the email is among the emails, which Sendgrid may have lowercased.
+func emailInEmails(emails []string, email string) bool {
+	for _, e := range emails {
+		if strings.EqualFold(e, email) {
+			return true
+		}
+	}
+
+	return false
+}
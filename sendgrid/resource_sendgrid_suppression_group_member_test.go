@@ -0,0 +1,69 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSuppressionGroupMemberBasic(t *testing.T) {
+	groupID := os.Getenv("SENDGRID_TEST_SUPPRESSION_GROUP")
+	if groupID == "" {
+		t.Skip("SENDGRID_TEST_SUPPRESSION_GROUP must be set to the ID of a suppression group of the account")
+	}
+
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSuppressionGroupMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_suppression_group_member" "member" {
+					group_id = %s
+					email    = %q
+				}
+				`, groupID, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_suppression_group_member.member", "group_id", groupID),
+					resource.TestCheckResourceAttr("sendgrid_suppression_group_member.member", "email", email),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_suppression_group_member.member",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSuppressionGroupMemberDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_suppression_group_member" {
+			continue
+		}
+
+		found, requestErr := c.SearchGroupSuppressions(
+			rs.Primary.Attributes["group_id"], []string{rs.Primary.Attributes["email"]},
+		)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if len(found) > 0 {
+			return fmt.Errorf("address %s is still in the suppression group", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
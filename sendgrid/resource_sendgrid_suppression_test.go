@@ -0,0 +1,78 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridSuppressionGlobal(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridSuppressionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridSuppressionConfigGlobal(email),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSendgridSuppressionExists("sendgrid_suppression.suppression"),
+					resource.TestCheckResourceAttr("sendgrid_suppression.suppression", "email", email),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_suppression.suppression",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridSuppressionDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_suppression" {
+			continue
+		}
+
+		_, requestErr := c.ReadSuppression(rs.Primary.Attributes["kind"], rs.Primary.Attributes["email"])
+		if requestErr.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("suppression %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridSuppressionConfigGlobal(email string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_suppression" "suppression" {
+		kind  = "global"
+		email = %q
+	}
+	`, email)
+}
+
+func testAccCheckSendgridSuppressionExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No suppression ID set")
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,489 @@
+/*
+Provide a resource to manage a teammate, Sendgrid sends an invite to its email address on creation.
+While the invite isn't accepted, the `status` of the teammate is `pending`, and the invite can be sent again
+by changing `resend_invite`, e.g. to a timestamp. An invite which expired, or was deleted outside of Terraform,
+is sent again by the next apply. Once the invite is accepted, the teammate is `active` and gets its `username`,
+without any change planned.
+Instead of listing its scopes, a teammate can be given a `role`, a preset of scopes like the ones of the Sendgrid UI:
+accountant, developer, marketer or observer (read-only), extended with `additional_scopes`.
+The scopes of an admin are ignored, an admin has all of them.
+Example Usage
+```hcl
+resource "sendgrid_teammate" "example" {
+	email         = "teammate@example.org"
+	scopes        = ["mail.send", "stats.read"]
+	resend_invite = "2021-04-01"
+}
+
+resource "sendgrid_teammate" "developer" {
+	email             = "developer@example.org"
+	role              = "developer"
+	additional_scopes = ["stats.read"]
+}
+```
+Import
+A teammate, or its pending invite, can be imported by email, e.g.
+```hcl
+$ terraform import sendgrid_teammate.example teammate@example.org
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// teammateManagedScopes are the scopes Sendgrid grants to the teammates once they accepted their invite,
+// depending on their two-factor authentication, they aren't part of the configuration.
+var teammateManagedScopes = []string{"2fa_exempt", "2fa_required"}
+
+// teammateRoles are the presets of scopes a teammate can be given instead of listing its scopes,
+// like the ones the Sendgrid UI assigns.
+var teammateRoles = map[string][]string{
+	"accountant": {
+		"billing.read",
+		"billing.update",
+		"stats.global.read",
+		"stats.read",
+		"user.account.read",
+		"user.credits.read",
+		"user.profile.read",
+	},
+	"developer": {
+		"alerts.read",
+		"asm.groups.read",
+		"categories.read",
+		"mail.batch.read",
+		"mail.send",
+		"mail_settings.read",
+		"mail_settings.update",
+		"stats.read",
+		"suppression.read",
+		"templates.create",
+		"templates.delete",
+		"templates.read",
+		"templates.update",
+		"templates.versions.activate.update",
+		"templates.versions.create",
+		"templates.versions.delete",
+		"templates.versions.read",
+		"templates.versions.update",
+		"tracking_settings.read",
+		"tracking_settings.update",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.event.settings.update",
+		"user.webhooks.parse.settings.read",
+		"user.webhooks.parse.settings.update",
+	},
+	"marketer": {
+		"asm.groups.read",
+		"categories.read",
+		"categories.stats.read",
+		"design_library.create",
+		"design_library.delete",
+		"design_library.read",
+		"design_library.update",
+		"marketing.automation.read",
+		"marketing.read",
+		"marketing.send",
+		"stats.read",
+		"suppression.read",
+		"templates.read",
+	},
+	"observer": {
+		"alerts.read",
+		"asm.groups.read",
+		"categories.read",
+		"categories.stats.read",
+		"mail_settings.read",
+		"marketing.read",
+		"stats.global.read",
+		"stats.read",
+		"suppression.read",
+		"templates.read",
+		"tracking_settings.read",
+		"user.webhooks.event.settings.read",
+		"user.webhooks.parse.settings.read",
+	},
+}
+
+func resourceSendgridTeammate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTeammateCreate,
+		ReadContext:   resourceSendgridTeammateRead,
+		UpdateContext: resourceSendgridTeammateUpdate,
+		DeleteContext: resourceSendgridTeammateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		CustomizeDiff: resourceSendgridTeammateCustomizeDiff,
+
+		Schema: map[string]*schema.Schema{
+			"email": {
+				Type:        schema.TypeString,
+				Description: "The email address the invite is sent to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"is_admin": {
+				Type:        schema.TypeBool,
+				Description: "Whether the teammate is an admin, with all the scopes.",
+				Optional:    true,
+				Default:     false,
+			},
+			"scopes": {
+				Type:             schema.TypeSet,
+				Description:      "The scopes of the teammate, ignored for an admin, derived from the role if it's set.",
+				Optional:         true,
+				Computed:         true,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				ConflictsWith:    []string{"role"},
+				DiffSuppressFunc: suppressTeammateAdminScopes,
+			},
+			"role": {
+				Type: schema.TypeString,
+				Description: "The preset of scopes of the teammate: " +
+					"accountant, developer, marketer or observer (read-only).",
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"accountant",
+					"developer",
+					"marketer",
+					"observer",
+				}, false),
+				ConflictsWith: []string{"scopes"},
+			},
+			"additional_scopes": {
+				Type:         schema.TypeSet,
+				Description:  "The scopes of the teammate on top of the ones of its role.",
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				RequiredWith: []string{"role"},
+			},
+			"resend_invite": {
+				Type: schema.TypeString,
+				Description: "Any value, changing it sends the invite again " +
+					"if the teammate didn't accept it yet.",
+				Optional: true,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Description: "The username of the teammate, once the invite is accepted.",
+				Computed:    true,
+			},
+			"pending": {
+				Type:        schema.TypeBool,
+				Description: "Whether the invite wasn't accepted yet.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the teammate: pending until the invite is accepted, then active.",
+				Computed:    true,
+			},
+			"expiration_date": {
+				Type:        schema.TypeInt,
+				Description: "The unix timestamp the pending invite expires at, 0 once it's accepted.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+const (
+	teammateStatusPending = "pending"
+	teammateStatusActive  = "active"
+)
+
+// teammateScopes removes the scopes managed by Sendgrid from the scopes of a teammate.
+func teammateScopes(scopes []string) []string {
+	kept := make([]string, 0, len(scopes))
+
+	for _, scope := range scopes {
+		if !sendgrid.ScopeIn(teammateManagedScopes, scope) {
+			kept = append(kept, scope)
+		}
+	}
+
+	return kept
+}
+
+// suppressTeammateAdminScopes ignores the scopes of an admin, which has all of them.
+func suppressTeammateAdminScopes(_, _, _ string, d *schema.ResourceData) bool {
+	return d.Get("is_admin").(bool)
+}
+
+// resourceSendgridTeammateCustomizeDiff expands the role of the teammate to its scopes,
+// so that the scopes changed outside of Terraform are restored.
+func resourceSendgridTeammateCustomizeDiff(_ context.Context, d *schema.ResourceDiff, _ interface{}) error {
+	// the values known after apply can't be expanded yet.
+	for _, key := range []string{"role", "additional_scopes", "is_admin"} {
+		if !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	role := d.Get("role").(string)
+	if role == "" || d.Get("is_admin").(bool) {
+		return nil
+	}
+
+	scopes := schema.NewSet(schema.HashString, d.Get("additional_scopes").(*schema.Set).List())
+	for _, scope := range teammateRoles[role] {
+		scopes.Add(scope)
+	}
+
+	if scopes.Equal(d.Get("scopes").(*schema.Set)) {
+		return nil
+	}
+
+	return d.SetNew("scopes", scopes.List())
+}
+
+func inviteTeammate(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+) diag.Diagnostics {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.InviteTeammate(
+			d.Get("email").(string),
+			stringSetToSlice(d.Get("scopes").(*schema.Set)),
+			d.Get("is_admin").(bool),
+		)
+	})
+	if err != nil {
+		return errorToDiags("failed inviting teammate", err)
+	}
+
+	return nil
+}
+
+func deleteTeammateInvite(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	token string,
+) diag.Diagnostics {
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteTeammateInvite(token)
+	})
+	if err != nil {
+		return errorToDiags("failed deleting teammate invite", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridTeammateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	email := d.Get("email").(string)
+
+	// an expired invite is still pending, and prevents inviting the teammate again.
+	pending, requestErr := c.ReadPendingTeammate(email)
+	if requestErr.Err != nil && !isNotFound(requestErr) {
+		return diag.Diagnostics{requestErrorToDiag("failed reading pending teammate", requestErr)}
+	}
+
+	if requestErr.Err == nil && pending.Expired(time.Now()) {
+		if diags := deleteTeammateInvite(ctx, c, d, schema.TimeoutCreate, pending.Token); diags.HasError() {
+			return diags
+		}
+	}
+
+	if diags := inviteTeammate(ctx, c, d, schema.TimeoutCreate); diags.HasError() {
+		return diags
+	}
+
+	d.SetId(email)
+
+	// the invite may not be listed right after being sent, it would be read as deleted.
+	err := waitUntilReadable(ctx, func() (bool, error) {
+		_, requestErr := c.ReadPendingTeammate(email)
+		if isNotFound(requestErr) {
+			return false, nil
+		}
+
+		if requestErr.Err != nil {
+			return false, requestErr
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return errorToDiags("failed waiting for the teammate invite to be readable", err)
+	}
+
+	return resourceSendgridTeammateRead(ctx, d, m)
+}
+
+func resourceSendgridTeammateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	pending, requestErr := c.ReadPendingTeammate(d.Id())
+	if requestErr.Err != nil && !isNotFound(requestErr) {
+		return diag.Diagnostics{requestErrorToDiag("failed reading pending teammate", requestErr)}
+	}
+
+	if requestErr.Err == nil {
+		if pending.Expired(time.Now()) {
+			// the invite can't be accepted anymore, the next apply invites the teammate again.
+			d.SetId("")
+
+			return nil
+		}
+
+		setTeammateEmail(d, pending.Email)
+		//nolint:errcheck
+		d.Set("is_admin", pending.IsAdmin)
+		//nolint:errcheck
+		d.Set("pending", true)
+		//nolint:errcheck
+		d.Set("status", teammateStatusPending)
+		//nolint:errcheck
+		d.Set("expiration_date", pending.ExpirationDate)
+		//nolint:errcheck
+		d.Set("username", "")
+
+		if !pending.IsAdmin {
+			//nolint:errcheck
+			d.Set("scopes", pending.Scopes)
+		}
+
+		return nil
+	}
+
+	teammate, requestErr := c.ReadTeammateByEmail(d.Id())
+	if isNotFound(requestErr) {
+		// the invite was deleted, or the teammate removed, outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading teammate", requestErr)}
+	}
+
+	setTeammateEmail(d, teammate.Email)
+	//nolint:errcheck
+	d.Set("is_admin", teammate.IsAdmin)
+	//nolint:errcheck
+	d.Set("pending", false)
+	//nolint:errcheck
+	d.Set("status", teammateStatusActive)
+	//nolint:errcheck
+	d.Set("expiration_date", 0)
+	//nolint:errcheck
+	d.Set("username", teammate.Username)
+
+	if !teammate.IsAdmin {
+		//nolint:errcheck
+		d.Set("scopes", teammateScopes(teammate.Scopes))
+	}
+
+	return nil
+}
+
+// setTeammateEmail keeps the email configured when Sendgrid returns it with another case, e.g. once the invite
+// is accepted: the email forces a new teammate, which would be invited again.
+func setTeammateEmail(d *schema.ResourceData, email string) {
+	if strings.EqualFold(d.Get("email").(string), email) {
+		return
+	}
+
+	//nolint:errcheck
+	d.Set("email", email)
+}
+
+func resourceSendgridTeammateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	pending, requestErr := c.ReadPendingTeammate(d.Id())
+	if requestErr.Err != nil && !isNotFound(requestErr) {
+		return diag.Diagnostics{requestErrorToDiag("failed reading pending teammate", requestErr)}
+	}
+
+	// the invite was accepted since the last refresh.
+	if requestErr.Err != nil {
+		if d.HasChanges("scopes", "is_admin") {
+			_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+				return c.UpdateTeammate(
+					d.Get("username").(string),
+					stringSetToSlice(d.Get("scopes").(*schema.Set)),
+					d.Get("is_admin").(bool),
+				)
+			})
+			if err != nil {
+				return errorToDiags("failed updating teammate", err)
+			}
+		}
+
+		return resourceSendgridTeammateRead(ctx, d, m)
+	}
+
+	// a pending invite can't be edited, it's replaced by a new one, which sends it again too.
+	if d.HasChanges("scopes", "is_admin") {
+		if diags := deleteTeammateInvite(ctx, c, d, schema.TimeoutUpdate, pending.Token); diags.HasError() {
+			return diags
+		}
+
+		if diags := inviteTeammate(ctx, c, d, schema.TimeoutUpdate); diags.HasError() {
+			return diags
+		}
+
+		return resourceSendgridTeammateRead(ctx, d, m)
+	}
+
+	if d.HasChange("resend_invite") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.ResendTeammateInvite(pending.Token)
+		})
+		if err != nil {
+			return errorToDiags("failed resending teammate invite", err)
+		}
+	}
+
+	return resourceSendgridTeammateRead(ctx, d, m)
+}
+
+func resourceSendgridTeammateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	pending, requestErr := c.ReadPendingTeammate(d.Id())
+	if requestErr.Err == nil {
+		return deleteTeammateInvite(ctx, c, d, schema.TimeoutDelete, pending.Token)
+	}
+
+	if !isNotFound(requestErr) {
+		return diag.Diagnostics{requestErrorToDiag("failed reading pending teammate", requestErr)}
+	}
+
+	teammate, requestErr := c.ReadTeammateByEmail(d.Id())
+	if isNotFound(requestErr) {
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading teammate", requestErr)}
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteTeammate(teammate.Username)
+	})
+	if err != nil {
+		return errorToDiags("failed deleting teammate", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+/*
+Provide a resource to restrict a teammate to some subusers, with a permission type per subuser:
+admin (all the scopes of the subuser) or restricted (only the given scopes).
+Destroying the resource lifts the restriction of the teammate to subusers.
+Example Usage
+```hcl
+resource "sendgrid_teammate_subuser_access" "account_manager" {
+	teammate = "account-manager"
+
+	subuser {
+		subuser_id      = sendgrid_subuser.client_a.user_id
+		permission_type = "admin"
+	}
+
+	subuser {
+		subuser_id      = sendgrid_subuser.client_b.user_id
+		permission_type = "restricted"
+		scopes          = ["stats.read", "templates.read"]
+	}
+}
+```
+Import
+The subuser access of a teammate can be imported using the teammate's username, e.g.
+```hcl
+$ terraform import sendgrid_teammate_subuser_access.account_manager teammateName
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTeammateSubuserAccess() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTeammateSubuserAccessCreate,
+		ReadContext:   resourceSendgridTeammateSubuserAccessRead,
+		UpdateContext: resourceSendgridTeammateSubuserAccessUpdate,
+		DeleteContext: resourceSendgridTeammateSubuserAccessDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridTeammateSubuserAccessImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"teammate": {
+				Type:        schema.TypeString,
+				Description: "The username of the teammate.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"subuser": {
+				Type:        schema.TypeSet,
+				Description: "The subusers the teammate has access to.",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subuser_id": {
+							Type:        schema.TypeInt,
+							Description: "The ID of the subuser.",
+							Required:    true,
+						},
+						"permission_type": {
+							Type:         schema.TypeString,
+							Description:  "The permission type of the teammate on the subuser: admin or restricted.",
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"admin", "restricted"}, false),
+						},
+						"scopes": {
+							Type:        schema.TypeSet,
+							Description: "The scopes of the teammate on the subuser, for the restricted permission type.",
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func teammateSubuserAccessFromResourceData(d *schema.ResourceData) sendgrid.TeammateSubuserAccess {
+	access := sendgrid.TeammateSubuserAccess{HasRestrictedSubuserAccess: true}
+
+	for _, v := range d.Get("subuser").(*schema.Set).List() {
+		subuser := v.(map[string]interface{})
+		access.SubuserAccess = append(access.SubuserAccess, sendgrid.SubuserAccess{
+			ID:             subuser["subuser_id"].(int),
+			PermissionType: subuser["permission_type"].(string),
+			Scopes:         stringSetToSlice(subuser["scopes"].(*schema.Set)),
+		})
+	}
+
+	return access
+}
+
+func updateTeammateSubuserAccess(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	access sendgrid.TeammateSubuserAccess,
+) error {
+	teammate := d.Get("teammate").(string)
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTeammateSubuserAccess(teammate, access)
+	})
+
+	return err
+}
+
+func resourceSendgridTeammateSubuserAccessCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	if err := updateTeammateSubuserAccess(
+		ctx, c, d, schema.TimeoutCreate, teammateSubuserAccessFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed creating teammate subuser access", err)
+	}
+
+	d.SetId(d.Get("teammate").(string))
+
+	return resourceSendgridTeammateSubuserAccessRead(ctx, d, m)
+}
+
+func resourceSendgridTeammateSubuserAccessRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	access, requestErr := c.ReadTeammateSubuserAccess(d.Id())
+	if isNotFound(requestErr) {
+		// the teammate was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading teammate subuser access", requestErr)}
+	}
+
+	if !access.HasRestrictedSubuserAccess {
+		// the restriction was lifted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	subusers := make([]interface{}, 0, len(access.SubuserAccess))
+	for _, subuser := range access.SubuserAccess {
+		subusers = append(subusers, map[string]interface{}{
+			"subuser_id":      subuser.ID,
+			"permission_type": subuser.PermissionType,
+			"scopes":          subuser.Scopes,
+		})
+	}
+
+	//nolint:errcheck
+	d.Set("subuser", subusers)
+
+	return nil
+}
+
+func resourceSendgridTeammateSubuserAccessUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	if err := updateTeammateSubuserAccess(
+		ctx, c, d, schema.TimeoutUpdate, teammateSubuserAccessFromResourceData(d),
+	); err != nil {
+		return errorToDiags("failed updating teammate subuser access", err)
+	}
+
+	return resourceSendgridTeammateSubuserAccessRead(ctx, d, m)
+}
+
+func resourceSendgridTeammateSubuserAccessDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := parentClient(m).WithContext(ctx)
+
+	if err := updateTeammateSubuserAccess(ctx, c, d, schema.TimeoutDelete, sendgrid.TeammateSubuserAccess{}); err != nil {
+		return errorToDiags("failed deleting teammate subuser access", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridTeammateSubuserAccessImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	//nolint:errcheck
+	d.Set("teammate", d.Id())
+
+	return []*schema.ResourceData{d}, nil
+}
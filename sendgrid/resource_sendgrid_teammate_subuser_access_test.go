@@ -0,0 +1,88 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridTeammateSubuserAccessBasic(t *testing.T) {
+	teammate := os.Getenv("SENDGRID_TEST_TEAMMATE")
+	if teammate == "" {
+		t.Skip("SENDGRID_TEST_TEAMMATE must be set to the username of an existing teammate")
+	}
+
+	username := "terraform-subuser-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTeammateSubuserAccessDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTeammateSubuserAccessConfigBasic(teammate, username),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate_subuser_access.access", "subuser.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_teammate_subuser_access.access",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestSendgridTeammateSubuserAccessReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_teammate_subuser_access", "teammate", map[string]interface{}{
+		"teammate": "teammate",
+	})
+}
+
+func testAccCheckSendgridTeammateSubuserAccessDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_teammate_subuser_access" {
+			continue
+		}
+
+		access, requestErr := c.ReadTeammateSubuserAccess(rs.Primary.ID)
+		if requestErr.Err != nil {
+			return requestErr.Err
+		}
+
+		if access.HasRestrictedSubuserAccess {
+			return fmt.Errorf("teammate %s is still restricted to subusers", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridTeammateSubuserAccessConfigBasic(teammate, username string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_subuser" "subuser" {
+		username = %q
+		password = "Passw0rd!%s"
+		email    = "%s@example.org"
+		ips      = ["127.0.0.1"]
+	}
+
+	resource "sendgrid_teammate_subuser_access" "access" {
+		teammate = %q
+
+		subuser {
+			subuser_id      = sendgrid_subuser.subuser.user_id
+			permission_type = "restricted"
+			scopes          = ["stats.read"]
+		}
+	}
+	`, username, username, username, teammate)
+}
@@ -0,0 +1,209 @@
+package sendgrid_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+	provider "github.com/trois-six/terraform-provider-sendgrid/sendgrid"
+)
+
+func TestAccSendgridTeammateBasic(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTeammateConfigBasic(email, "stats.read", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "email", email),
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "pending", "true"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "username", ""),
+				),
+			},
+			{
+				// resending the invite must keep the pending teammate.
+				Config: testAccCheckSendgridTeammateConfigBasic(email, "stats.read", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "pending", "true"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "resend_invite", "2"),
+				),
+			},
+			{
+				// the scopes of a pending invite are changed by inviting the teammate again.
+				Config: testAccCheckSendgridTeammateConfigBasic(email, "mail.send", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "scopes.#", "1"),
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "pending", "true"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_teammate.teammate",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resend_invite"},
+			},
+		},
+	})
+}
+
+func TestAccSendgridTeammateRole(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTeammateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_teammate" "teammate" {
+					email             = %q
+					role              = "observer"
+					additional_scopes = ["mail.send"]
+				}
+				`, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "role", "observer"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_teammate.teammate", "scopes.*", "stats.read"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_teammate.teammate", "scopes.*", "mail.send"),
+				),
+			},
+			{
+				// the scopes of an admin are ignored.
+				Config: fmt.Sprintf(`
+				resource "sendgrid_teammate" "teammate" {
+					email    = %q
+					role     = "observer"
+					is_admin = true
+				}
+				`, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_teammate.teammate", "is_admin", "true"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_teammate" "teammate" {
+					email    = %q
+					role     = "observer"
+					is_admin = true
+				}
+				`, email),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestSendgridTeammatePendingToActive(t *testing.T) {
+	var accepted int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/teammates":
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprint(w, `{"email": "teammate@example.org", "scopes": ["stats.read"], "is_admin": false}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/teammates/pending":
+			if atomic.LoadInt32(&accepted) == 1 {
+				fmt.Fprint(w, `{"result": []}`)
+
+				return
+			}
+
+			fmt.Fprint(w, `{"result": [{"email": "teammate@example.org", "scopes": ["stats.read"], `+
+				`"is_admin": false, "token": "token", "expiration_date": 4102444800}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/teammates":
+			// Sendgrid returns the email of the teammate who accepted the invite with another case.
+			fmt.Fprint(w, `{"result": [{"username": "teammate", "email": "Teammate@example.org"}]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/teammates/teammate":
+			fmt.Fprint(w, `{"username": "teammate", "email": "Teammate@example.org", "is_admin": false, `+
+				`"scopes": ["stats.read", "2fa_exempt"]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	c := sendgrid.NewClient("key", server.URL, "")
+
+	raw := map[string]interface{}{
+		"email":  "teammate@example.org",
+		"scopes": []interface{}{"stats.read"},
+	}
+
+	r := provider.Provider().ResourcesMap["sendgrid_teammate"]
+	d := schema.TestResourceDataRaw(t, r.Schema, raw)
+
+	if diags := r.CreateContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed creating teammate: %v", diags)
+	}
+
+	if d.Get("status").(string) != "pending" {
+		t.Fatalf("expected the teammate to be pending, got %q", d.Get("status"))
+	}
+
+	atomic.StoreInt32(&accepted, 1)
+
+	if diags := r.ReadContext(context.Background(), d, c); diags.HasError() {
+		t.Fatalf("failed reading teammate: %v", diags)
+	}
+
+	if d.Id() == "" || d.Get("status").(string) != "active" || d.Get("username").(string) != "teammate" {
+		t.Fatalf("expected the teammate to be active, got status %q", d.Get("status"))
+	}
+
+	// the accepted invite doesn't plan any change, let alone a replacement.
+	diff, err := r.Diff(context.Background(), d.State(), terraform.NewResourceConfigRaw(raw), c)
+	if err != nil {
+		t.Fatalf("failed planning: %v", err)
+	}
+
+	if diff != nil && (diff.RequiresNew() || !diff.Empty()) {
+		t.Fatalf("expected no change, got: %v", diff)
+	}
+}
+
+func testAccCheckSendgridTeammateDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_teammate" {
+			continue
+		}
+
+		_, requestErr := c.ReadPendingTeammate(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("teammate invite %s still exists", rs.Primary.ID)
+		}
+
+		_, requestErr = c.ReadTeammateByEmail(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("teammate %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridTeammateConfigBasic(email, scope, resend string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_teammate" "teammate" {
+		email         = %q
+		scopes        = [%q]
+		resend_invite = %q
+	}
+	`, email, scope, resend)
+}
@@ -55,15 +55,15 @@ func resourceSendgridTemplate() *schema.Resource {
 	}
 }
 
-func resourceSendgridTemplateCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func resourceSendgridTemplateCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	name := d.Get("name").(string)
 	generation := d.Get("generation").(string)
 
 	template, err := c.CreateTemplate(name, generation)
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed creating template", err)
 	}
 
 	//nolint:errcheck
@@ -73,12 +73,19 @@ func resourceSendgridTemplateCreate(_ context.Context, d *schema.ResourceData, m
 	return nil
 }
 
-func resourceSendgridTemplateRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func resourceSendgridTemplateRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	template, err := c.ReadTemplate(d.Id())
+	if isNotFound(err) {
+		// the template was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed reading template", err)
 	}
 
 	//nolint:errcheck
@@ -92,24 +99,24 @@ func resourceSendgridTemplateRead(_ context.Context, d *schema.ResourceData, m i
 }
 
 func resourceSendgridTemplateUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	if d.HasChange("name") {
 		_, err := c.UpdateTemplate(d.Id(), d.Get("name").(string))
 		if err != nil {
-			return diag.FromErr(err)
+			return errorToDiags("failed updating template", err)
 		}
 	}
 
 	return resourceSendgridTemplateRead(ctx, d, m)
 }
 
-func resourceSendgridTemplateDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func resourceSendgridTemplateDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	_, err := c.DeleteTemplate(d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed deleting template", err)
 	}
 
 	return nil
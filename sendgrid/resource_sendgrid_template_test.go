@@ -29,6 +29,13 @@ func TestAccSendgridTemplateBasic(t *testing.T) {
 	})
 }
 
+func TestSendgridTemplateReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_template", "d-1", map[string]interface{}{
+		"name":       "template",
+		"generation": "dynamic",
+	})
+}
+
 func testAccCheckSendgridTemplateDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
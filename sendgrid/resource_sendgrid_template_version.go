@@ -15,7 +15,25 @@ resource "sendgrid_template_version" "template_version" {
 	generate_plain_content = true
 	subject                = "subject"
 }
+
+resource "sendgrid_template_version" "from_files" {
+	name                   = "my-template-version-from-files"
+	template_id            = sendgrid_template.template.id
+	html_content_file      = "${path.module}/templates/welcome.html"
+	plain_content_file     = "${path.module}/templates/welcome.txt"
+	generate_plain_content = false
+	subject                = "subject"
+}
+
+resource "sendgrid_template_version" "from_design" {
+	name           = "my-template-version-from-design"
+	template_id    = sendgrid_template.template.id
+	from_design_id = sendgrid_design.newsletter.id
+}
 ```
+A version can be created from a design of the design library with `from_design_id`: the HTML and plain contents
+and the subject of the design are copied when the version is created, unless they're set. The version is then
+managed independently of the design: the changes of the design aren't copied again, replace the version to do so.
 Import
 A template version can be imported, e.g.
 ```hcl
@@ -26,7 +44,14 @@ package sendgrid
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
 	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -48,6 +73,7 @@ func resourceSendgridTemplateVersion() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			StateContext: resourceSendgridTemplateVersionImport,
 		},
+		CustomizeDiff: resourceSendgridTemplateVersionCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"template_id": {
@@ -78,14 +104,35 @@ func resourceSendgridTemplateVersion() *schema.Resource {
 				Required:    true,
 			},
 			"html_content": {
-				Type:        schema.TypeString,
-				Description: "The HTML content of the version, maximum of 1048576 bytes allowed.",
-				Optional:    true,
+				Type:          schema.TypeString,
+				Description:   "The HTML content of the version, maximum of 1048576 bytes allowed.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"html_content_file"},
+			},
+			"html_content_file": {
+				Type:          schema.TypeString,
+				Description:   "The path of a file to read the HTML content of the version from.",
+				Optional:      true,
+				ConflictsWith: []string{"html_content"},
 			},
 			"plain_content": {
-				Type:        schema.TypeString,
-				Description: "Text/plain content of the transactional template version, maximum of 1048576 bytes allowed.",
-				Computed:    true,
+				Type:          schema.TypeString,
+				Description:   "Text/plain content of the transactional template version, maximum of 1048576 bytes allowed.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"plain_content_file"},
+				// Sendgrid derives the plain content from the HTML content when generate_plain_content is true.
+				DiffSuppressFunc: func(_, _, _ string, d *schema.ResourceData) bool {
+					return d.Get("generate_plain_content").(bool)
+				},
+			},
+			"plain_content_file": {
+				Type: schema.TypeString,
+				Description: "The path of a file to read the text/plain content of the version from, " +
+					"requires generate_plain_content to be false.",
+				Optional:      true,
+				ConflictsWith: []string{"plain_content"},
 			},
 			"generate_plain_content": {
 				Type: schema.TypeBool,
@@ -95,9 +142,17 @@ func resourceSendgridTemplateVersion() *schema.Resource {
 				Default:  true,
 			},
 			"subject": {
-				Type:        schema.TypeString,
-				Description: "Subject of the new transactional template version, max length: 255.",
-				Required:    true,
+				Type: schema.TypeString,
+				Description: "Subject of the new transactional template version, max length: 255. " +
+					"Required unless the version is created from a design.",
+				Optional: true,
+				Computed: true,
+			},
+			"from_design_id": {
+				Type: schema.TypeString,
+				Description: "The ID of a design to copy the contents and the subject of, when the version " +
+					"is created. The version isn't updated when the design changes.",
+				Optional: true,
 			},
 			"editor": {
 				Type:         schema.TypeString,
@@ -106,6 +161,14 @@ func resourceSendgridTemplateVersion() *schema.Resource {
 				Default:      "code",
 				ValidateFunc: validation.StringInSlice([]string{"code", "design"}, false),
 			},
+			"variables": {
+				Type: schema.TypeSet,
+				Description: "The variables the Handlebars expressions of the subject and the contents reference, " +
+					"e.g. to check the dynamic_template_data sent with the template. The variables used in " +
+					"each and with blocks are prefixed with the path of the block, e.g. items.name.",
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
 			"test_data": {
 				Type: schema.TypeString,
 				Description: "For dynamic templates only, " +
@@ -116,36 +179,391 @@ func resourceSendgridTemplateVersion() *schema.Resource {
 	}
 }
 
-func resourceSendgridTemplateVersionCreate(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+// contentHash hashes the content of a template version, ignoring the line endings and the trailing newlines
+// that editors add to files, so that they don't produce a diff against the content stored by Sendgrid.
+func contentHash(content string) string {
+	content = strings.ReplaceAll(content, "\r\n", "\n")
+	hash := sha256.Sum256([]byte(strings.TrimRight(content, "\n")))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// setContentFromFile plans the content read from the file at the path of fileKey as the new value of key,
+// if it differs from the current content.
+func setContentFromFile(d *schema.ResourceDiff, key, fileKey string) error {
+	path := d.Get(fileKey).(string)
+	if path == "" || !d.NewValueKnown(fileKey) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed reading %s: %w", fileKey, err)
+	}
+
+	old, _ := d.GetChange(key)
+	if contentHash(old.(string)) == contentHash(string(content)) {
+		return nil
+	}
+
+	return d.SetNew(key, string(content))
+}
+
+func resourceSendgridTemplateVersionCustomizeDiff(
+	_ context.Context,
+	d *schema.ResourceDiff,
+	_ interface{},
+) error {
+	if d.Get("plain_content_file").(string) != "" && d.Get("generate_plain_content").(bool) {
+		return ErrPlainContentGenerated
+	}
+
+	// the subject of a version created from a design is copied from it.
+	if d.NewValueKnown("subject") && d.NewValueKnown("from_design_id") &&
+		d.Get("subject").(string) == "" && d.Get("from_design_id").(string) == "" {
+		return ErrTemplateVersionSubjectRequired
+	}
+
+	if err := setContentFromFile(d, "html_content", "html_content_file"); err != nil {
+		return err
+	}
+
+	if err := setContentFromFile(d, "plain_content", "plain_content_file"); err != nil {
+		return err
+	}
+
+	// the variables are read from the contents stored by Sendgrid, e.g. the generated plain content.
+	if d.HasChange("subject") || d.HasChange("html_content") || d.HasChange("plain_content") {
+		return d.SetNewComputed("variables")
+	}
+
+	return nil
+}
+
+// handlebarsExpression matches the Handlebars expressions, e.g. {{name}}, {{{html}}} or {{~#if user.vip~}}.
+var handlebarsExpression = regexp.MustCompile(`\{\{\{?~?\s*(.*?)\s*~?\}?\}\}`)
+
+// handlebarsBlockParam matches the block parameter naming the item of an each block, e.g. as |item|.
+var handlebarsBlockParam = regexp.MustCompile(`\bas\s+\|\s*([^\s|]+)`)
+
+// handlebarsScope is an each or with block, its path and the name of its item, if any.
+type handlebarsScope struct {
+	path  string
+	alias string
+}
+
+// handlebarsVariables returns the sorted variables referenced by the Handlebars expressions of the contents.
+// The helpers, e.g. {{formatDate date "YYYY"}} or {{#if (equals a b)}}, and the literals aren't variables,
+// their arguments are. The variables used in the each and with blocks are prefixed with the path of the block.
+func handlebarsVariables(contents ...string) []string {
+	found := map[string]bool{}
+
+	for _, content := range contents {
+		// the each and with blocks enclosing the expression.
+		var scopes []handlebarsScope
+
+		for _, match := range handlebarsExpression.FindAllStringSubmatch(content, -1) {
+			expression := match[1]
+			if expression == "" || strings.HasPrefix(expression, "!") {
+				// a comment.
+				continue
+			}
+
+			if strings.HasPrefix(expression, "/") {
+				if block := strings.TrimSpace(expression[1:]); (block == "each" || block == "with") && len(scopes) > 0 {
+					scopes = scopes[:len(scopes)-1]
+				}
+
+				continue
+			}
+
+			block := ""
+			if expression[0] == '#' || expression[0] == '^' {
+				// the name of the block is a helper, e.g. {{#if x}}, or a variable, e.g. {{^items}}.
+				block = strings.Fields(expression[1:] + " ")[0]
+				expression = expression[1:]
+			}
+
+			var blockPath string
+
+			for i, arg := range handlebarsArguments(expression) {
+				path, ok := handlebarsPath(arg, scopes)
+				if !ok {
+					continue
+				}
+
+				found[path] = true
+
+				if i == 0 {
+					blockPath = path
+				}
+			}
+
+			if block == "each" || block == "with" {
+				scope := handlebarsScope{path: blockPath}
+				if param := handlebarsBlockParam.FindStringSubmatch(expression); param != nil {
+					scope.alias = param[1]
+				}
+
+				scopes = append(scopes, scope)
+			}
+		}
+	}
+
+	variables := make([]string, 0, len(found))
+	for variable := range found {
+		variables = append(variables, variable)
+	}
+
+	sort.Strings(variables)
+
+	return variables
+}
+
+// handlebarsArguments returns the arguments of an expression: the tokens which aren't the name of a helper,
+// i.e. the first token of an expression with arguments, or of a (subexpression). Block parameters are skipped.
+func handlebarsArguments(expression string) []string {
+	tokens := handlebarsTokens(expression)
+
+	// {{else}} and {{else if x}} continue a block.
+	if len(tokens) > 0 && tokens[0] == "else" {
+		tokens = tokens[1:]
+	}
+
+	// the expression calls a helper if it has several top level tokens, a subexpression counting for one.
+	topLevel, depth := 0, 0
+
+	for _, token := range tokens {
+		switch token {
+		case "(":
+			if depth == 0 {
+				topLevel++
+			}
+
+			depth++
+		case ")":
+			depth--
+		default:
+			if depth == 0 {
+				topLevel++
+			}
+		}
+	}
+
+	var args []string
+
+	helperNext := topLevel > 1
+
+	for _, token := range tokens {
+		switch {
+		case token == "(":
+			helperNext = true
+		case token == ")":
+		case helperNext:
+			helperNext = false
+		case token == "as" || strings.HasPrefix(token, "|"):
+			return args
+		default:
+			args = append(args, token)
+		}
+	}
+
+	return args
+}
+
+// handlebarsTokens splits an expression on spaces and parentheses, which are tokens too, except within quotes.
+func handlebarsTokens(expression string) []string {
+	var (
+		tokens []string
+		token  strings.Builder
+		quote  rune
+	)
+
+	endToken := func() {
+		if token.Len() > 0 {
+			tokens = append(tokens, token.String())
+			token.Reset()
+		}
+	}
+
+	for _, r := range expression {
+		switch {
+		case quote != 0:
+			token.WriteRune(r)
+
+			if r == quote {
+				quote = 0
+			}
+		case r == '"' || r == '\'':
+			quote = r
+
+			token.WriteRune(r)
+		case r == '(' || r == ')':
+			endToken()
+
+			tokens = append(tokens, string(r))
+		case r == ' ' || r == '\t' || r == '\n' || r == '\r':
+			endToken()
+		default:
+			token.WriteRune(r)
+		}
+	}
+
+	endToken()
+
+	return tokens
+}
+
+// handlebarsPath resolves an argument into the path of a variable, from the root of the data,
+// or returns false if it's a literal, or a data variable like @index.
+func handlebarsPath(arg string, scopes []handlebarsScope) (string, bool) {
+	if arg == "" || arg[0] == '"' || arg[0] == '\'' {
+		return "", false
+	}
+
+	if i := strings.Index(arg, "="); i >= 0 {
+		// a hash argument, e.g. key=value.
+		return handlebarsPath(arg[i+1:], scopes)
+	}
+
+	if arg == "true" || arg == "false" || arg == "null" || arg == "undefined" {
+		return "", false
+	}
+
+	if _, err := strconv.ParseFloat(arg, 64); err == nil {
+		return "", false
+	}
+
+	if strings.HasPrefix(arg, "@root.") {
+		return strings.TrimPrefix(arg, "@root."), true
+	}
+
+	if strings.HasPrefix(arg, "@") {
+		return "", false
+	}
+
+	// a block parameter is the item of its block, wherever it's used within the block.
+	for i := len(scopes) - 1; i >= 0; i-- {
+		alias := scopes[i].alias
+		if alias != "" && (arg == alias || strings.HasPrefix(arg, alias+".")) {
+			return joinHandlebarsPath(scopes[i].path, strings.TrimPrefix(arg[len(alias):], "."))
+		}
+	}
+
+	depth := len(scopes)
+
+	for strings.HasPrefix(arg, "../") {
+		arg = strings.TrimPrefix(arg, "../")
+		depth--
+	}
+
+	arg = strings.TrimPrefix(strings.TrimPrefix(arg, "this"), ".")
+	arg = strings.TrimPrefix(arg, "/")
+
+	scope := ""
+	if depth > 0 {
+		scope = scopes[depth-1].path
+	}
+
+	return joinHandlebarsPath(scope, arg)
+}
+
+func joinHandlebarsPath(scope, path string) (string, bool) {
+	switch {
+	case scope == "" && path == "":
+		return "", false
+	case scope == "":
+		return path, true
+	case path == "":
+		return scope, true
+	default:
+		return scope + "." + path, true
+	}
+}
+
+// copyDesignToTemplateVersion copies the contents and the subject of a design to a version being created,
+// except the ones which are set.
+func copyDesignToTemplateVersion(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	designID string,
+	version *sendgrid.TemplateVersion,
+) diag.Diagnostics {
+	designStruct, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.ReadDesign(designID)
+	})
+	if err != nil {
+		return errorToDiags("failed reading design "+designID, err)
+	}
+
+	design := designStruct.(*sendgrid.Design)
 
-	templateVersion, err := c.CreateTemplateVersion(sendgrid.TemplateVersion{
+	if version.HTMLContent == "" {
+		version.HTMLContent = design.HTMLContent
+	}
+
+	// the plain content is generated from the HTML content otherwise.
+	if version.PlainContent == "" && !version.GeneratePlainContent {
+		version.PlainContent = design.PlainContent
+	}
+
+	if version.Subject == "" {
+		version.Subject = design.Subject
+	}
+
+	return nil
+}
+
+func resourceSendgridTemplateVersionCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	version := sendgrid.TemplateVersion{
 		TemplateID:           d.Get("template_id").(string),
 		Active:               d.Get("active").(int),
 		Name:                 d.Get("name").(string),
 		HTMLContent:          d.Get("html_content").(string),
+		PlainContent:         d.Get("plain_content").(string),
 		GeneratePlainContent: d.Get("generate_plain_content").(bool),
 		Subject:              d.Get("subject").(string),
 		Editor:               d.Get("editor").(string),
 		TestData:             d.Get("test_data").(string),
-	})
+	}
+
+	if designID := d.Get("from_design_id").(string); designID != "" {
+		if diags := copyDesignToTemplateVersion(ctx, c, d, schema.TimeoutCreate, designID, &version); diags.HasError() {
+			return diags
+		}
+	}
+
+	templateVersion, err := c.CreateTemplateVersion(version)
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed creating template version", err)
 	}
 
-	//nolint:errcheck
-	d.Set("updated_at", templateVersion.UpdatedAt)
 	d.SetId(templateVersion.ID)
 
-	return nil
+	return resourceSendgridTemplateVersionRead(ctx, d, m)
 }
 
-func resourceSendgridTemplateVersionRead(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func resourceSendgridTemplateVersionRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	templateVersion, err := c.ReadTemplateVersion(d.Get("template_id").(string), d.Id())
+	if isNotFound(err) {
+		// the template version, or its template, was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed reading template version", err)
 	}
 
 	//nolint:errcheck
@@ -168,6 +586,10 @@ func resourceSendgridTemplateVersionRead(_ context.Context, d *schema.ResourceDa
 	d.Set("editor", templateVersion.Editor)
 	//nolint:errcheck
 	d.Set("test_data", templateVersion.TestData)
+	//nolint:errcheck
+	d.Set("variables", handlebarsVariables(
+		templateVersion.Subject, templateVersion.HTMLContent, templateVersion.PlainContent,
+	))
 
 	return nil
 }
@@ -177,13 +599,18 @@ func resourceSendgridTemplateVersionUpdate(
 	d *schema.ResourceData,
 	m interface{},
 ) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	oldGeneratePlainContent, newGeneratePlainContent := d.GetChange("generate_plain_content")
 
 	baseTemplateVersion := sendgrid.TemplateVersion{
-		ID:         d.Id(),
-		TemplateID: d.Get("template_id").(string),
+		ID:                   d.Id(),
+		TemplateID:           d.Get("template_id").(string),
+		GeneratePlainContent: oldGeneratePlainContent.(bool),
 	}
 	templateVersion := baseTemplateVersion
+	// always sent, otherwise Sendgrid regenerates the plain content.
+	templateVersion.GeneratePlainContent = newGeneratePlainContent.(bool)
 
 	if d.HasChange("active") {
 		templateVersion.Active = d.Get("active").(int)
@@ -197,8 +624,8 @@ func resourceSendgridTemplateVersionUpdate(
 		templateVersion.HTMLContent = d.Get("html_content").(string)
 	}
 
-	if d.HasChange("generate_plain_content") {
-		templateVersion.GeneratePlainContent = d.Get("generate_plain_content").(bool)
+	if d.HasChange("plain_content") {
+		templateVersion.PlainContent = d.Get("plain_content").(string)
 	}
 
 	if d.HasChange("subject") {
@@ -213,23 +640,29 @@ func resourceSendgridTemplateVersionUpdate(
 		templateVersion.TestData = d.Get("test_data").(string)
 	}
 
+	// from_design_id is only used when the version is created, the design isn't copied again.
+
 	if reflect.DeepEqual(baseTemplateVersion, templateVersion) {
 		return nil
 	}
 
 	if _, err := c.UpdateTemplateVersion(templateVersion); err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed updating template version", err)
 	}
 
 	return resourceSendgridTemplateVersionRead(ctx, d, m)
 }
 
-func resourceSendgridTemplateVersionDelete(_ context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	c := m.(*sendgrid.Client)
+func resourceSendgridTemplateVersionDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
 
 	_, err := c.DeleteTemplateVersion(d.Get("template_id").(string), d.Id())
 	if err != nil {
-		return diag.FromErr(err)
+		return errorToDiags("failed deleting template version", err)
 	}
 
 	return nil
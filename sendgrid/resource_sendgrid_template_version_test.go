@@ -2,6 +2,9 @@ package sendgrid_test
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -34,6 +37,191 @@ func TestAccSendgridTemplateVersionBasic(t *testing.T) {
 	})
 }
 
+func TestAccSendgridTemplateVersionGeneratedPlainContent(t *testing.T) {
+	templateName := "terraform-template-" + acctest.RandString(10)
+	templateVersionName := "terraform-template-version-" + acctest.RandString(10)
+
+	config := fmt.Sprintf(`
+	resource "sendgrid_template" "template" {
+		name       = %q
+		generation = "dynamic"
+	}
+
+	resource "sendgrid_template_version" "generated" {
+		template_id            = sendgrid_template.template.id
+		name                   = %q
+		subject                = "{{subject}}"
+		editor                 = "design"
+		html_content           = "<p>Hello {{name}}</p>"
+		plain_content          = "ignored, Sendgrid generates it"
+		generate_plain_content = true
+	}
+	`, templateName, templateVersionName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.generated", "subject", "{{subject}}"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.generated", "editor", "design"),
+					resource.TestCheckResourceAttrSet("sendgrid_template_version.generated", "thumbnail_url"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.generated", "variables.#", "2"),
+				),
+			},
+			{
+				// the generated plain content must not drift from the configuration.
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccSendgridTemplateVersionVariables(t *testing.T) {
+	templateName := "terraform-template-" + acctest.RandString(10)
+	templateVersionName := "terraform-template-version-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "sendgrid_template" "template" {
+					name       = %q
+					generation = "dynamic"
+				}
+
+				resource "sendgrid_template_version" "variables" {
+					template_id            = sendgrid_template.template.id
+					name                   = %q
+					subject                = "Your order {{order.id}}"
+					generate_plain_content = true
+					html_content           = <<-EOT
+					{{! the items of the order }}
+					{{#each order.items}}<p>{{this.name}}: {{formatNumber price "0.00"}} {{@root.currency}}</p>{{/each}}
+					{{#if (equals customer.plan "pro")}}<p>Thanks {{customer.name}}</p>{{else}}<p>{{@index}}</p>{{/if}}
+					EOT
+				}
+				`, templateName, templateVersionName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.variables", "variables.#", "7"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_template_version.variables", "variables.*", "order.id"),
+					resource.TestCheckTypeSetElemAttr("sendgrid_template_version.variables", "variables.*", "order.items"),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_template_version.variables", "variables.*", "order.items.name",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_template_version.variables", "variables.*", "order.items.price",
+					),
+					resource.TestCheckTypeSetElemAttr("sendgrid_template_version.variables", "variables.*", "currency"),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_template_version.variables", "variables.*", "customer.plan",
+					),
+					resource.TestCheckTypeSetElemAttr(
+						"sendgrid_template_version.variables", "variables.*", "customer.name",
+					),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridTemplateVersionFromFiles(t *testing.T) {
+	templateName := "terraform-template-" + acctest.RandString(10)
+	templateVersionName := "terraform-template-version-" + acctest.RandString(10)
+
+	dir, err := ioutil.TempDir("", "terraform-template-version")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	htmlFile := filepath.Join(dir, "template.html")
+	plainFile := filepath.Join(dir, "template.txt")
+
+	writeFile := func(path, content string) func() {
+		return func() {
+			if writeErr := ioutil.WriteFile(path, []byte(content), 0o600); writeErr != nil {
+				t.Fatal(writeErr)
+			}
+		}
+	}
+
+	config := testAccCheckSendgridTemplateVersionConfigFromFiles(templateName, templateVersionName, htmlFile, plainFile)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					writeFile(htmlFile, "<p>Hello</p>\r\n\n")()
+					writeFile(plainFile, "Hello\n")()
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.files", "plain_content", "Hello\n"),
+				),
+			},
+			{
+				// the trailing newlines added by editors must not produce a diff.
+				PreConfig: writeFile(htmlFile, "<p>Hello</p>"),
+				Config:    config,
+				PlanOnly:  true,
+			},
+			{
+				PreConfig: writeFile(htmlFile, "<p>Hello again</p>\n"),
+				Config:    config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.files", "html_content", "<p>Hello again</p>\n"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccSendgridTemplateVersionFromDesign(t *testing.T) {
+	name := "terraform-template-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridTemplateVersionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTemplateVersionConfigFromDesign(name, "<p>Hello</p>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_template_version.design", "html_content", "<p>Hello</p>"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.design", "subject", "Design subject"),
+				),
+			},
+			{
+				// the version isn't synced with the design once created.
+				Config: testAccCheckSendgridTemplateVersionConfigFromDesign(name, "<p>Hello again</p>"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_design.design", "html_content", "<p>Hello again</p>"),
+					resource.TestCheckResourceAttr("sendgrid_template_version.design", "html_content", "<p>Hello</p>"),
+				),
+			},
+		},
+	})
+}
+
+func TestSendgridTemplateVersionReadNotFound(t *testing.T) {
+	testResourceReadNotFound(t, "sendgrid_template_version", "1", map[string]interface{}{
+		"template_id": "d-1",
+		"name":        "version",
+		"subject":     "subject",
+	})
+}
+
 func testAccCheckSendgridTemplateVersionDestroy(s *terraform.State) error {
 	c := testAccProvider.Meta().(*sendgrid.Client)
 
@@ -70,6 +258,47 @@ func testAccCheckSendgridTemplateVersionConfigBasic(
 	`, templateName, templateVersionName, subject)
 }
 
+func testAccCheckSendgridTemplateVersionConfigFromFiles(
+	templateName, templateVersionName, htmlFile, plainFile string,
+) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_template" "template" {
+		name       = %q
+		generation = "dynamic"
+	}
+
+	resource "sendgrid_template_version" "files" {
+		template_id            = sendgrid_template.template.id
+		name                   = %q
+		subject                = "subject"
+		html_content_file      = %q
+		plain_content_file     = %q
+		generate_plain_content = false
+	}
+	`, templateName, templateVersionName, htmlFile, plainFile)
+}
+
+func testAccCheckSendgridTemplateVersionConfigFromDesign(name, html string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_design" "design" {
+		name         = %q
+		subject      = "Design subject"
+		html_content = %q
+	}
+
+	resource "sendgrid_template" "template" {
+		name       = %q
+		generation = "dynamic"
+	}
+
+	resource "sendgrid_template_version" "design" {
+		template_id    = sendgrid_template.template.id
+		name           = %q
+		from_design_id = sendgrid_design.design.id
+	}
+	`, name, html, name, name)
+}
+
 func testAccCheckSendgridTemplateVersionExists(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,166 @@
+/*
+Provide a resource sending a single email with a dynamic template when created, e.g. to check that the sending
+pipeline works end-to-end once the templates and the senders are provisioned.
+This resource has a side effect: a real email is sent on every creation, i.e. whenever any of its arguments,
+the `triggers` included, change. The email is sent with a new batch ID, kept in the state.
+Destroying the resource only removes it from the state, a sent email can't be recalled.
+Example Usage
+```hcl
+resource "sendgrid_test_send" "smoke" {
+	from_email  = "noreply@example.org"
+	to          = ["smoke-tests@example.org"]
+	template_id = sendgrid_template.template.id
+
+	dynamic_template_data = jsonencode({
+		name = "Terraform"
+	})
+
+	triggers = {
+		template_version = sendgrid_template_version.template_version.id
+	}
+}
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTestSend() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTestSendCreate,
+		ReadContext:   resourceSendgridTestSendRead,
+		DeleteContext: resourceSendgridTestSendDelete,
+
+		Schema: map[string]*schema.Schema{
+			"from_email": {
+				Type:        schema.TypeString,
+				Description: "The address the email is sent from, it must be a verified sender.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"from_name": {
+				Type:        schema.TypeString,
+				Description: "The name the email is sent from.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"to": {
+				Type:        schema.TypeSet,
+				Description: "The addresses the email is sent to.",
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"template_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the dynamic template of the email.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dynamic_template_data": {
+				Type:         schema.TypeString,
+				Description:  "The data the dynamic template is rendered with, as a JSON object.",
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"sandbox_mode": {
+				Type:        schema.TypeBool,
+				Description: "Only validate the email, without delivering it.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values which send the email again when they change.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"batch_id": {
+				Type:        schema.TypeString,
+				Description: "The batch ID the email was sent with.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceSendgridTestSendCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	mail := sendgrid.Mail{
+		From: sendgrid.MailAddress{
+			Email: d.Get("from_email").(string),
+			Name:  d.Get("from_name").(string),
+		},
+		TemplateID:  d.Get("template_id").(string),
+		SandboxMode: d.Get("sandbox_mode").(bool),
+	}
+
+	for _, to := range stringSetToSlice(d.Get("to").(*schema.Set)) {
+		mail.To = append(mail.To, sendgrid.MailAddress{Email: to})
+	}
+
+	if data := d.Get("dynamic_template_data").(string); data != "" {
+		// the JSON was already checked by validation.StringIsJSON.
+		//nolint:errcheck
+		json.Unmarshal([]byte(data), &mail.DynamicTemplateData)
+	}
+
+	batchID, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateBatchID()
+	})
+	if err != nil {
+		return errorToDiags("failed creating batch ID of test send", err)
+	}
+
+	mail.BatchID = batchID.(string)
+
+	_, err = c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.SendMail(mail)
+	})
+	if err != nil {
+		return errorToDiags("failed sending test email", err)
+	}
+
+	d.SetId(mail.BatchID)
+	//nolint:errcheck
+	d.Set("batch_id", mail.BatchID)
+
+	return resourceSendgridTestSendRead(ctx, d, m)
+}
+
+func resourceSendgridTestSendRead(
+	_ context.Context,
+	_ *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// an email is sent once, there is nothing to read back.
+	return nil
+}
+
+func resourceSendgridTestSendDelete(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) diag.Diagnostics {
+	// a sent email can't be recalled, it is only removed from the state.
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridTestSendBasic(t *testing.T) {
+	sender := os.Getenv("SENDGRID_TEST_SENDER")
+	if sender == "" {
+		t.Skip("SENDGRID_TEST_SENDER must be set to the email address of a verified sender")
+	}
+
+	name := "terraform-template-" + acctest.RandString(10)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridTestSendConfigBasic(name, sender),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sendgrid_test_send.smoke", "batch_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridTestSendConfigBasic(name, sender string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_template" "template" {
+		name       = %q
+		generation = "dynamic"
+	}
+
+	resource "sendgrid_template_version" "template_version" {
+		name                   = %q
+		template_id            = sendgrid_template.template.id
+		active                 = 1
+		html_content           = "<p>Hello {{name}}</p>"
+		generate_plain_content = true
+		subject                = "Smoke test"
+	}
+
+	resource "sendgrid_test_send" "smoke" {
+		from_email   = %q
+		to           = ["smoke-tests@example.org"]
+		template_id  = sendgrid_template_version.template_version.template_id
+		sandbox_mode = true
+
+		dynamic_template_data = jsonencode({
+			name = "Terraform"
+		})
+	}
+	`, name, name, sender)
+}
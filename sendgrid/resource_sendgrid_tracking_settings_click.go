@@ -0,0 +1,214 @@
+/*
+Provide a resource to manage the click tracking setting: the links of the emails are rewritten to count the clicks.
+A subuser inherits the setting of the parent account until it's overridden: the resource only writes the attributes
+set in its configuration, and lists them in `overridden`, the others keep their inherited values.
+Destroying the resource disables the overridden attributes, Sendgrid can't make a subuser inherit them again.
+Example Usage
+```hcl
+resource "sendgrid_tracking_settings_click" "click" {
+	enabled = true
+}
+
+resource "sendgrid_tracking_settings_click" "subuser_click" {
+	sub_user_on_behalf_of = "subUserName"
+	enable_text           = false
+}
+```
+Import
+The click tracking setting can be imported, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_click.click click
+```
+The click tracking setting of a subuser can be imported using the subuser's username, e.g.
+```hcl
+$ terraform import sendgrid_tracking_settings_click.subuser_click subUserName/click
+```
+*/
+package sendgrid
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridTrackingSettingsClick() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridTrackingSettingsClickCreate,
+		ReadContext:   resourceSendgridTrackingSettingsClickRead,
+		UpdateContext: resourceSendgridTrackingSettingsClickUpdate,
+		DeleteContext: resourceSendgridTrackingSettingsClickDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridMailSettingsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sub_user_on_behalf_of": {
+				Type:        schema.TypeString,
+				Description: "The subuser's username. Manages the tracking setting of the subuser instead of the account.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"parent_account": parentAccountSchema(true),
+			"enabled": {
+				Type:        schema.TypeBool,
+				Description: "Track the clicks on the links of the emails, inherited if not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"enable_text": {
+				Type:        schema.TypeBool,
+				Description: "Track the clicks on the links of the plain content too, inherited if not set.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"overridden": {
+				Type:        schema.TypeSet,
+				Description: "The attributes written by the resource, which don't inherit their values anymore.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+// trackingSettingsClickOverrides returns the setting made of the attributes set in the configuration on creation,
+// or changed on update, the others are left nil so that they keep their values, and the attributes overridden
+// from now on.
+func trackingSettingsClickOverrides(
+	d *schema.ResourceData,
+	creating bool,
+) (sendgrid.TrackingSettingClick, *schema.Set) {
+	var setting sendgrid.TrackingSettingClick
+
+	overridden := schema.NewSet(schema.HashString, d.Get("overridden").(*schema.Set).List())
+
+	for attribute, field := range map[string]**bool{
+		"enabled":     &setting.Enabled,
+		"enable_text": &setting.EnableText,
+	} {
+		//nolint:staticcheck
+		_, set := d.GetOkExists(attribute)
+		if (creating && !set) || (!creating && !d.HasChange(attribute)) {
+			continue
+		}
+
+		value := d.Get(attribute).(bool)
+		*field = &value
+
+		overridden.Add(attribute)
+	}
+
+	return setting, overridden
+}
+
+func updateTrackingSettingsClick(
+	ctx context.Context,
+	c *sendgrid.Client,
+	d *schema.ResourceData,
+	timeoutKey string,
+	setting sendgrid.TrackingSettingClick,
+) error {
+	if setting.Enabled == nil && setting.EnableText == nil {
+		return nil
+	}
+
+	_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+		return c.UpdateTrackingSettingClick(setting)
+	})
+
+	return err
+}
+
+func resourceSendgridTrackingSettingsClickCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, overridden := trackingSettingsClickOverrides(d, true)
+	if err := updateTrackingSettingsClick(ctx, c, d, schema.TimeoutCreate, setting); err != nil {
+		return errorToDiags("failed creating click tracking setting", err)
+	}
+
+	d.SetId("click")
+	//nolint:errcheck
+	d.Set("overridden", overridden)
+
+	return resourceSendgridTrackingSettingsClickRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsClickRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, requestErr := c.ReadTrackingSettingClick()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading click tracking setting", requestErr)}
+	}
+
+	// the values are read whether they are inherited or overridden, the API doesn't tell them apart.
+	if setting.Enabled != nil {
+		//nolint:errcheck
+		d.Set("enabled", *setting.Enabled)
+	}
+
+	if setting.EnableText != nil {
+		//nolint:errcheck
+		d.Set("enable_text", *setting.EnableText)
+	}
+
+	return nil
+}
+
+func resourceSendgridTrackingSettingsClickUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	setting, overridden := trackingSettingsClickOverrides(d, false)
+	if err := updateTrackingSettingsClick(ctx, c, d, schema.TimeoutUpdate, setting); err != nil {
+		return errorToDiags("failed updating click tracking setting", err)
+	}
+
+	//nolint:errcheck
+	d.Set("overridden", overridden)
+
+	return resourceSendgridTrackingSettingsClickRead(ctx, d, m)
+}
+
+func resourceSendgridTrackingSettingsClickDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := mailSettingsClient(d, m).WithContext(ctx)
+
+	// only reset the overridden attributes to the Sendgrid defaults, the inherited ones were never written.
+	var setting sendgrid.TrackingSettingClick
+
+	disabled := false
+
+	for _, attribute := range d.Get("overridden").(*schema.Set).List() {
+		switch attribute.(string) {
+		case "enabled":
+			setting.Enabled = &disabled
+		case "enable_text":
+			setting.EnableText = &disabled
+		}
+	}
+
+	if err := updateTrackingSettingsClick(ctx, c, d, schema.TimeoutDelete, setting); err != nil {
+		return errorToDiags("failed deleting click tracking setting", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package sendgrid_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSendgridTrackingSettingsClickBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				// enable_text isn't set, it keeps its value and isn't overridden.
+				Config: testAccCheckSendgridTrackingSettingsClickConfigBasic(`enabled = true`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.click", "enabled", "true"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.click", "overridden.#", "1"),
+					resource.TestCheckResourceAttrSet("sendgrid_tracking_settings_click.click", "enable_text"),
+				),
+			},
+			{
+				// an explicit false is an override too.
+				Config: testAccCheckSendgridTrackingSettingsClickConfigBasic(`
+					enabled     = true
+					enable_text = false
+				`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.click", "enable_text", "false"),
+					resource.TestCheckResourceAttr("sendgrid_tracking_settings_click.click", "overridden.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_tracking_settings_click.click",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"overridden"},
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridTrackingSettingsClickConfigBasic(attributes string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_tracking_settings_click" "click" {
+		%s
+	}
+	`, attributes)
+}
@@ -0,0 +1,325 @@
+/*
+Provide a resource to manage a verified sender, Sendgrid sends a verification email to its address on creation.
+While the sender isn't verified, the verification email can be sent again by changing `resend_verification`,
+e.g. to a timestamp, which doesn't update any other field of the sender.
+Sendgrid refuses the senders whose domain is already authenticated: the emails can be sent from any address
+of an authenticated domain without verifying it, see `sendgrid_domain_authentication`.
+A sender whose from email already exists can be adopted with `adopt_existing`, and updated to the configuration.
+Example Usage
+```hcl
+resource "sendgrid_verified_sender" "example" {
+	nickname            = "Support"
+	from_email          = "support@example.org"
+	from_name           = "Example Support"
+	reply_to            = "support@example.org"
+	address             = "1 Example Street"
+	city                = "Paris"
+	country             = "France"
+	resend_verification = "2021-04-01"
+}
+```
+Import
+A verified sender can be imported by ID, e.g.
+```hcl
+$ terraform import sendgrid_verified_sender.example verifiedSenderID
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func resourceSendgridVerifiedSender() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridVerifiedSenderCreate,
+		ReadContext:   resourceSendgridVerifiedSenderRead,
+		UpdateContext: resourceSendgridVerifiedSenderUpdate,
+		DeleteContext: resourceSendgridVerifiedSenderDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridVerifiedSenderImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"nickname": {
+				Type:        schema.TypeString,
+				Description: "A nickname for the sender, not used for sending.",
+				Required:    true,
+			},
+			"from_email": {
+				Type:        schema.TypeString,
+				Description: "The email address the emails are sent from.",
+				Required:    true,
+			},
+			"from_name": {
+				Type:        schema.TypeString,
+				Description: "The name the emails are sent from.",
+				Optional:    true,
+			},
+			"reply_to": {
+				Type:        schema.TypeString,
+				Description: "The email address the replies are sent to.",
+				Required:    true,
+			},
+			"reply_to_name": {
+				Type:        schema.TypeString,
+				Description: "The name the replies are sent to.",
+				Optional:    true,
+			},
+			"address": {
+				Type:        schema.TypeString,
+				Description: "The physical address of the sender.",
+				Required:    true,
+			},
+			"address2": {
+				Type:        schema.TypeString,
+				Description: "The second line of the physical address of the sender.",
+				Optional:    true,
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Description: "The state of the sender.",
+				Optional:    true,
+			},
+			"city": {
+				Type:        schema.TypeString,
+				Description: "The city of the sender.",
+				Required:    true,
+			},
+			"zip": {
+				Type:        schema.TypeString,
+				Description: "The zip code of the sender.",
+				Optional:    true,
+			},
+			"country": {
+				Type:        schema.TypeString,
+				Description: "The country of the sender.",
+				Required:    true,
+			},
+			"resend_verification": {
+				Type: schema.TypeString,
+				Description: "Any value, changing it sends the verification email again " +
+					"if the sender isn't verified yet.",
+				Optional: true,
+			},
+			"adopt_existing": {
+				Type: schema.TypeBool,
+				Description: "Adopt the sender if its from email already exists, e.g. when an apply was interrupted, " +
+					"instead of failing. The sender is updated to the configuration.",
+				Optional: true,
+				Default:  false,
+			},
+			"verified": {
+				Type:        schema.TypeBool,
+				Description: "Whether the sender was verified.",
+				Computed:    true,
+			},
+			"locked": {
+				Type:        schema.TypeBool,
+				Description: "Whether the sender is locked, e.g. because it's used by a campaign.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func verifiedSenderFromResourceData(d *schema.ResourceData) sendgrid.VerifiedSender {
+	return sendgrid.VerifiedSender{
+		Nickname:    d.Get("nickname").(string),
+		FromEmail:   d.Get("from_email").(string),
+		FromName:    d.Get("from_name").(string),
+		ReplyTo:     d.Get("reply_to").(string),
+		ReplyToName: d.Get("reply_to_name").(string),
+		Address:     d.Get("address").(string),
+		Address2:    d.Get("address2").(string),
+		State:       d.Get("state").(string),
+		City:        d.Get("city").(string),
+		Zip:         d.Get("zip").(string),
+		Country:     d.Get("country").(string),
+	}
+}
+
+func resourceSendgridVerifiedSenderCreate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	fromEmail := d.Get("from_email").(string)
+
+	sender, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateVerifiedSender(verifiedSenderFromResourceData(d))
+	})
+
+	switch {
+	case errors.Is(err, sendgrid.ErrVerifiedSenderDomainAuthenticated):
+		return diag.Diagnostics{{
+			Severity: diag.Error,
+			Summary:  "Sendgrid sender domain is already authenticated",
+			Detail: fmt.Sprintf("Sendgrid refuses to verify %s as its domain %s is authenticated: "+
+				"the emails can be sent from any address of the domain without a verified sender, "+
+				"remove the sender or manage the domain with sendgrid_domain_authentication instead.",
+				fromEmail, fromEmail[strings.LastIndex(fromEmail, "@")+1:]),
+		}}
+	case errors.Is(err, sendgrid.ErrVerifiedSenderAlreadyExists) && d.Get("adopt_existing").(bool):
+		return adoptVerifiedSender(ctx, d, schema.TimeoutCreate, m)
+	case err != nil:
+		return errorToDiags("failed creating verified sender", err)
+	}
+
+	d.SetId(strconv.FormatInt(sender.(*sendgrid.VerifiedSender).ID, 10))
+
+	return resourceSendgridVerifiedSenderRead(ctx, d, m)
+}
+
+// adoptVerifiedSender finds the existing sender with the from email of the configuration,
+// and updates it to the configuration.
+func adoptVerifiedSender(
+	ctx context.Context,
+	d *schema.ResourceData,
+	timeoutKey string,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	fromEmail := d.Get("from_email").(string)
+
+	senders, requestErr := c.ListVerifiedSenders()
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed listing existing verified senders", requestErr)}
+	}
+
+	for _, sender := range senders {
+		if strings.EqualFold(sender.FromEmail, fromEmail) {
+			d.SetId(strconv.FormatInt(sender.ID, 10))
+
+			_, err := c.RetryOnRateLimit(ctx, d, timeoutKey, func() (interface{}, sendgrid.RequestError) {
+				return c.UpdateVerifiedSender(d.Id(), verifiedSenderFromResourceData(d))
+			})
+			if err != nil {
+				return errorToDiags("failed updating adopted verified sender", err)
+			}
+
+			return resourceSendgridVerifiedSenderRead(ctx, d, m)
+		}
+	}
+
+	return diag.FromErr(verifiedSenderToAdoptNotFound(fromEmail))
+}
+
+func resourceSendgridVerifiedSenderRead(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	sender, requestErr := c.ReadVerifiedSender(d.Id())
+	if isNotFound(requestErr) {
+		// the verified sender was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading verified sender", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("nickname", sender.Nickname)
+	//nolint:errcheck
+	d.Set("from_email", sender.FromEmail)
+	//nolint:errcheck
+	d.Set("from_name", sender.FromName)
+	//nolint:errcheck
+	d.Set("reply_to", sender.ReplyTo)
+	//nolint:errcheck
+	d.Set("reply_to_name", sender.ReplyToName)
+	//nolint:errcheck
+	d.Set("address", sender.Address)
+	//nolint:errcheck
+	d.Set("address2", sender.Address2)
+	//nolint:errcheck
+	d.Set("state", sender.State)
+	//nolint:errcheck
+	d.Set("city", sender.City)
+	//nolint:errcheck
+	d.Set("zip", sender.Zip)
+	//nolint:errcheck
+	d.Set("country", sender.Country)
+	//nolint:errcheck
+	d.Set("verified", sender.Verified)
+	//nolint:errcheck
+	d.Set("locked", sender.Locked)
+
+	return nil
+}
+
+func resourceSendgridVerifiedSenderUpdate(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if d.HasChanges("nickname", "from_email", "from_name", "reply_to", "reply_to_name",
+		"address", "address2", "state", "city", "zip", "country") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateVerifiedSender(d.Id(), verifiedSenderFromResourceData(d))
+		})
+		if err != nil {
+			return errorToDiags("failed updating verified sender", err)
+		}
+	}
+
+	// a verified sender has nothing left to verify, Sendgrid rejects the resend.
+	if d.HasChange("resend_verification") && !d.Get("verified").(bool) {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.ResendVerifiedSenderVerification(d.Id())
+		})
+		if err != nil {
+			return errorToDiags("failed resending verified sender verification", err)
+		}
+	}
+
+	return resourceSendgridVerifiedSenderRead(ctx, d, m)
+}
+
+func resourceSendgridVerifiedSenderDelete(
+	ctx context.Context,
+	d *schema.ResourceData,
+	m interface{},
+) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteVerifiedSender(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting verified sender", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridVerifiedSenderImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("adopt_existing", false)
+
+	return []*schema.ResourceData{d}, nil
+}
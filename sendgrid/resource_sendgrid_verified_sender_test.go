@@ -0,0 +1,123 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridVerifiedSenderBasic(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridVerifiedSenderDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSendgridVerifiedSenderConfigBasic(email, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "from_email", email),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "verified", "false"),
+				),
+			},
+			{
+				// resending the verification must leave the other fields untouched.
+				Config: testAccCheckSendgridVerifiedSenderConfigBasic(email, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "from_email", email),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "nickname", "Terraform"),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "resend_verification", "2"),
+				),
+			},
+			{
+				ResourceName:            "sendgrid_verified_sender.sender",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resend_verification", "adopt_existing"},
+			},
+		},
+	})
+}
+
+func TestAccSendgridVerifiedSenderAdoptExisting(t *testing.T) {
+	email := "terraform-" + acctest.RandString(10) + "@example.org"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridVerifiedSenderDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the sender exists before the apply, e.g. created by an interrupted apply.
+				PreConfig: func() {
+					c := sendgrid.NewClient(os.Getenv("SENDGRID_API_KEY"), os.Getenv("SENDGRID_HOST"), "")
+
+					_, requestErr := c.CreateVerifiedSender(sendgrid.VerifiedSender{
+						Nickname:  "Existing",
+						FromEmail: email,
+						ReplyTo:   email,
+						Address:   "1 Example Street",
+						City:      "Paris",
+						Country:   "France",
+					})
+					if requestErr.Err != nil {
+						t.Fatal(requestErr.Err)
+					}
+				},
+				Config: fmt.Sprintf(`
+				resource "sendgrid_verified_sender" "sender" {
+					nickname       = "Terraform"
+					from_email     = %q
+					reply_to       = %q
+					address        = "1 Example Street"
+					city           = "Paris"
+					country        = "France"
+					adopt_existing = true
+				}
+				`, email, email),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "from_email", email),
+					resource.TestCheckResourceAttr("sendgrid_verified_sender.sender", "nickname", "Terraform"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridVerifiedSenderDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_verified_sender" {
+			continue
+		}
+
+		_, requestErr := c.ReadVerifiedSender(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("verified sender %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridVerifiedSenderConfigBasic(email, resend string) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_verified_sender" "sender" {
+		nickname            = "Terraform"
+		from_email          = %q
+		reply_to            = %q
+		address             = "1 Example Street"
+		city                = "Paris"
+		country             = "France"
+		resend_verification = %q
+	}
+	`, email, email, resend)
+}
@@ -0,0 +1,213 @@
+/*
+Provide a resource to manage the inbound parse webhook of a hostname:
+the emails received by the hostname are parsed and posted to the URL.
+Sendgrid only receives the emails of the hostname once its MX record points to mx.sendgrid.net, otherwise nothing
+is ever posted and no error is reported. Set `verify_mx` to look up the MX records of the hostname when applying,
+and warn when none points to Sendgrid.
+Example Usage
+```hcl
+resource "sendgrid_webhook_parse" "inbound" {
+	hostname   = "inbound.example.org"
+	url        = "https://example.org/sendgrid/inbound"
+	spam_check = true
+	verify_mx  = true
+}
+```
+Import
+A parse webhook can be imported by hostname, e.g.
+```hcl
+$ terraform import sendgrid_webhook_parse.inbound inbound.example.org
+```
+*/
+package sendgrid
+
+import (
+	"context"
+	"net"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+// parseWebhookMXHost is the host the MX record of the hostname of a parse webhook must point to.
+const parseWebhookMXHost = "mx.sendgrid.net"
+
+func resourceSendgridWebhookParse() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceSendgridWebhookParseCreate,
+		ReadContext:   resourceSendgridWebhookParseRead,
+		UpdateContext: resourceSendgridWebhookParseUpdate,
+		DeleteContext: resourceSendgridWebhookParseDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceSendgridWebhookParseImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"hostname": {
+				Type:        schema.TypeString,
+				Description: "The hostname receiving the emails, its MX record must point to mx.sendgrid.net.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"url": {
+				Type:             schema.TypeString,
+				Description:      "The URL the parsed emails are posted to.",
+				Required:         true,
+				ValidateFunc:     validation.IsURLWithHTTPorHTTPS,
+				DiffSuppressFunc: suppressEquivalentURL,
+			},
+			"spam_check": {
+				Type:        schema.TypeBool,
+				Description: "Whether the emails are checked for spam before being posted.",
+				Optional:    true,
+				Default:     false,
+			},
+			"send_raw": {
+				Type:        schema.TypeBool,
+				Description: "Whether the raw MIME emails are posted, instead of their parsed fields.",
+				Optional:    true,
+				Default:     false,
+			},
+			"verify_mx": {
+				Type: schema.TypeBool,
+				Description: "Look up the MX records of the hostname when applying, " +
+					"and warn when none points to mx.sendgrid.net.",
+				Optional: true,
+				Default:  false,
+			},
+		},
+	}
+}
+
+func parseWebhookFromResourceData(d *schema.ResourceData) sendgrid.ParseWebhook {
+	return sendgrid.ParseWebhook{
+		Hostname:  d.Get("hostname").(string),
+		URL:       d.Get("url").(string),
+		SpamCheck: d.Get("spam_check").(bool),
+		SendRaw:   d.Get("send_raw").(bool),
+	}
+}
+
+// verifyParseWebhookMX warns when none of the MX records of the hostname points to Sendgrid,
+// as Sendgrid then never receives the emails to parse.
+func verifyParseWebhookMX(ctx context.Context, hostname string) diag.Diagnostics {
+	records, err := net.DefaultResolver.LookupMX(ctx, hostname)
+	if err != nil {
+		return diag.Diagnostics{{
+			Severity: diag.Warning,
+			Summary:  "The MX records of the parse webhook hostname couldn't be looked up",
+			Detail: "Sendgrid only receives the emails of " + hostname + " once its MX record points to " +
+				parseWebhookMXHost + ": " + err.Error(),
+		}}
+	}
+
+	for _, record := range records {
+		if strings.EqualFold(strings.TrimSuffix(record.Host, "."), parseWebhookMXHost) {
+			return nil
+		}
+	}
+
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "The MX records of the parse webhook hostname don't point to Sendgrid",
+		Detail: "Sendgrid doesn't receive the emails of " + hostname + ", nothing is posted to the URL " +
+			"until an MX record of " + hostname + " points to " + parseWebhookMXHost + ".",
+	}}
+}
+
+func resourceSendgridWebhookParseCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	webhook := parseWebhookFromResourceData(d)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutCreate, func() (interface{}, sendgrid.RequestError) {
+		return c.CreateParseWebhook(webhook)
+	})
+	if err != nil {
+		return errorToDiags("failed creating parse webhook", err)
+	}
+
+	d.SetId(webhook.Hostname)
+
+	var diags diag.Diagnostics
+	if d.Get("verify_mx").(bool) {
+		diags = verifyParseWebhookMX(ctx, webhook.Hostname)
+	}
+
+	return append(diags, resourceSendgridWebhookParseRead(ctx, d, m)...)
+}
+
+func resourceSendgridWebhookParseRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	webhook, requestErr := c.ReadParseWebhook(d.Id())
+	if isNotFound(requestErr) {
+		// the parse webhook was deleted outside of Terraform.
+		d.SetId("")
+
+		return nil
+	}
+
+	if requestErr.Err != nil {
+		return diag.Diagnostics{requestErrorToDiag("failed reading parse webhook", requestErr)}
+	}
+
+	//nolint:errcheck
+	d.Set("hostname", webhook.Hostname)
+	//nolint:errcheck
+	d.Set("url", webhook.URL)
+	//nolint:errcheck
+	d.Set("spam_check", webhook.SpamCheck)
+	//nolint:errcheck
+	d.Set("send_raw", webhook.SendRaw)
+
+	return nil
+}
+
+func resourceSendgridWebhookParseUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	if d.HasChanges("url", "spam_check", "send_raw") {
+		_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutUpdate, func() (interface{}, sendgrid.RequestError) {
+			return c.UpdateParseWebhook(parseWebhookFromResourceData(d))
+		})
+		if err != nil {
+			return errorToDiags("failed updating parse webhook", err)
+		}
+	}
+
+	var diags diag.Diagnostics
+	if d.Get("verify_mx").(bool) {
+		diags = verifyParseWebhookMX(ctx, d.Id())
+	}
+
+	return append(diags, resourceSendgridWebhookParseRead(ctx, d, m)...)
+}
+
+func resourceSendgridWebhookParseDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	c := m.(*sendgrid.Client).WithContext(ctx)
+
+	_, err := c.RetryOnRateLimit(ctx, d, schema.TimeoutDelete, func() (interface{}, sendgrid.RequestError) {
+		return c.DeleteParseWebhook(d.Id())
+	})
+	if err != nil {
+		return errorToDiags("failed deleting parse webhook", err)
+	}
+
+	return nil
+}
+
+func resourceSendgridWebhookParseImport(
+	_ context.Context,
+	d *schema.ResourceData,
+	_ interface{},
+) ([]*schema.ResourceData, error) {
+	// the defaults aren't set when importing.
+	//nolint:errcheck
+	d.Set("verify_mx", false)
+
+	return []*schema.ResourceData{d}, nil
+}
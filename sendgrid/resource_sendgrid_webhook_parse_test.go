@@ -0,0 +1,76 @@
+package sendgrid_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	sendgrid "github.com/trois-six/terraform-provider-sendgrid/sdk"
+)
+
+func TestAccSendgridWebhookParseBasic(t *testing.T) {
+	domain := os.Getenv("SENDGRID_TEST_DOMAIN")
+	if domain == "" {
+		t.Skip("SENDGRID_TEST_DOMAIN must be set to a domain authenticated on the account")
+	}
+
+	hostname := "parse." + domain
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckSendgridWebhookParseDestroy,
+		Steps: []resource.TestStep{
+			{
+				// the MX record of the hostname doesn't point to Sendgrid, which is only a warning.
+				Config: testAccCheckSendgridWebhookParseConfigBasic(hostname, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_webhook_parse.parse", "hostname", hostname),
+					resource.TestCheckResourceAttr("sendgrid_webhook_parse.parse", "spam_check", "false"),
+				),
+			},
+			{
+				Config: testAccCheckSendgridWebhookParseConfigBasic(hostname, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("sendgrid_webhook_parse.parse", "spam_check", "true"),
+				),
+			},
+			{
+				ResourceName:      "sendgrid_webhook_parse.parse",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckSendgridWebhookParseDestroy(s *terraform.State) error {
+	c := testAccProvider.Meta().(*sendgrid.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "sendgrid_webhook_parse" {
+			continue
+		}
+
+		_, requestErr := c.ReadParseWebhook(rs.Primary.ID)
+		if !errors.Is(requestErr, sendgrid.ErrNotFound) {
+			return fmt.Errorf("parse webhook %s still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckSendgridWebhookParseConfigBasic(hostname string, spamCheck bool) string {
+	return fmt.Sprintf(`
+	resource "sendgrid_webhook_parse" "parse" {
+		hostname   = %q
+		url        = "https://example.org/sendgrid/inbound"
+		spam_check = %t
+		verify_mx  = true
+	}
+	`, hostname, spamCheck)
+}